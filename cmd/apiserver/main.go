@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
@@ -15,18 +16,26 @@ import (
 	"github.com/msanath/mrds/ledger/deploymentplan"
 	"github.com/msanath/mrds/ledger/metainstance"
 	"github.com/msanath/mrds/ledger/node"
+	"github.com/msanath/mrds/pkg/signals"
 	"github.com/msanath/mrds/pkg/sqlstorage"
 
 	"github.com/msanath/gondolf/pkg/ctxslog"
 	"github.com/msanath/gondolf/pkg/simplesql/test"
 	"github.com/spf13/cobra"
+	temporalclient "go.temporal.io/sdk/client"
 	"google.golang.org/grpc"
 )
 
 type serverOptions struct {
-	testMode bool
+	testMode           bool
+	temporalAddress    string
+	changeLogRetention time.Duration
+	schedulerProfiles  []string
 }
 
+// changeLogPruneInterval is how often the changes older than the retention are pruned from the change log.
+const changeLogPruneInterval = 10 * time.Minute
+
 func main() {
 	so := serverOptions{}
 	cmd := cobra.Command{
@@ -38,6 +47,12 @@ func main() {
 		},
 	}
 	cmd.Flags().BoolVar(&so.testMode, "test-mode", false, "Uses in-memory database. Data will be lost after server restart.")
+	cmd.Flags().StringVar(&so.temporalAddress, "temporal-address", "localhost:7233",
+		"Address of the Temporal server used to signal operation workflows when their operation is approved, and deployment workflows when their deployment is paused, resumed or aborted. Signalling is disabled when empty.")
+	cmd.Flags().DurationVar(&so.changeLogRetention, "change-log-retention", 24*time.Hour,
+		"How long the changes are kept in the change log. Watches which fall further behind start again from the current records.")
+	cmd.Flags().StringSliceVar(&so.schedulerProfiles, "scheduler-profiles", []string{"default", "bin-packing"},
+		"Scheduler profiles registered with the controlplane. Deployment plans selecting another profile are rejected.")
 
 	err := cmd.Execute()
 	if err != nil {
@@ -89,22 +104,60 @@ func (o serverOptions) Run(ctx context.Context) error {
 		grpcservers.NewNodeService(nodeLedger),
 	)
 
+	var metaInstanceOpts []grpcservers.MetaInstanceServiceOption
+	var deploymentPlanOpts []grpcservers.DeploymentPlanServiceOption
+	if o.temporalAddress != "" {
+		// The client connects on first use, so the API server can be started before the Temporal server.
+		tc, err := temporalclient.NewLazyClient(temporalclient.Options{
+			HostPort:  o.temporalAddress,
+			Namespace: "mrds",
+			Logger:    log,
+		})
+		if err != nil {
+			return err
+		}
+		defer tc.Close()
+		metaInstanceOpts = append(metaInstanceOpts, grpcservers.WithOperationApprovalNotifier(signals.NewOperationApprovalNotifier(tc)))
+		deploymentPlanOpts = append(deploymentPlanOpts, grpcservers.WithDeploymentStateNotifier(signals.NewDeploymentStateNotifier(tc)))
+	}
 	metaInstanceLedger := metainstance.NewLedger(storage.MetaInstance)
 	mrdspb.RegisterMetaInstancesServer(
 		gServer,
-		grpcservers.NewMetaInstanceService(metaInstanceLedger),
+		grpcservers.NewMetaInstanceService(metaInstanceLedger, metaInstanceOpts...),
 	)
 
-	deploymentPlanLedger := deploymentplan.NewLedger(storage.DeploymentPlan)
+	deploymentPlanLedger := deploymentplan.NewLedger(storage.DeploymentPlan, deploymentplan.WithSchedulerProfiles(o.schedulerProfiles...))
 	mrdspb.RegisterDeploymentPlansServer(
 		gServer,
-		grpcservers.NewDeploymentPlanService(deploymentPlanLedger),
+		grpcservers.NewDeploymentPlanService(deploymentPlanLedger, deploymentPlanOpts...),
 	)
 
+	go pruneChangeLog(ctx, storage, o.changeLogRetention)
+
 	log.Info("Starting MRDS API server")
 	return gServer.Serve(lis)
 }
 
+// pruneChangeLog prunes the changes older than the retention from the change log until the context is
+// cancelled.
+func pruneChangeLog(ctx context.Context, storage *sqlstorage.SQLStorage, retention time.Duration) {
+	log := ctxslog.FromContext(ctx)
+
+	ticker := time.NewTicker(changeLogPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := storage.PruneChangeLog(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Error("failed to prune change log", "error", err)
+			}
+		}
+	}
+}
+
 func newMySQLConn() (*sqlx.DB, error) {
 	mysqlConfig := &mysql.Config{
 		User:                 "root",
@@ -2,17 +2,27 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
 	"syscall"
+	"time"
 
 	"github.com/msanath/mrds/controlplane"
+	"github.com/msanath/mrds/controlplane/approver"
+	"github.com/msanath/mrds/controlplane/operators"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/controlplane/temporal/activities/scheduler"
+	"github.com/msanath/mrds/controlplane/temporal/workflows"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/runtime/fake"
 	"github.com/msanath/mrds/pkg/runtime/kind"
+	"github.com/msanath/mrds/pkg/runtime/kubernetes"
+	"github.com/msanath/mrds/pkg/runtime/process"
 	temporalclient "go.temporal.io/sdk/client"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/msanath/gondolf/pkg/ctxslog"
 	"github.com/spf13/cobra"
@@ -20,10 +30,22 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-type serverOptions struct{}
+type serverOptions struct {
+	schedulerProfile  string
+	approvalPolicies  string
+	approvalTimeout   time.Duration
+	reconcileInterval time.Duration
+	remediation       operators.RemediationOptions
+	defaultRuntime    string
+	processDir        string
+	nodeSyncClusters  []string
+	nodeSyncInterval  time.Duration
+}
 
 func main() {
-	so := serverOptions{}
+	so := serverOptions{
+		remediation: operators.DefaultRemediationOptions,
+	}
 	cmd := cobra.Command{
 		Use: "mrds-controlplane",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -33,6 +55,30 @@ func main() {
 		},
 	}
 
+	cmd.Flags().StringVar(&so.schedulerProfile, "scheduler-profile", scheduler.DefaultProfileName,
+		fmt.Sprintf("Scheduler profile used for deployment plans which do not select one. One of %v", scheduler.ProfileNames()))
+	cmd.Flags().StringVar(&so.approvalPolicies, "approval-policies", "",
+		"Path to a YAML file of policies which approve operations automatically. Operations are approved manually when unset.")
+	cmd.Flags().DurationVar(&so.approvalTimeout, "approval-timeout", workflows.DefaultApprovalTimeout,
+		"How long an operation waits for approval before it is marked FAILED.")
+	cmd.Flags().DurationVar(&so.reconcileInterval, "reconcile-interval", operators.DefaultReconcileInterval,
+		"How often the status of the runtime instances is synced from the runtime.")
+	cmd.Flags().IntVar(&so.remediation.Budget, "remediation-budget", so.remediation.Budget,
+		"How many FAILED runtime instances of a deployment plan are replaced within the remediation budget window. Disables remediation when 0.")
+	cmd.Flags().DurationVar(&so.remediation.BudgetWindow, "remediation-budget-window", so.remediation.BudgetWindow,
+		"The period over which the remediation budget is counted.")
+	cmd.Flags().DurationVar(&so.remediation.MaxBackoff, "remediation-max-backoff", so.remediation.MaxBackoff,
+		"The longest a meta instance waits before it is remediated again.")
+	cmd.Flags().StringVar(&so.defaultRuntime, "default-runtime", kind.RuntimeType,
+		fmt.Sprintf("Runtime type of the nodes whose cluster does not select one. One of %v",
+			[]string{kind.RuntimeType, kubernetes.RuntimeType, process.RuntimeType, fake.RuntimeType}))
+	cmd.Flags().StringVar(&so.processDir, "process-dir", filepath.Join(os.TempDir(), "mrds-processes"),
+		"Directory where the process runtime runs the instances of the clusters which do not set one.")
+	cmd.Flags().StringSliceVar(&so.nodeSyncClusters, "node-sync-cluster", nil,
+		"ID of a cluster whose nodes are synced from its kubernetes API, connected with the kubeconfig and context of its runtime config. May be repeated.")
+	cmd.Flags().DurationVar(&so.nodeSyncInterval, "node-sync-interval", operators.DefaultNodeSyncInterval,
+		"How often the nodes of the node sync clusters are synced from kubernetes.")
+
 	err := cmd.Execute()
 	if err != nil {
 		panic(err)
@@ -42,6 +88,19 @@ func main() {
 func (o serverOptions) Run(ctx context.Context) error {
 	log := ctxslog.FromContext(ctx)
 
+	if !slices.Contains(scheduler.ProfileNames(), o.schedulerProfile) {
+		return fmt.Errorf("unknown scheduler profile %q", o.schedulerProfile)
+	}
+
+	var approvalPolicies []approver.Policy
+	if o.approvalPolicies != "" {
+		policies, err := approver.LoadPolicies(o.approvalPolicies)
+		if err != nil {
+			return err
+		}
+		approvalPolicies = policies
+	}
+
 	log.Info("Starting control plane")
 	conn, err := grpc.NewClient("localhost:12345", grpc.WithTransportCredentials(
 		insecure.NewCredentials(),
@@ -59,25 +118,56 @@ func (o serverOptions) Run(ctx context.Context) error {
 		return err
 	}
 
-	// Create a kind runtime activity.
-	kubeconfig := clientcmd.RecommendedHomeFile
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
-	if err != nil {
-		return err
-	}
-	// Create a clientset
-	clientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return err
-	}
-	kindRuntime := kind.NewKindRuntime(
+	// Route the runtime activities to the runtime of the cluster of each node.
+	runtimes := runtime.NewRegistry(
 		mrdspb.NewMetaInstancesClient(conn),
-		mrdspb.NewDeploymentPlansClient(conn),
 		mrdspb.NewNodesClient(conn),
-		clientset,
+		mrdspb.NewClustersClient(conn),
+		o.defaultRuntime,
 	)
+	runtimes.RegisterRuntime(kind.RuntimeType, kind.NewFactory(
+		mrdspb.NewMetaInstancesClient(conn),
+		mrdspb.NewDeploymentPlansClient(conn),
+		mrdspb.NewNodesClient(conn),
+	))
+	runtimes.RegisterRuntime(kubernetes.RuntimeType, kubernetes.NewFactory(
+		mrdspb.NewMetaInstancesClient(conn),
+		mrdspb.NewDeploymentPlansClient(conn),
+		mrdspb.NewNodesClient(conn),
+	))
+	runtimes.RegisterRuntime(process.RuntimeType, process.NewFactory(
+		mrdspb.NewMetaInstancesClient(conn),
+		mrdspb.NewDeploymentPlansClient(conn),
+		mrdspb.NewNodesClient(conn),
+		o.processDir,
+	))
+	runtimes.RegisterRuntime(fake.RuntimeType, fake.NewFactory(mrdspb.NewMetaInstancesClient(conn)))
+	if !slices.Contains(runtimes.RuntimeTypes(), o.defaultRuntime) {
+		return fmt.Errorf("unknown default runtime %q. One of %v", o.defaultRuntime, runtimes.RuntimeTypes())
+	}
 
-	cp := controlplane.NewControlPlane(conn, tc, kindRuntime)
+	var nodeSyncTargets []controlplane.NodeSyncTarget
+	for _, clusterID := range o.nodeSyncClusters {
+		clusterResp, err := mrdspb.NewClustersClient(conn).GetByID(ctx, &mrdspb.GetClusterByIDRequest{Id: clusterID})
+		if err != nil {
+			return fmt.Errorf("failed to get node sync cluster %s: %w", clusterID, err)
+		}
+		k8sClient, err := kubernetes.NewClient(clusterResp.Record.RuntimeConfig)
+		if err != nil {
+			return fmt.Errorf("failed to connect to node sync cluster %s: %w", clusterID, err)
+		}
+		nodeSyncTargets = append(nodeSyncTargets, controlplane.NodeSyncTarget{ClusterID: clusterID, K8sClient: k8sClient})
+	}
+
+	cp := controlplane.NewControlPlane(conn, tc, runtimes, controlplane.Options{
+		SchedulerProfile:  o.schedulerProfile,
+		ApprovalPolicies:  approvalPolicies,
+		ApprovalTimeout:   o.approvalTimeout,
+		ReconcileInterval: o.reconcileInterval,
+		Remediation:       o.remediation,
+		NodeSyncTargets:   nodeSyncTargets,
+		NodeSyncInterval:  o.nodeSyncInterval,
+	})
 
 	cpErrChan := make(chan error)
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
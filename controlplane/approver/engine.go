@@ -0,0 +1,182 @@
+package approver
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// Decision is the outcome of evaluating the policies against an operation.
+type Decision struct {
+	Approved bool   // Approved is true when a policy approves the operation.
+	Policy   string // Policy is the name of the policy which approved the operation.
+	Reason   string // Reason explains why the operation is held when it is not approved.
+}
+
+// Engine evaluates the approval policies against the operations pending approval.
+type Engine struct {
+	policies        []Policy
+	metaInstances   mrdspb.MetaInstancesClient
+	deploymentPlans mrdspb.DeploymentPlansClient
+	nodes           mrdspb.NodesClient
+}
+
+// NewEngine creates an Engine which evaluates the policies in order.
+func NewEngine(
+	policies []Policy,
+	metaInstances mrdspb.MetaInstancesClient,
+	deploymentPlans mrdspb.DeploymentPlansClient,
+	nodes mrdspb.NodesClient,
+) *Engine {
+	return &Engine{
+		policies:        policies,
+		metaInstances:   metaInstances,
+		deploymentPlans: deploymentPlans,
+		nodes:           nodes,
+	}
+}
+
+// planState is the state of the instances of a deployment plan used by the policy constraints.
+type planState struct {
+	total        int
+	unavailable  int
+	domainByNode map[string]string // node ID -> update domain.
+	busyDomains  map[string]bool   // Update domains with an operation in progress.
+}
+
+// Evaluate decides whether the operation of the meta instance is approved. The policies are evaluated in
+// order and the first policy which matches the operation and whose constraints hold approves it. The
+// operation is held when no policy approves it.
+func (e *Engine) Evaluate(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) (Decision, error) {
+	planResp, err := e.deploymentPlans.GetByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{
+		Id: metaInstance.DeploymentPlanId,
+	})
+	if err != nil {
+		return Decision{}, fmt.Errorf("failed to get Deployment Plan: %w", err)
+	}
+	plan := planResp.Record
+
+	state, err := e.getPlanState(ctx, metaInstance, plan)
+	if err != nil {
+		return Decision{}, err
+	}
+	updateDomain := state.domainByNode[activeNodeID(metaInstance)]
+
+	reason := "no policy matches the operation"
+	matched := false
+	for _, policy := range e.policies {
+		if !policy.matches(operation, plan, updateDomain) {
+			continue
+		}
+		held := policy.check(state, operation, updateDomain)
+		if held == "" {
+			return Decision{Approved: true, Policy: policy.Name}, nil
+		}
+		if !matched {
+			reason = fmt.Sprintf("held by policy %s: %s", policy.Name, held)
+			matched = true
+		}
+	}
+	return Decision{Reason: reason}, nil
+}
+
+// check returns why the operation is held by the constraints of the policy, or an empty string when the
+// constraints hold.
+func (p Policy) check(state planState, operation *mrdspb.Operation, updateDomain string) string {
+	if p.MaxUnavailableFraction != nil && isDisruptive(operation) {
+		maxUnavailable := int(math.Floor(*p.MaxUnavailableFraction * float64(state.total)))
+		if maxUnavailable < 1 {
+			maxUnavailable = 1
+		}
+		if state.unavailable+1 > maxUnavailable {
+			return fmt.Sprintf("%d of %d instances are unavailable, at most %d can be", state.unavailable, state.total, maxUnavailable)
+		}
+	}
+	if p.OneUpdateDomainAtATime && updateDomain != "" {
+		for domain := range state.busyDomains {
+			if domain != updateDomain {
+				return fmt.Sprintf("an operation is in progress in update domain %s", domain)
+			}
+		}
+	}
+	return ""
+}
+
+// getPlanState computes the availability of the instances of the plan, other than the given one.
+// An instance is unavailable when an approved operation is in progress on it or when its active runtime
+// instance is not running.
+func (e *Engine) getPlanState(ctx context.Context, metaInstance *mrdspb.MetaInstance, plan *mrdspb.DeploymentPlanRecord) (planState, error) {
+	listResp, err := e.metaInstances.List(ctx, &mrdspb.ListMetaInstanceRequest{
+		DeploymentPlanIdIn: []string{plan.Metadata.Id},
+	})
+	if err != nil {
+		return planState{}, fmt.Errorf("failed to list meta instances: %w", err)
+	}
+
+	nodeIDs := make([]string, 0)
+	for _, instance := range listResp.Records {
+		if nodeID := activeNodeID(instance); nodeID != "" {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	state := planState{
+		total:        len(listResp.Records),
+		domainByNode: make(map[string]string),
+		busyDomains:  make(map[string]bool),
+	}
+	if len(nodeIDs) > 0 {
+		nodeResp, err := e.nodes.List(ctx, &mrdspb.ListNodeRequest{IdIn: nodeIDs})
+		if err != nil {
+			return planState{}, fmt.Errorf("failed to list nodes: %w", err)
+		}
+		for _, node := range nodeResp.Records {
+			state.domainByNode[node.Metadata.Id] = node.UpdateDomain
+		}
+	}
+
+	for _, instance := range listResp.Records {
+		if instance.Metadata.Id == metaInstance.Metadata.Id {
+			continue
+		}
+		inProgress := false
+		for _, operation := range instance.Operations {
+			if operation.Status.State == mrdspb.OperationState_OperationState_APPROVED && isDisruptive(operation) {
+				inProgress = true
+				break
+			}
+		}
+		if inProgress {
+			if domain, ok := state.domainByNode[activeNodeID(instance)]; ok {
+				state.busyDomains[domain] = true
+			}
+		}
+
+		notRunning := false
+		for _, runtimeInstance := range instance.RuntimeInstances {
+			if runtimeInstance.IsActive && runtimeInstance.Status.State != mrdspb.RuntimeInstanceState_RuntimeState_RUNNING {
+				notRunning = true
+			}
+		}
+		if inProgress || notRunning {
+			state.unavailable++
+		}
+	}
+	return state, nil
+}
+
+// isDisruptive returns whether the operation takes the instance down. Creating an instance only adds capacity.
+func isDisruptive(operation *mrdspb.Operation) bool {
+	return operation.Type != mrdspb.OperationType_OperationType_CREATE
+}
+
+// activeNodeID returns the node of the active runtime instance of the meta instance, if any.
+func activeNodeID(metaInstance *mrdspb.MetaInstance) string {
+	for _, runtimeInstance := range metaInstance.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			return runtimeInstance.NodeId
+		}
+	}
+	return ""
+}
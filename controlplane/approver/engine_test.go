@@ -0,0 +1,265 @@
+package approver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testInstance is an instance of the deployment plan under test.
+type testInstance struct {
+	nodeName       string
+	operationType  mrdspb.OperationType
+	operationState mrdspb.OperationState // Adds an operation in this state when set.
+	notRunning     bool
+}
+
+func fraction(f float64) *float64 {
+	return &f
+}
+
+func TestEvaluate(t *testing.T) {
+	updateDomains := map[string]string{"node-1": "ud-1", "node-2": "ud-1", "node-3": "ud-2", "node-4": "ud-2"}
+
+	testCases := []struct {
+		name           string
+		policies       []Policy
+		instances      []testInstance // The first instance holds the operation which is evaluated.
+		expectApproved bool
+		expectPolicy   string
+	}{
+		{
+			name:     "Operation is held when no policy is configured",
+			policies: nil,
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Policy matching the operation type and namespace approves",
+			policies: []Policy{
+				{Name: "updates", OperationTypes: []string{"UPDATE"}, Namespaces: []string{"test"}},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+			},
+			expectApproved: true,
+			expectPolicy:   "updates",
+		},
+		{
+			name: "Policy of another operation type does not match",
+			policies: []Policy{
+				{Name: "creates", OperationTypes: []string{"OperationType_CREATE"}},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Policy of another deployment plan or update domain does not match",
+			policies: []Policy{
+				{Name: "other-plan", DeploymentPlans: []string{"other"}},
+				{Name: "other-domain", UpdateDomains: []string{"ud-2"}},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Operation is held when too many instances are unavailable",
+			policies: []Policy{
+				{Name: "quarter", MaxUnavailableFraction: fraction(0.25)},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+				{nodeName: "node-3"},
+				{nodeName: "node-4"},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Instances which are not running count as unavailable",
+			policies: []Policy{
+				{Name: "quarter", MaxUnavailableFraction: fraction(0.25)},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", notRunning: true},
+				{nodeName: "node-3"},
+				{nodeName: "node-4"},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Operation is approved within the unavailable fraction",
+			policies: []Policy{
+				{Name: "half", MaxUnavailableFraction: fraction(0.5)},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+				{nodeName: "node-3"},
+				{nodeName: "node-4"},
+			},
+			expectApproved: true,
+			expectPolicy:   "half",
+		},
+		{
+			name: "Create operations do not reduce availability",
+			policies: []Policy{
+				{Name: "quarter", MaxUnavailableFraction: fraction(0.25)},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_CREATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+				{nodeName: "node-3"},
+				{nodeName: "node-4"},
+			},
+			expectApproved: true,
+			expectPolicy:   "quarter",
+		},
+		{
+			name: "Operation is held while another update domain is in progress",
+			policies: []Policy{
+				{Name: "one-domain", OneUpdateDomainAtATime: true},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-3", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+			},
+			expectApproved: false,
+		},
+		{
+			name: "Operation is approved while its own update domain is in progress",
+			policies: []Policy{
+				{Name: "one-domain", OneUpdateDomainAtATime: true},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+			},
+			expectApproved: true,
+			expectPolicy:   "one-domain",
+		},
+		{
+			name: "Later policy approves when an earlier one holds",
+			policies: []Policy{
+				{Name: "strict", MaxUnavailableFraction: fraction(0)},
+				{Name: "lenient", MaxUnavailableFraction: fraction(1)},
+			},
+			instances: []testInstance{
+				{nodeName: "node-1", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+				{nodeName: "node-2", operationType: mrdspb.OperationType_OperationType_UPDATE, operationState: mrdspb.OperationState_OperationState_APPROVED},
+			},
+			expectApproved: true,
+			expectPolicy:   "lenient",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, ValidatePolicies(tc.policies))
+
+			ts, err := testserver.NewTestServer()
+			require.NoError(t, err)
+			defer ts.Close()
+
+			ctx := context.Background()
+			nodes := mrdspb.NewNodesClient(ts.Conn())
+			metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+			deploymentPlans := mrdspb.NewDeploymentPlansClient(ts.Conn())
+
+			nodeIDs := make(map[string]string)
+			for name, updateDomain := range updateDomains {
+				resp, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+					Name:                    name,
+					UpdateDomain:            updateDomain,
+					TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+					SystemReservedResources: &mrdspb.Resources{},
+				})
+				require.NoError(t, err)
+				nodeIDs[name] = resp.Record.Metadata.Id
+			}
+
+			planResp, err := deploymentPlans.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+				Name:        "plan",
+				Namespace:   "test",
+				ServiceName: "plan",
+				Applications: []*mrdspb.Application{
+					{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+				},
+			})
+			require.NoError(t, err)
+			_, err = deploymentPlans.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:     planResp.Record.Metadata,
+				DeploymentId: "deployment-1",
+				PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+					{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+				},
+				InstanceCount: uint32(len(tc.instances)),
+			})
+			require.NoError(t, err)
+
+			var metaInstance *mrdspb.MetaInstance
+			var operation *mrdspb.Operation
+			for i, instance := range tc.instances {
+				createResp, err := metaInstances.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+					Name:             fmt.Sprintf("plan-%d", i),
+					DeploymentPlanId: planResp.Record.Metadata.Id,
+					DeploymentId:     "deployment-1",
+				})
+				require.NoError(t, err)
+
+				state := mrdspb.RuntimeInstanceState_RuntimeState_RUNNING
+				if instance.notRunning {
+					state = mrdspb.RuntimeInstanceState_RuntimeState_STARTING
+				}
+				resp, err := metaInstances.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+					Metadata: createResp.Record.Metadata,
+					RuntimeInstance: &mrdspb.RuntimeInstance{
+						Id:       fmt.Sprintf("plan-%d-runtime", i),
+						NodeId:   nodeIDs[instance.nodeName],
+						IsActive: true,
+						Status:   &mrdspb.RuntimeInstanceStatus{State: state},
+					},
+				})
+				require.NoError(t, err)
+
+				if instance.operationState != mrdspb.OperationState_OperationState_UNKNOWN {
+					resp, err = metaInstances.AddOperation(ctx, &mrdspb.AddOperationRequest{
+						Metadata: resp.Record.Metadata,
+						Operation: &mrdspb.Operation{
+							Id:       fmt.Sprintf("operation-%d", i),
+							Type:     instance.operationType,
+							IntentId: "deployment-1",
+							Status:   &mrdspb.OperationStatus{State: instance.operationState},
+						},
+					})
+					require.NoError(t, err)
+				}
+				if i == 0 {
+					metaInstance = resp.Record
+					operation = resp.Record.Operations[0]
+				}
+			}
+
+			engine := NewEngine(tc.policies, metaInstances, deploymentPlans, nodes)
+			decision, err := engine.Evaluate(ctx, metaInstance, operation)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectApproved, decision.Approved, decision.Reason)
+			require.Equal(t, tc.expectPolicy, decision.Policy)
+			if !tc.expectApproved {
+				require.NotEmpty(t, decision.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package approver
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Policy is a declarative rule which approves the operations it matches when its constraints hold.
+//
+// An empty match field matches everything. An operation is matched by a policy when it matches all of the
+// non empty match fields.
+type Policy struct {
+	// Name identifies the policy. It is recorded in the status message of the operations it approves.
+	Name string `yaml:"name"`
+
+	// OperationTypes are the types of the operations matched by the policy. E.g. CREATE, UPDATE.
+	OperationTypes []string `yaml:"operationTypes"`
+	// DeploymentPlans are the names of the deployment plans matched by the policy.
+	DeploymentPlans []string `yaml:"deploymentPlans"`
+	// Namespaces are the namespaces of the deployment plans matched by the policy.
+	Namespaces []string `yaml:"namespaces"`
+	// UpdateDomains are the update domains of the nodes, running the active instance, matched by the policy.
+	UpdateDomains []string `yaml:"updateDomains"`
+
+	// MaxUnavailableFraction is the largest fraction of the instances of the deployment plan which can be
+	// unavailable once the operation is approved. At least one instance is always allowed to be unavailable.
+	// No limit is applied when unset.
+	MaxUnavailableFraction *float64 `yaml:"maxUnavailableFraction"`
+	// OneUpdateDomainAtATime holds the operation while an operation of the same deployment plan is in progress
+	// in another update domain.
+	OneUpdateDomainAtATime bool `yaml:"oneUpdateDomainAtATime"`
+}
+
+// policyFile is the format of the file holding the policies.
+type policyFile struct {
+	Policies []Policy `yaml:"policies"`
+}
+
+// LoadPolicies reads the policies from a YAML file.
+func LoadPolicies(path string) ([]Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policies: %w", err)
+	}
+	var file policyFile
+	err = yaml.Unmarshal(data, &file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policies: %w", err)
+	}
+	err = ValidatePolicies(file.Policies)
+	if err != nil {
+		return nil, err
+	}
+	return file.Policies, nil
+}
+
+// ValidatePolicies checks that the policies are well formed and uniquely named.
+func ValidatePolicies(policies []Policy) error {
+	names := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return fmt.Errorf("policy name is required")
+		}
+		if names[policy.Name] {
+			return fmt.Errorf("duplicate policy %q", policy.Name)
+		}
+		names[policy.Name] = true
+
+		for _, operationType := range policy.OperationTypes {
+			if _, ok := mrdspb.OperationType_value[operationTypeName(operationType)]; !ok {
+				return fmt.Errorf("policy %q: unknown operation type %q", policy.Name, operationType)
+			}
+		}
+		if policy.MaxUnavailableFraction != nil && (*policy.MaxUnavailableFraction < 0 || *policy.MaxUnavailableFraction > 1) {
+			return fmt.Errorf("policy %q: maxUnavailableFraction must be between 0 and 1", policy.Name)
+		}
+	}
+	return nil
+}
+
+// operationTypeName returns the enum name of an operation type. Both CREATE and OperationType_CREATE are accepted.
+func operationTypeName(operationType string) string {
+	operationType = strings.ToUpper(operationType)
+	if strings.HasPrefix(operationType, "OPERATIONTYPE_") {
+		operationType = operationType[len("OPERATIONTYPE_"):]
+	}
+	return "OperationType_" + operationType
+}
+
+// matches returns whether the policy applies to the operation.
+func (p Policy) matches(operation *mrdspb.Operation, plan *mrdspb.DeploymentPlanRecord, updateDomain string) bool {
+	if len(p.OperationTypes) > 0 && !slices.ContainsFunc(p.OperationTypes, func(operationType string) bool {
+		return operationTypeName(operationType) == operation.Type.String()
+	}) {
+		return false
+	}
+	if len(p.DeploymentPlans) > 0 && !slices.Contains(p.DeploymentPlans, plan.Name) {
+		return false
+	}
+	if len(p.Namespaces) > 0 && !slices.Contains(p.Namespaces, plan.Namespace) {
+		return false
+	}
+	if len(p.UpdateDomains) > 0 && !slices.Contains(p.UpdateDomains, updateDomain) {
+		return false
+	}
+	return true
+}
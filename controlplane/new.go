@@ -3,7 +3,9 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/msanath/mrds/controlplane/approver"
 	"github.com/msanath/mrds/controlplane/operators"
 	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
 	"github.com/msanath/mrds/controlplane/temporal/workers"
@@ -13,23 +15,51 @@ import (
 
 	temporalclient "go.temporal.io/sdk/client"
 	"google.golang.org/grpc"
+	k8s "k8s.io/client-go/kubernetes"
 )
 
+// Options are the tunables of the control plane.
+type Options struct {
+	// SchedulerProfile is the scheduler profile used for deployment plans which do not select one.
+	SchedulerProfile string
+	// ApprovalTimeout is how long an operation waits for approval before it is FAILED.
+	ApprovalTimeout time.Duration
+	// ApprovalPolicies approve the operations pending approval. Operations are approved manually when empty.
+	ApprovalPolicies []approver.Policy
+	// ReconcileInterval is how often the status of the runtime instances is synced from the runtime.
+	ReconcileInterval time.Duration
+	// Remediation configures the replacement of FAILED runtime instances. It is disabled when its budget is zero.
+	Remediation operators.RemediationOptions
+	// NodeSyncTargets are the clusters whose nodes are synced from kubernetes.
+	NodeSyncTargets []NodeSyncTarget
+	// NodeSyncInterval is how often the nodes of the NodeSyncTargets are synced.
+	NodeSyncInterval time.Duration
+}
+
+// NodeSyncTarget is a cluster whose nodes are synced from the node objects of its kubernetes API.
+type NodeSyncTarget struct {
+	ClusterID string
+	K8sClient k8s.Interface
+}
+
 type ControlPlane struct {
 	mrdsConn          *grpc.ClientConn
 	temporalClient    temporalclient.Client
 	runtimeActivities runtime.RuntimeActivities
+	options           Options
 }
 
 func NewControlPlane(
 	mrdsConn *grpc.ClientConn,
 	temporalClient temporalclient.Client,
 	runtimeActivities runtime.RuntimeActivities,
+	options Options,
 ) *ControlPlane {
 	return &ControlPlane{
 		mrdsConn:          mrdsConn,
 		temporalClient:    temporalClient,
 		runtimeActivities: runtimeActivities,
+		options:           options,
 	}
 }
 
@@ -37,7 +67,7 @@ func (c *ControlPlane) Start(ctx context.Context) error {
 	log := ctxslog.FromContext(ctx)
 	log.Info("Starting control plane")
 
-	err := workers.NewWorker(ctx, c.mrdsConn, c.temporalClient, c.runtimeActivities)
+	err := workers.NewWorker(ctx, c.mrdsConn, c.temporalClient, c.runtimeActivities, c.options.SchedulerProfile, c.options.ApprovalTimeout)
 	if err != nil {
 		return fmt.Errorf("failed to start worker: %w", err)
 	}
@@ -58,5 +88,63 @@ func (c *ControlPlane) Start(ctx context.Context) error {
 		}
 	}()
 
+	disruptionOperator := operators.NewDisruptionOperator(c.temporalClient, mrdspb.NewNodesClient(c.mrdsConn))
+	go func() {
+		err := disruptionOperator.RunBlocking(ctx)
+		if err != nil {
+			log.Error("failed to run disruption manager", "error", err)
+		}
+	}()
+
+	reconcilerOperator := operators.NewReconcilerOperator(mrdspb.NewMetaInstancesClient(c.mrdsConn), c.runtimeActivities, c.options.ReconcileInterval)
+	go func() {
+		err := reconcilerOperator.RunBlocking(ctx)
+		if err != nil {
+			log.Error("failed to run reconciler", "error", err)
+		}
+	}()
+
+	if c.options.Remediation.Budget > 0 {
+		remediationOperator := operators.NewRemediationOperator(mrdspb.NewMetaInstancesClient(c.mrdsConn), mrdspb.NewNodesClient(c.mrdsConn), c.options.Remediation)
+		go func() {
+			err := remediationOperator.RunBlocking(ctx)
+			if err != nil {
+				log.Error("failed to run remediation manager", "error", err)
+			}
+		}()
+	}
+
+	for _, target := range c.options.NodeSyncTargets {
+		nodeSyncOperator := operators.NewNodeSyncOperator(
+			mrdspb.NewNodesClient(c.mrdsConn),
+			mrdspb.NewComputeCapabilitiesClient(c.mrdsConn),
+			target.K8sClient,
+			target.ClusterID,
+			c.options.NodeSyncInterval,
+		)
+		go func() {
+			err := nodeSyncOperator.RunBlocking(ctx)
+			if err != nil {
+				log.Error("failed to run node sync", "cluster", target.ClusterID, "error", err)
+			}
+		}()
+	}
+
+	if len(c.options.ApprovalPolicies) > 0 {
+		engine := approver.NewEngine(
+			c.options.ApprovalPolicies,
+			mrdspb.NewMetaInstancesClient(c.mrdsConn),
+			mrdspb.NewDeploymentPlansClient(c.mrdsConn),
+			mrdspb.NewNodesClient(c.mrdsConn),
+		)
+		approvalOperator := operators.NewApprovalOperator(mrdspb.NewMetaInstancesClient(c.mrdsConn), engine)
+		go func() {
+			err := approvalOperator.RunBlocking(ctx)
+			if err != nil {
+				log.Error("failed to run approval manager", "error", err)
+			}
+		}()
+	}
+
 	return nil
 }
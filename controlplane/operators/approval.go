@@ -0,0 +1,89 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/controlplane/approver"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// approvalOperator approves the PENDING_APPROVAL operations which are allowed by the approval policies.
+type approvalOperator struct {
+	metaInstancesClient mrdspb.MetaInstancesClient
+	engine              *approver.Engine
+}
+
+func NewApprovalOperator(metaInstancesClient mrdspb.MetaInstancesClient, engine *approver.Engine) Operator {
+	return &approvalOperator{
+		metaInstancesClient: metaInstancesClient,
+		engine:              engine,
+	}
+}
+
+func (a *approvalOperator) RunBlocking(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	ticker, stop := newImmediatelyFiringTicker(10 * time.Second)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled, stopping approval manager")
+			return nil
+		case <-ticker:
+			listResp, err := a.metaInstancesClient.List(ctx, &mrdspb.ListMetaInstanceRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list meta instances: %w", err)
+			}
+
+			for _, metaInstance := range listResp.Records {
+				for _, operation := range metaInstance.Operations {
+					if operation.Status.State != mrdspb.OperationState_OperationState_PENDING_APPROVAL {
+						continue
+					}
+					// Approving an operation changes the version of the meta instance, so at most one operation
+					// of a meta instance is approved on each tick.
+					approved, err := a.evaluate(ctx, metaInstance, operation)
+					if err != nil {
+						logger.Error("failed to evaluate operation", "metaInstance", metaInstance.Name, "operationID", operation.Id, "error", err)
+						break
+					}
+					if approved {
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func (a *approvalOperator) evaluate(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) (bool, error) {
+	log := ctxslog.FromContext(ctx)
+
+	decision, err := a.engine.Evaluate(ctx, metaInstance, operation)
+	if err != nil {
+		return false, err
+	}
+	if !decision.Approved {
+		log.Debug("Operation held", "metaInstance", metaInstance.Name, "operationID", operation.Id, "reason", decision.Reason)
+		return false, nil
+	}
+
+	_, err = a.metaInstancesClient.UpdateOperationStatus(ctx, &mrdspb.UpdateOperationStatusRequest{
+		Metadata:    metaInstance.Metadata,
+		OperationId: operation.Id,
+		Status: &mrdspb.OperationStatus{
+			State:   mrdspb.OperationState_OperationState_APPROVED,
+			Message: fmt.Sprintf("Approved by policy %s", decision.Policy),
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to approve operation: %w", err)
+	}
+	log.Info("Approved operation", "metaInstance", metaInstance.Name, "operationID", operation.Id, "policy", decision.Policy)
+	return true, nil
+}
@@ -2,15 +2,18 @@ package operators
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/msanath/gondolf/pkg/ctxslog"
 	"github.com/msanath/mrds/controlplane/temporal/workers"
 	"github.com/msanath/mrds/controlplane/temporal/workflows"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
 )
 
 type deploymentOperator struct {
@@ -28,45 +31,54 @@ func NewDeploymentOperator(tc temporalclient.Client, deploymentPlansClient mrdsp
 func (d *deploymentOperator) RunBlocking(ctx context.Context) error {
 	logger := ctxslog.FromContext(ctx)
 
-	ticker, stop := newImmediatelyFiringTicker(10 * time.Second)
-	defer stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("Context cancelled, stopping deployment manager")
-			return nil
-		case <-ticker:
-			// Get all deployment plans
-			listResp, err := d.deploymentPlansClient.List(ctx, &mrdspb.ListDeploymentPlanRequest{})
-			if err != nil {
-				return fmt.Errorf("failed to list deployment plans: %w", err)
+	err := watch(ctx,
+		func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[mrdspb.WatchDeploymentPlanResponse], error) {
+			return d.deploymentPlansClient.Watch(ctx, &mrdspb.WatchDeploymentPlanRequest{AfterSequence: afterSequence})
+		},
+		func(ctx context.Context, event *mrdspb.WatchDeploymentPlanResponse) error {
+			if event.Type == mrdspb.ChangeType_ChangeType_DELETE {
+				return nil
 			}
+			return d.handleDeploymentPlan(ctx, event.Record)
+		},
+	)
+	logger.Info("Context cancelled, stopping deployment manager")
+	return err
+}
 
-			for _, plan := range listResp.Records {
-				if plan.Status.State == mrdspb.DeploymentPlanState_DeploymentPlanState_ACTIVE {
-					for _, deployment := range plan.Deployments {
-						if deployment.Status.State == mrdspb.DeploymentState_DeploymentState_PENDING {
-							err := d.executeWorkflows(ctx, plan, deployment)
-							if err != nil {
-								return fmt.Errorf("failed to execute workflows: %w", err)
-							}
-						}
-					}
-				}
+// handleDeploymentPlan starts the workflows of the deployments of an ACTIVE deployment plan which are not
+// completed.
+func (d *deploymentOperator) handleDeploymentPlan(ctx context.Context, plan *mrdspb.DeploymentPlanRecord) error {
+	if plan.Status.State != mrdspb.DeploymentPlanState_DeploymentPlanState_ACTIVE {
+		return nil
+	}
+	for _, deployment := range plan.Deployments {
+		// IN_PROGRESS and PAUSED deployments are started again so that a deployment whose workflow
+		// is gone, for example after a controlplane restart, resumes from its recorded progress. The
+		// deployment plans are handled again whenever the watch is resynced.
+		if deployment.Status.State == mrdspb.DeploymentState_DeploymentState_PENDING ||
+			deployment.Status.State == mrdspb.DeploymentState_DeploymentState_IN_PROGRESS ||
+			deployment.Status.State == mrdspb.DeploymentState_DeploymentState_PAUSED {
+			err := d.executeWorkflows(ctx, plan, deployment)
+			if err != nil {
+				return fmt.Errorf("failed to execute workflows: %w", err)
 			}
 		}
 	}
+	return nil
 }
 
 func (m *deploymentOperator) executeWorkflows(ctx context.Context, deploymentPlan *mrdspb.DeploymentPlanRecord, deployment *mrdspb.Deployment) error {
 	log := ctxslog.FromContext(ctx)
 
+	// A failed workflow marks its deployment FAILED, so that the failed workflow of a deployment which is
+	// still in progress was terminated or timed out, and is started again to resume the deployment once the
+	// watch is resynced.
 	we, err := m.tc.ExecuteWorkflow(ctx,
 		temporalclient.StartWorkflowOptions{
-			ID:                    fmt.Sprintf("%s-%s", deploymentPlan.Name, deployment.Id),
+			ID:                    signals.DeploymentWorkflowID(deploymentPlan.Name, deployment.Id),
 			TaskQueue:             workers.DeploymentTaskQueue,
-			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
 		},
 		workflows.RunDeploymentWorkflowName,
 		&workflows.RunDeploymentWorkflowParams{
@@ -75,6 +87,10 @@ func (m *deploymentOperator) executeWorkflows(ctx context.Context, deploymentPla
 		},
 	)
 	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
 		return fmt.Errorf("failed to start workflow: %w", err)
 	}
 	log.Info("Started workflow", "workflowID", we.GetID())
@@ -0,0 +1,56 @@
+package operators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentRestartsTerminatedWorkflow(t *testing.T) {
+	watchResyncInterval = 50 * time.Millisecond
+	t.Cleanup(func() { watchResyncInterval = time.Minute })
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deploymentPlans := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	metaInstance := createTestMetaInstance(t, ts, nil, false)
+	getResp, err := deploymentPlans.GetByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: metaInstance.DeploymentPlanId})
+	require.NoError(t, err)
+	_, err = deploymentPlans.UpdateDeploymentStatus(ctx, &mrdspb.UpdateDeploymentStatusRequest{
+		Metadata:     getResp.Record.Metadata,
+		DeploymentId: "deployment-1",
+		Status:       &mrdspb.DeploymentStatus{State: mrdspb.DeploymentState_DeploymentState_IN_PROGRESS},
+	})
+	require.NoError(t, err)
+
+	tc := &startClient{}
+	operator := NewDeploymentOperator(tc, deploymentPlans)
+	done := make(chan error)
+	go func() {
+		done <- operator.RunBlocking(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(tc.Started()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The workflow is terminated without marking its deployment FAILED, and is started again to resume the
+	// deployment without any other change to the plan.
+	tc.Fail("plan-deployment-1")
+	require.Eventually(t, func() bool {
+		return len(tc.Started()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"plan-deployment-1", "plan-deployment-1"}, tc.Started())
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,85 @@
+package operators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/controlplane/temporal/workers"
+	"github.com/msanath/mrds/controlplane/temporal/workflows"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+)
+
+// disruptionOperator starts a disruption workflow for every SCHEDULED disruption of a node.
+type disruptionOperator struct {
+	tc          temporalclient.Client
+	nodesClient mrdspb.NodesClient
+}
+
+func NewDisruptionOperator(tc temporalclient.Client, nodesClient mrdspb.NodesClient) Operator {
+	return &disruptionOperator{
+		tc:          tc,
+		nodesClient: nodesClient,
+	}
+}
+
+func (d *disruptionOperator) RunBlocking(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	err := watch(ctx,
+		func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[mrdspb.WatchNodeResponse], error) {
+			return d.nodesClient.Watch(ctx, &mrdspb.WatchNodeRequest{AfterSequence: afterSequence})
+		},
+		func(ctx context.Context, event *mrdspb.WatchNodeResponse) error {
+			if event.Type == mrdspb.ChangeType_ChangeType_DELETE {
+				return nil
+			}
+			for _, disruption := range event.Record.Disruptions {
+				if disruption.Status.State == mrdspb.DisruptionState_DisruptionState_SCHEDULED {
+					err := d.executeWorkflows(ctx, event.Record, disruption)
+					if err != nil {
+						return fmt.Errorf("failed to execute workflows: %w", err)
+					}
+				}
+			}
+			return nil
+		},
+	)
+	logger.Info("Context cancelled, stopping disruption manager")
+	return err
+}
+
+func (d *disruptionOperator) executeWorkflows(ctx context.Context, node *mrdspb.Node, disruption *mrdspb.NodeDisruption) error {
+	log := ctxslog.FromContext(ctx)
+
+	// The disruption stays SCHEDULED when its node could not be drained, so that the failed workflow is started
+	// again to retry the drain when the watch is resynced.
+	we, err := d.tc.ExecuteWorkflow(ctx,
+		temporalclient.StartWorkflowOptions{
+			ID:                    fmt.Sprintf("%s-%s", node.Name, disruption.Id),
+			TaskQueue:             workers.DeploymentTaskQueue,
+			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+		},
+		workflows.RunDisruptionWorkflowName,
+		&workflows.RunDisruptionWorkflowParams{
+			NodeID:       node.Metadata.Id,
+			DisruptionID: disruption.Id,
+		},
+	)
+	if err != nil {
+		// The disruption stays SCHEDULED while its node is drained, so the workflow may already be running.
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
+		return fmt.Errorf("failed to start workflow: %w", err)
+	}
+	log.Info("Started workflow", "workflowID", we.GetID())
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package operators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisruptionRestartsFailedDrain(t *testing.T) {
+	watchResyncInterval = 50 * time.Millisecond
+	t.Cleanup(func() { watchResyncInterval = time.Minute })
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+	createResp, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+		Name:                    "node-1",
+		UpdateDomain:            "ud-1",
+		TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+		SystemReservedResources: &mrdspb.Resources{},
+	})
+	require.NoError(t, err)
+	_, err = nodes.AddDisruption(ctx, &mrdspb.AddDisruptionRequest{
+		Metadata: createResp.Record.Metadata,
+		Disruption: &mrdspb.NodeDisruption{
+			Id:     "disruption-1",
+			Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+		},
+	})
+	require.NoError(t, err)
+
+	tc := &startClient{}
+	operator := NewDisruptionOperator(tc, nodes)
+	done := make(chan error)
+	go func() {
+		done <- operator.RunBlocking(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(tc.Started()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The running workflow is not started again when the watch is resynced.
+	time.Sleep(3 * watchResyncInterval)
+	require.Len(t, tc.Started(), 1)
+
+	// The drain fails, leaving the disruption SCHEDULED, and its workflow is started again without any other
+	// change to the node.
+	tc.Fail("node-1-disruption-1")
+	require.Eventually(t, func() bool {
+		return len(tc.Started()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"node-1-disruption-1", "node-1-disruption-1"}, tc.Started())
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,268 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// DefaultNodeSyncInterval is how often the nodes of a cluster are synced from kubernetes.
+const DefaultNodeSyncInterval = time.Minute
+
+// nodeSyncOperator syncs the nodes of a cluster from the node objects of its kubernetes API. Every kubernetes
+// node is recorded as an ALLOCATED node of the cluster, with the same name, its capacity as the total
+// resources and its allocatable resources as the remaining resources. The zone label of the kubernetes node
+// is its update domain, and each of its labels selects the compute capability whose type is the label key and
+// whose name is the label value. The nodes of the cluster which are no longer in kubernetes are EVICTED.
+type nodeSyncOperator struct {
+	nodesClient               mrdspb.NodesClient
+	computeCapabilitiesClient mrdspb.ComputeCapabilitiesClient
+	k8sClient                 k8s.Interface
+	clusterID                 string
+	interval                  time.Duration
+}
+
+func NewNodeSyncOperator(
+	nodesClient mrdspb.NodesClient,
+	computeCapabilitiesClient mrdspb.ComputeCapabilitiesClient,
+	k8sClient k8s.Interface,
+	clusterID string,
+	interval time.Duration,
+) Operator {
+	return &nodeSyncOperator{
+		nodesClient:               nodesClient,
+		computeCapabilitiesClient: computeCapabilitiesClient,
+		k8sClient:                 k8sClient,
+		clusterID:                 clusterID,
+		interval:                  interval,
+	}
+}
+
+func (s *nodeSyncOperator) RunBlocking(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	ticker, stop := newImmediatelyFiringTicker(s.interval)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled, stopping node sync", "cluster", s.clusterID)
+			return nil
+		case <-ticker:
+			// The kubernetes API of the cluster may be unreachable for a while, so that the sync is retried
+			// on the next tick.
+			err := s.sync(ctx)
+			if err != nil {
+				logger.Error("failed to sync nodes", "cluster", s.clusterID, "error", err)
+			}
+		}
+	}
+}
+
+// sync creates or updates the node of every kubernetes node, and evicts the nodes of the cluster which are
+// no longer in kubernetes.
+func (s *nodeSyncOperator) sync(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	k8sNodes, err := s.k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list kubernetes nodes: %w", err)
+	}
+	capabilitiesResp, err := s.computeCapabilitiesClient.List(ctx, &mrdspb.ListComputeCapabilityRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list compute capabilities: %w", err)
+	}
+
+	names := make([]string, 0, len(k8sNodes.Items))
+	for _, k8sNode := range k8sNodes.Items {
+		names = append(names, k8sNode.Name)
+	}
+	nodes := make(map[string]*mrdspb.Node)
+	if len(names) > 0 {
+		listResp, err := s.nodesClient.List(ctx, &mrdspb.ListNodeRequest{NameIn: names})
+		if err != nil {
+			return fmt.Errorf("failed to list nodes: %w", err)
+		}
+		for _, node := range listResp.Records {
+			nodes[node.Name] = node
+		}
+	}
+
+	for i := range k8sNodes.Items {
+		k8sNode := &k8sNodes.Items[i]
+		err := s.syncNode(ctx, k8sNode, nodes[k8sNode.Name], capabilitiesResp.Records)
+		if err != nil {
+			logger.Error("failed to sync node", "cluster", s.clusterID, "node", k8sNode.Name, "error", err)
+		}
+	}
+
+	listResp, err := s.nodesClient.List(ctx, &mrdspb.ListNodeRequest{
+		ClusterIdIn: []string{s.clusterID},
+		StateIn:     []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes of cluster %s: %w", s.clusterID, err)
+	}
+	for _, node := range listResp.Records {
+		if slices.Contains(names, node.Name) {
+			continue
+		}
+		logger.Info("Node removed from kubernetes, evicting it", "cluster", s.clusterID, "node", node.Name)
+		_, err := s.nodesClient.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+			Metadata: node.Metadata,
+			Status: &mrdspb.NodeStatus{
+				State:   mrdspb.NodeState_NodeState_EVICTED,
+				Message: fmt.Sprintf("Node not found in kubernetes cluster %s", s.clusterID),
+			},
+		})
+		if err != nil {
+			logger.Error("failed to evict node", "cluster", s.clusterID, "node", node.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+// syncNode creates the node of the kubernetes node when it has none, syncs its resources, update domain and
+// capabilities, and allocates it to the cluster. Nodes allocated to another cluster, and nodes which are
+// EVICTED or SANITIZING, are left alone until they are UNALLOCATED.
+func (s *nodeSyncOperator) syncNode(
+	ctx context.Context, k8sNode *corev1.Node, node *mrdspb.Node, capabilities []*mrdspb.ComputeCapability,
+) error {
+	logger := ctxslog.FromContext(ctx)
+
+	updateDomain, total, systemReserved := nodeSpec(k8sNode, s.clusterID)
+	capabilityIDs := labelCapabilityIDs(k8sNode.Labels, capabilities)
+
+	if node == nil {
+		logger.Info("Node added to kubernetes, creating it", "cluster", s.clusterID, "node", k8sNode.Name)
+		createResp, err := s.nodesClient.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    k8sNode.Name,
+			UpdateDomain:            updateDomain,
+			TotalResources:          total,
+			SystemReservedResources: systemReserved,
+			CapabilityIds:           capabilityIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create node: %w", err)
+		}
+		node = createResp.Record
+	}
+
+	switch node.Status.State {
+	case mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED:
+		if node.ClusterId != s.clusterID {
+			return fmt.Errorf("node is allocated to cluster %s", node.ClusterId)
+		}
+	case mrdspb.NodeState_NodeState_UNALLOCATED:
+	default:
+		return nil
+	}
+
+	if node.UpdateDomain != updateDomain ||
+		node.TotalResources.GetCores() != total.Cores || node.TotalResources.GetMemory() != total.Memory ||
+		node.SystemReservedResources.GetCores() != systemReserved.Cores || node.SystemReservedResources.GetMemory() != systemReserved.Memory {
+		logger.Info("Node changed in kubernetes, updating it", "cluster", s.clusterID, "node", node.Name)
+		updateResp, err := s.nodesClient.UpdateSpec(ctx, &mrdspb.UpdateNodeSpecRequest{
+			Metadata:                node.Metadata,
+			UpdateDomain:            updateDomain,
+			TotalResources:          total,
+			SystemReservedResources: systemReserved,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update node spec: %w", err)
+		}
+		node = updateResp.Record
+	}
+
+	for _, capabilityID := range node.CapabilityIds {
+		if slices.Contains(capabilityIDs, capabilityID) {
+			continue
+		}
+		updateResp, err := s.nodesClient.RemoveCapability(ctx, &mrdspb.RemoveCapabilityRequest{
+			Metadata:     node.Metadata,
+			CapabilityId: capabilityID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove capability %s: %w", capabilityID, err)
+		}
+		node = updateResp.Record
+	}
+	for _, capabilityID := range capabilityIDs {
+		if slices.Contains(node.CapabilityIds, capabilityID) {
+			continue
+		}
+		updateResp, err := s.nodesClient.AddCapability(ctx, &mrdspb.AddCapabilityRequest{
+			Metadata:     node.Metadata,
+			CapabilityId: capabilityID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add capability %s: %w", capabilityID, err)
+		}
+		node = updateResp.Record
+	}
+
+	states := map[mrdspb.NodeState]mrdspb.NodeState{
+		mrdspb.NodeState_NodeState_UNALLOCATED: mrdspb.NodeState_NodeState_ALLOCATING,
+		mrdspb.NodeState_NodeState_ALLOCATING:  mrdspb.NodeState_NodeState_ALLOCATED,
+	}
+	for next, ok := states[node.Status.State]; ok; next, ok = states[node.Status.State] {
+		updateResp, err := s.nodesClient.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+			Metadata:  node.Metadata,
+			Status:    &mrdspb.NodeStatus{State: next},
+			ClusterId: s.clusterID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update node status to %s: %w", next, err)
+		}
+		node = updateResp.Record
+	}
+	return nil
+}
+
+// nodeSpec returns the update domain, total resources and system reserved resources of the node of the
+// kubernetes node. The resources which are not allocatable are reserved for the system, so that the remaining
+// resources of a node without instances are the allocatable resources. Memory is in MB, and the update
+// domain is the cluster ID when the kubernetes node has no zone.
+func nodeSpec(k8sNode *corev1.Node, clusterID string) (string, *mrdspb.Resources, *mrdspb.Resources) {
+	updateDomain := k8sNode.Labels[corev1.LabelTopologyZone]
+	if updateDomain == "" {
+		updateDomain = clusterID
+	}
+
+	capacity := k8sNode.Status.Capacity
+	allocatable := k8sNode.Status.Allocatable
+	total := &mrdspb.Resources{
+		Cores:  uint32(capacity.Cpu().MilliValue() / 1000),
+		Memory: uint32(capacity.Memory().Value() >> 20),
+	}
+	available := &mrdspb.Resources{
+		Cores:  min(uint32(allocatable.Cpu().MilliValue()/1000), total.Cores),
+		Memory: min(uint32(allocatable.Memory().Value()>>20), total.Memory),
+	}
+	systemReserved := &mrdspb.Resources{
+		Cores:  total.Cores - available.Cores,
+		Memory: total.Memory - available.Memory,
+	}
+	return updateDomain, total, systemReserved
+}
+
+// labelCapabilityIDs returns the IDs of the compute capabilities selected by the labels, which are those whose
+// type is a label key and whose name is the value of that label.
+func labelCapabilityIDs(labels map[string]string, capabilities []*mrdspb.ComputeCapability) []string {
+	var ids []string
+	for _, capability := range capabilities {
+		value, ok := labels[capability.Type]
+		if ok && value == capability.Name {
+			ids = append(ids, capability.Metadata.Id)
+		}
+	}
+	return ids
+}
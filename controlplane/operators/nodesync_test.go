@@ -0,0 +1,150 @@
+package operators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newK8sNode returns a kubernetes node with the labels, whose capacity is 4 cores and 8Gi of memory, and whose
+// allocatable resources are the given cpu and memory.
+func newK8sNode(name string, labels map[string]string, cpu string, memory string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func TestNodeSync(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx := context.Background()
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+	computeCapabilities := mrdspb.NewComputeCapabilitiesClient(ts.Conn())
+
+	capabilityIDs := make(map[string]string)
+	for name, capabilityType := range map[string]string{"amd64": corev1.LabelArchStable, "arm64": corev1.LabelArchStable, "ssd": "disktype"} {
+		createResp, err := computeCapabilities.Create(ctx, &mrdspb.CreateComputeCapabilityRequest{Name: name, Type: capabilityType})
+		require.NoError(t, err)
+		capabilityIDs[name] = createResp.Record.Metadata.Id
+	}
+
+	// node-3 is allocated to another cluster.
+	createResp, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+		Name:                    "node-3",
+		UpdateDomain:            "ud-1",
+		TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+		SystemReservedResources: &mrdspb.Resources{},
+	})
+	require.NoError(t, err)
+	metadata := createResp.Record.Metadata
+	for _, state := range []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED} {
+		updateResp, err := nodes.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+			Metadata:  metadata,
+			Status:    &mrdspb.NodeStatus{State: state},
+			ClusterId: "cluster-2",
+		})
+		require.NoError(t, err)
+		metadata = updateResp.Record.Metadata
+	}
+
+	k8sClient := fake.NewSimpleClientset(
+		newK8sNode("node-1", map[string]string{corev1.LabelTopologyZone: "zone-a", corev1.LabelArchStable: "amd64", "disktype": "ssd"}, "3500m", "7Gi"),
+		newK8sNode("node-2", map[string]string{corev1.LabelArchStable: "arm64"}, "4", "8Gi"),
+		newK8sNode("node-3", nil, "4", "8Gi"),
+	)
+	s := &nodeSyncOperator{
+		nodesClient:               nodes,
+		computeCapabilitiesClient: computeCapabilities,
+		k8sClient:                 k8sClient,
+		clusterID:                 "cluster-1",
+		interval:                  time.Minute,
+	}
+	getNode := func(name string) *mrdspb.Node {
+		getResp, err := nodes.GetByName(ctx, &mrdspb.GetNodeByNameRequest{Name: name})
+		require.NoError(t, err)
+		return getResp.Record
+	}
+
+	t.Run("Kubernetes nodes are created", func(t *testing.T) {
+		require.NoError(t, s.sync(ctx))
+
+		node := getNode("node-1")
+		require.Equal(t, mrdspb.NodeState_NodeState_ALLOCATED, node.Status.State)
+		require.Equal(t, "cluster-1", node.ClusterId)
+		require.Equal(t, "zone-a", node.UpdateDomain)
+		require.Equal(t, uint32(4), node.TotalResources.Cores)
+		require.Equal(t, uint32(8192), node.TotalResources.Memory)
+		require.Equal(t, uint32(1), node.SystemReservedResources.Cores)
+		require.Equal(t, uint32(1024), node.SystemReservedResources.Memory)
+		require.Equal(t, uint32(3), node.RemainingResources.Cores)
+		require.Equal(t, uint32(7168), node.RemainingResources.Memory)
+		require.ElementsMatch(t, []string{capabilityIDs["amd64"], capabilityIDs["ssd"]}, node.CapabilityIds)
+
+		node = getNode("node-2")
+		require.Equal(t, mrdspb.NodeState_NodeState_ALLOCATED, node.Status.State)
+		require.Equal(t, "cluster-1", node.UpdateDomain)
+		require.Equal(t, uint32(0), node.SystemReservedResources.Cores)
+		require.Equal(t, []string{capabilityIDs["arm64"]}, node.CapabilityIds)
+	})
+
+	t.Run("Node of another cluster is left alone", func(t *testing.T) {
+		node := getNode("node-3")
+		require.Equal(t, metadata, node.Metadata)
+		require.Equal(t, "cluster-2", node.ClusterId)
+	})
+
+	t.Run("Unchanged nodes are not updated", func(t *testing.T) {
+		before := getNode("node-1")
+		require.NoError(t, s.sync(ctx))
+		require.Equal(t, before.Metadata, getNode("node-1").Metadata)
+	})
+
+	t.Run("Changed kubernetes node is updated", func(t *testing.T) {
+		_, err := k8sClient.CoreV1().Nodes().Update(ctx,
+			newK8sNode("node-1", map[string]string{corev1.LabelTopologyZone: "zone-b", corev1.LabelArchStable: "arm64"}, "2", "6Gi"),
+			metav1.UpdateOptions{},
+		)
+		require.NoError(t, err)
+		require.NoError(t, s.sync(ctx))
+
+		node := getNode("node-1")
+		require.Equal(t, mrdspb.NodeState_NodeState_ALLOCATED, node.Status.State)
+		require.Equal(t, "zone-b", node.UpdateDomain)
+		require.Equal(t, uint32(2), node.SystemReservedResources.Cores)
+		require.Equal(t, uint32(2048), node.SystemReservedResources.Memory)
+		require.Equal(t, uint32(2), node.RemainingResources.Cores)
+		require.Equal(t, uint32(6144), node.RemainingResources.Memory)
+		require.Equal(t, []string{capabilityIDs["arm64"]}, node.CapabilityIds)
+	})
+
+	t.Run("Removed kubernetes node is evicted", func(t *testing.T) {
+		require.NoError(t, k8sClient.CoreV1().Nodes().Delete(ctx, "node-2", metav1.DeleteOptions{}))
+		require.NoError(t, s.sync(ctx))
+
+		node := getNode("node-2")
+		require.Equal(t, mrdspb.NodeState_NodeState_EVICTED, node.Status.State)
+		require.Equal(t, "Node not found in kubernetes cluster cluster-1", node.Status.Message)
+		require.Equal(t, mrdspb.NodeState_NodeState_ALLOCATED, getNode("node-1").Status.State)
+	})
+}
@@ -2,15 +2,18 @@ package operators
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/msanath/gondolf/pkg/ctxslog"
 	"github.com/msanath/mrds/controlplane/temporal/workers"
 	"github.com/msanath/mrds/controlplane/temporal/workflows"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
 )
 
 type operationsOperator struct {
@@ -28,33 +31,30 @@ func NewOperationsOperator(tc temporalclient.Client, metaInstancesClient mrdspb.
 func (d *operationsOperator) RunBlocking(ctx context.Context) error {
 	logger := ctxslog.FromContext(ctx)
 
-	ticker, stop := newImmediatelyFiringTicker(10 * time.Second)
-	defer stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("Context cancelled, stopping deployment manager")
-			return nil
-		case <-ticker:
-			// Get all meta instances
-			listResp, err := d.metaInstancesClient.List(ctx, &mrdspb.ListMetaInstanceRequest{})
-			if err != nil {
-				return fmt.Errorf("failed to list meta instances: %w", err)
+	err := watch(ctx,
+		func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[mrdspb.WatchMetaInstanceResponse], error) {
+			return d.metaInstancesClient.Watch(ctx, &mrdspb.WatchMetaInstanceRequest{AfterSequence: afterSequence})
+		},
+		func(ctx context.Context, event *mrdspb.WatchMetaInstanceResponse) error {
+			if event.Type == mrdspb.ChangeType_ChangeType_DELETE {
+				return nil
 			}
-
-			for _, metaInstance := range listResp.Records {
-				for _, operation := range metaInstance.Operations {
-					if operation.Status.State == mrdspb.OperationState_OperationState_PENDING {
-						err := d.executeWorkflows(ctx, metaInstance, operation)
-						if err != nil {
-							return fmt.Errorf("failed to execute workflows: %w", err)
-						}
+			// A failure is logged rather than returned, so that a single operation does not hold back the
+			// events of all the others. The operation is retried on the next event of its meta instance, or
+			// when the watch is resynced.
+			for _, operation := range event.Record.Operations {
+				if operation.Status.State == mrdspb.OperationState_OperationState_PENDING {
+					err := d.executeWorkflows(ctx, event.Record, operation)
+					if err != nil {
+						logger.Error("failed to execute workflows", "metaInstance", event.Record.Name, "operation", operation.Id, "error", err)
 					}
 				}
 			}
-		}
-	}
+			return nil
+		},
+	)
+	logger.Info("Context cancelled, stopping operations manager")
+	return err
 }
 
 func (m *operationsOperator) executeWorkflows(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) error {
@@ -62,7 +62,7 @@ func (m *operationsOperator) executeWorkflows(ctx context.Context, metaInstance
 
 	we, err := m.tc.ExecuteWorkflow(ctx,
 		temporalclient.StartWorkflowOptions{
-			ID:                    fmt.Sprintf("%s-%s", metaInstance.Name, operation.Id),
+			ID:                    signals.OperationWorkflowID(metaInstance.Name, operation.Id),
 			TaskQueue:             workers.DeploymentTaskQueue,
 			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
 		},
@@ -75,6 +75,11 @@ func (m *operationsOperator) executeWorkflows(ctx context.Context, metaInstance
 	)
 
 	if err != nil {
+		// The operation stays PENDING until its workflow updates it, so the workflow may already be running.
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
 		return fmt.Errorf("failed to start workflow: %w", err)
 	}
 	log.Info("Started workflow", "workflowID", we.GetID())
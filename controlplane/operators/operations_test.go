@@ -0,0 +1,107 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// startedRun is the run of a workflow started by a startClient.
+type startedRun struct {
+	temporalclient.WorkflowRun
+	id string
+}
+
+func (r *startedRun) GetID() string { return r.id }
+
+// startClient is a Temporal client which records the workflows started through it. The first starts, as many
+// as failures, fail. A workflow runs until it is failed with Fail, and cannot be started again while it runs.
+type startClient struct {
+	temporalclient.Client
+
+	mu       sync.Mutex
+	failures int
+	attempts []string
+	started  []string
+	running  map[string]bool
+}
+
+func (c *startClient) ExecuteWorkflow(ctx context.Context, options temporalclient.StartWorkflowOptions, workflow interface{}, args ...interface{}) (temporalclient.WorkflowRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts = append(c.attempts, options.ID)
+	if len(c.attempts) <= c.failures {
+		return nil, fmt.Errorf("temporal is unavailable")
+	}
+	if c.running[options.ID] {
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted("workflow is running", "", "")
+	}
+	if c.running == nil {
+		c.running = make(map[string]bool)
+	}
+	c.running[options.ID] = true
+	c.started = append(c.started, options.ID)
+	return &startedRun{id: options.ID}, nil
+}
+
+// Fail fails the running workflow.
+func (c *startClient) Fail(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.running, id)
+}
+
+func (c *startClient) Started() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.started...)
+}
+
+func TestOperationsRetriesFailedStart(t *testing.T) {
+	watchResyncInterval = 50 * time.Millisecond
+	t.Cleanup(func() { watchResyncInterval = time.Minute })
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+	metaInstance := createTestMetaInstance(t, ts, nil, false)
+	_, err = metaInstances.AddOperation(ctx, &mrdspb.AddOperationRequest{
+		Metadata: metaInstance.Metadata,
+		Operation: &mrdspb.Operation{
+			Id:       "CREATE-1",
+			Type:     mrdspb.OperationType_OperationType_CREATE,
+			IntentId: "deployment-1",
+			Status:   &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_PENDING},
+		},
+	})
+	require.NoError(t, err)
+
+	tc := &startClient{failures: 1}
+	operator := NewOperationsOperator(tc, metaInstances)
+	done := make(chan error)
+	go func() {
+		done <- operator.RunBlocking(ctx)
+	}()
+
+	// The failed start is retried once the watch is resynced, with no other change to the meta instance.
+	require.Eventually(t, func() bool {
+		return len(tc.Started()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, "plan-0-CREATE-1", tc.Started()[0])
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,130 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// DefaultReconcileInterval is how often the status of the runtime instances is synced from the runtime.
+const DefaultReconcileInterval = 30 * time.Second
+
+// reconcilerOperator syncs the status observed by the runtime into the ledger, so that a runtime instance
+// which crashes after it was started does not stay RUNNING.
+type reconcilerOperator struct {
+	metaInstancesClient mrdspb.MetaInstancesClient
+	runtimeActivities   runtime.RuntimeActivities
+	interval            time.Duration
+}
+
+func NewReconcilerOperator(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	runtimeActivities runtime.RuntimeActivities,
+	interval time.Duration,
+) Operator {
+	return &reconcilerOperator{
+		metaInstancesClient: metaInstancesClient,
+		runtimeActivities:   runtimeActivities,
+		interval:            interval,
+	}
+}
+
+func (r *reconcilerOperator) RunBlocking(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	ticker, stop := newImmediatelyFiringTicker(r.interval)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled, stopping reconciler")
+			return nil
+		case <-ticker:
+			listResp, err := r.metaInstancesClient.List(ctx, &mrdspb.ListMetaInstanceRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list meta instances: %w", err)
+			}
+
+			for _, metaInstance := range listResp.Records {
+				// The operation workflows update the status of the runtime instances they start and stop.
+				if hasOperationInFlight(metaInstance) {
+					continue
+				}
+				err := r.reconcile(ctx, metaInstance)
+				if err != nil {
+					logger.Error("failed to reconcile meta instance", "metaInstance", metaInstance.Name, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// reconcile updates the status of every started runtime instance of the meta instance which differs from the
+// status observed by the runtime.
+func (r *reconcilerOperator) reconcile(ctx context.Context, metaInstance *mrdspb.MetaInstance) error {
+	logger := ctxslog.FromContext(ctx)
+
+	metadata := metaInstance.Metadata
+	for _, ri := range metaInstance.RuntimeInstances {
+		if !isStarted(ri) {
+			continue
+		}
+
+		resp, err := r.runtimeActivities.GetInstanceStatus(ctx, &runtime.RuntimeActivityRequest{
+			MetaInstanceID:    metaInstance.Metadata.Id,
+			RuntimeInstanceID: ri.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get status of runtime instance %s: %w", ri.Id, err)
+		}
+		if resp.Status.State == ri.Status.State && resp.Status.Ready == ri.Status.Ready {
+			continue
+		}
+
+		logger.Info("Runtime instance status changed",
+			"metaInstance", metaInstance.Name, "runtimeInstance", ri.Id,
+			"state", resp.Status.State, "ready", resp.Status.Ready,
+		)
+		updateResp, err := r.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+			Metadata:          metadata,
+			RuntimeInstanceId: ri.Id,
+			Status:            resp.Status,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update status of runtime instance %s: %w", ri.Id, err)
+		}
+		metadata = updateResp.Record.Metadata
+	}
+	return nil
+}
+
+// hasOperationInFlight returns true when an operation of the meta instance has neither SUCCEEDED nor FAILED.
+func hasOperationInFlight(metaInstance *mrdspb.MetaInstance) bool {
+	for _, operation := range metaInstance.Operations {
+		switch operation.Status.State {
+		case mrdspb.OperationState_OperationState_SUCCEEDED, mrdspb.OperationState_OperationState_FAILED:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// isStarted returns true for the runtime instances which were started and not stopped. The runtime owns their
+// status, while PENDING instances are not started yet and TERMINATING or TERMINATED instances were stopped.
+func isStarted(ri *mrdspb.RuntimeInstance) bool {
+	switch ri.Status.State {
+	case mrdspb.RuntimeInstanceState_RuntimeState_STARTING,
+		mrdspb.RuntimeInstanceState_RuntimeState_RUNNING,
+		mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+		mrdspb.RuntimeInstanceState_RuntimeState_UNKNOWN:
+		return true
+	default:
+		return false
+	}
+}
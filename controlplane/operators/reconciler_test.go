@@ -0,0 +1,209 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/worker"
+)
+
+// statusRuntime is a runtime which reports a fixed status for each runtime instance.
+type statusRuntime struct {
+	statuses map[string]*mrdspb.RuntimeInstanceStatus
+}
+
+func (r *statusRuntime) Register(registry worker.Registry) {}
+
+func (r *statusRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *statusRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *statusRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	status, ok := r.statuses[req.RuntimeInstanceID]
+	if !ok {
+		return nil, fmt.Errorf("unexpected status request for runtime instance %s", req.RuntimeInstanceID)
+	}
+	return &runtime.RuntimeInstanceStatusResponse{Status: status}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	running := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true}
+	crashed := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_FAILED, Message: "Pod not found"}
+	terminated := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED}
+
+	testCases := []struct {
+		name          string
+		ledger        []*mrdspb.RuntimeInstanceStatus // The status of each runtime instance in the ledger.
+		observed      []*mrdspb.RuntimeInstanceStatus // The status reported by the runtime, nil when it is not asked.
+		expect        []*mrdspb.RuntimeInstanceStatus
+		expectUpdated bool
+	}{
+		{
+			name:     "Crashed instance is marked FAILED",
+			ledger:   []*mrdspb.RuntimeInstanceStatus{running},
+			observed: []*mrdspb.RuntimeInstanceStatus{crashed},
+			expect:   []*mrdspb.RuntimeInstanceStatus{crashed},
+
+			expectUpdated: true,
+		},
+		{
+			name:     "Recovered instance is marked RUNNING",
+			ledger:   []*mrdspb.RuntimeInstanceStatus{crashed},
+			observed: []*mrdspb.RuntimeInstanceStatus{running},
+			expect:   []*mrdspb.RuntimeInstanceStatus{running},
+
+			expectUpdated: true,
+		},
+		{
+			name:     "Unchanged instance is not updated",
+			ledger:   []*mrdspb.RuntimeInstanceStatus{running},
+			observed: []*mrdspb.RuntimeInstanceStatus{running},
+			expect:   []*mrdspb.RuntimeInstanceStatus{running},
+		},
+		{
+			name:     "Stopped instance is not reconciled",
+			ledger:   []*mrdspb.RuntimeInstanceStatus{terminated, running},
+			observed: []*mrdspb.RuntimeInstanceStatus{nil, crashed},
+			expect:   []*mrdspb.RuntimeInstanceStatus{terminated, crashed},
+
+			expectUpdated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, err := testserver.NewTestServer()
+			require.NoError(t, err)
+			defer ts.Close()
+
+			ctx := context.Background()
+			metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+			metaInstance := createTestMetaInstance(t, ts, tc.ledger, false)
+
+			rt := &statusRuntime{statuses: make(map[string]*mrdspb.RuntimeInstanceStatus)}
+			for i, ri := range metaInstance.RuntimeInstances {
+				if tc.observed[i] != nil {
+					rt.statuses[ri.Id] = tc.observed[i]
+				}
+			}
+
+			r := &reconcilerOperator{
+				metaInstancesClient: metaInstances,
+				runtimeActivities:   rt,
+				interval:            time.Minute,
+			}
+			require.NoError(t, r.reconcile(ctx, metaInstance))
+
+			getResp, err := metaInstances.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstance.Metadata.Id})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectUpdated, getResp.Record.Metadata.Version != metaInstance.Metadata.Version)
+			require.Len(t, getResp.Record.RuntimeInstances, len(tc.expect))
+			for i, ri := range getResp.Record.RuntimeInstances {
+				require.Equal(t, tc.expect[i].State, ri.Status.State, ri.Id)
+				require.Equal(t, tc.expect[i].Ready, ri.Status.Ready, ri.Id)
+				require.Equal(t, tc.expect[i].Message, ri.Status.Message, ri.Id)
+			}
+		})
+	}
+}
+
+// createTestMetaInstance creates a meta instance of a deployment plan with a runtime instance in each of the
+// given states, on a node of its own. The last runtime instance is active. The nodes are ALLOCATED when
+// allocated is set.
+func createTestMetaInstance(t *testing.T, ts *testserver.TestServer, statuses []*mrdspb.RuntimeInstanceStatus, allocated bool) *mrdspb.MetaInstance {
+	ctx := context.Background()
+	metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+	deploymentPlans := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+
+	nodeIDs := make([]string, len(statuses))
+	for i := range statuses {
+		nodeResp, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    fmt.Sprintf("node-%d", i),
+			UpdateDomain:            "ud-1",
+			TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(t, err)
+		metadata := nodeResp.Record.Metadata
+		for _, state := range []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED} {
+			if !allocated {
+				break
+			}
+			updateNodeResp, err := nodes.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+				Metadata:  metadata,
+				Status:    &mrdspb.NodeStatus{State: state},
+				ClusterId: "cluster-1",
+			})
+			require.NoError(t, err)
+			metadata = updateNodeResp.Record.Metadata
+		}
+		nodeIDs[i] = nodeResp.Record.Metadata.Id
+	}
+
+	planResp, err := deploymentPlans.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "plan",
+		Namespace:   "test",
+		ServiceName: "plan",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = deploymentPlans.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-1",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+	createResp, err := metaInstances.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             "plan-0",
+		DeploymentPlanId: planResp.Record.Metadata.Id,
+		DeploymentId:     "deployment-1",
+	})
+	require.NoError(t, err)
+
+	metaInstance := createResp.Record
+	for i, status := range statuses {
+		resp, err := metaInstances.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+			Metadata: metaInstance.Metadata,
+			RuntimeInstance: &mrdspb.RuntimeInstance{
+				Id:       fmt.Sprintf("plan-0-runtime-%d", i),
+				NodeId:   nodeIDs[i],
+				IsActive: i == len(statuses)-1,
+				Status:   status,
+			},
+		})
+		require.NoError(t, err)
+		metaInstance = resp.Record
+	}
+	return metaInstance
+}
+
+func TestHasOperationInFlight(t *testing.T) {
+	withOperation := func(state mrdspb.OperationState) *mrdspb.MetaInstance {
+		return &mrdspb.MetaInstance{
+			Operations: []*mrdspb.Operation{{Id: "operation-1", Status: &mrdspb.OperationStatus{State: state}}},
+		}
+	}
+
+	require.False(t, hasOperationInFlight(&mrdspb.MetaInstance{}))
+	require.False(t, hasOperationInFlight(withOperation(mrdspb.OperationState_OperationState_SUCCEEDED)))
+	require.False(t, hasOperationInFlight(withOperation(mrdspb.OperationState_OperationState_FAILED)))
+	require.True(t, hasOperationInFlight(withOperation(mrdspb.OperationState_OperationState_PENDING)))
+	require.True(t, hasOperationInFlight(withOperation(mrdspb.OperationState_OperationState_APPROVED)))
+}
@@ -0,0 +1,235 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RemediationIntentID is the intent of the operations created to replace FAILED runtime instances.
+const RemediationIntentID = "Remediation"
+
+// RemediationOptions are the tunables of the remediation operator.
+type RemediationOptions struct {
+	// Budget is the number of remediations allowed for the instances of a deployment plan within the
+	// BudgetWindow. Remediation is disabled when zero.
+	Budget int
+	// BudgetWindow is the period over which the Budget is counted.
+	BudgetWindow time.Duration
+	// InitialBackoff is how long a meta instance waits before it is remediated again. The backoff doubles
+	// with every remediation of the meta instance, up to MaxBackoff, and is reset once the meta instance was
+	// not remediated for a BudgetWindow.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RestartsBeforeRelocate is the number of RESTART operations after which a meta instance is relocated.
+	RestartsBeforeRelocate int
+}
+
+// DefaultRemediationOptions allows 5 remediations per deployment plan every hour.
+var DefaultRemediationOptions = RemediationOptions{
+	Budget:                 5,
+	BudgetWindow:           time.Hour,
+	InitialBackoff:         time.Minute,
+	MaxBackoff:             30 * time.Minute,
+	RestartsBeforeRelocate: 2,
+}
+
+// remediationState is the history of the remediations of a meta instance.
+type remediationState struct {
+	attempts    int
+	lastAttempt time.Time
+}
+
+// remediationOperator adds a RESTART operation to the meta instances whose active runtime instance is FAILED.
+// The meta instance is relocated instead when its node is no longer ALLOCATED, or when restarting it did
+// not help.
+//
+// The budgets and backoffs are derived from the remediation operations recorded in the ledger, so that they
+// hold across restarts of the operator.
+type remediationOperator struct {
+	metaInstancesClient mrdspb.MetaInstancesClient
+	nodesClient         mrdspb.NodesClient
+	options             RemediationOptions
+	now                 func() time.Time
+}
+
+func NewRemediationOperator(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	nodesClient mrdspb.NodesClient,
+	options RemediationOptions,
+) Operator {
+	return &remediationOperator{
+		metaInstancesClient: metaInstancesClient,
+		nodesClient:         nodesClient,
+		options:             options,
+		now:                 time.Now,
+	}
+}
+
+func (r *remediationOperator) RunBlocking(ctx context.Context) error {
+	logger := ctxslog.FromContext(ctx)
+
+	ticker, stop := newImmediatelyFiringTicker(10 * time.Second)
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled, stopping remediation manager")
+			return nil
+		case <-ticker:
+			listResp, err := r.metaInstancesClient.List(ctx, &mrdspb.ListMetaInstanceRequest{})
+			if err != nil {
+				return fmt.Errorf("failed to list meta instances: %w", err)
+			}
+
+			for _, metaInstance := range listResp.Records {
+				err := r.remediate(ctx, metaInstance)
+				if err != nil {
+					logger.Error("failed to remediate meta instance", "metaInstance", metaInstance.Name, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// remediate adds an operation replacing the active runtime instance of the meta instance when it is FAILED,
+// unless the meta instance is backing off or the budget of its deployment plan is spent.
+func (r *remediationOperator) remediate(ctx context.Context, metaInstance *mrdspb.MetaInstance) error {
+	logger := ctxslog.FromContext(ctx)
+
+	if metaInstance.Status.State == mrdspb.MetaInstanceState_MetaInstanceState_MARKED_FOR_DELETION ||
+		hasOperationInFlight(metaInstance) {
+		return nil
+	}
+	var failed *mrdspb.RuntimeInstance
+	for _, ri := range metaInstance.RuntimeInstances {
+		if ri.IsActive && ri.Status.State == mrdspb.RuntimeInstanceState_RuntimeState_FAILED {
+			failed = ri
+		}
+	}
+	if failed == nil {
+		return nil
+	}
+
+	now := r.now()
+	state := r.remediationState(metaInstance, now)
+	if state.attempts > 0 && now.Before(state.lastAttempt.Add(r.backoff(state.attempts))) {
+		return nil
+	}
+	spent, err := r.budgetSpent(ctx, metaInstance.DeploymentPlanId, now)
+	if err != nil {
+		return err
+	}
+	if spent {
+		logger.Info("Remediation budget of the deployment plan is spent", "metaInstance", metaInstance.Name)
+		return nil
+	}
+
+	operationType, err := r.operationType(ctx, failed, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.metaInstancesClient.AddOperation(ctx, &mrdspb.AddOperationRequest{
+		Metadata: metaInstance.Metadata,
+		Operation: &mrdspb.Operation{
+			Id:       uuid.New().String(),
+			Type:     operationType,
+			IntentId: RemediationIntentID,
+			Status: &mrdspb.OperationStatus{
+				State:   mrdspb.OperationState_OperationState_PENDING,
+				Message: fmt.Sprintf("runtime instance %s is FAILED", failed.Id),
+			},
+			CreatedAt: timestamppb.New(now),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add operation: %w", err)
+	}
+	logger.Info("Added remediation operation", "metaInstance", metaInstance.Name, "runtimeInstance", failed.Id, "type", operationType)
+	return nil
+}
+
+// remediationState returns the remediations of the meta instance since it was last left alone for a budget
+// window.
+func (r *remediationOperator) remediationState(metaInstance *mrdspb.MetaInstance, now time.Time) remediationState {
+	times := remediationTimes(metaInstance)
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	var state remediationState
+	for _, t := range times {
+		if t.Sub(state.lastAttempt) >= r.options.BudgetWindow {
+			state.attempts = 0
+		}
+		state.attempts++
+		state.lastAttempt = t
+	}
+	if now.Sub(state.lastAttempt) >= r.options.BudgetWindow {
+		return remediationState{}
+	}
+	return state
+}
+
+// operationType returns RELOCATE when the node of the runtime instance is no longer ALLOCATED, or when the
+// meta instance was restarted enough times, and RESTART otherwise.
+func (r *remediationOperator) operationType(ctx context.Context, ri *mrdspb.RuntimeInstance, state remediationState) (mrdspb.OperationType, error) {
+	if state.attempts >= r.options.RestartsBeforeRelocate {
+		return mrdspb.OperationType_OperationType_RELOCATE, nil
+	}
+	nodeResp, err := r.nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: ri.NodeId})
+	if err != nil {
+		return mrdspb.OperationType_OperationType_UNKNOWN, fmt.Errorf("failed to get node: %w", err)
+	}
+	if nodeResp.Record.Status.State != mrdspb.NodeState_NodeState_ALLOCATED {
+		return mrdspb.OperationType_OperationType_RELOCATE, nil
+	}
+	return mrdspb.OperationType_OperationType_RESTART, nil
+}
+
+// backoff returns how long a meta instance which was remediated the given number of times waits before it
+// is remediated again.
+func (r *remediationOperator) backoff(attempts int) time.Duration {
+	backoff := r.options.InitialBackoff
+	for i := 1; i < attempts && backoff < r.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.options.MaxBackoff)
+}
+
+// budgetSpent returns true when the meta instances of the deployment plan were remediated as many times as
+// the budget allows within the budget window.
+func (r *remediationOperator) budgetSpent(ctx context.Context, deploymentPlanID string, now time.Time) (bool, error) {
+	listResp, err := r.metaInstancesClient.List(ctx, &mrdspb.ListMetaInstanceRequest{
+		DeploymentPlanIdIn: []string{deploymentPlanID},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list meta instances: %w", err)
+	}
+
+	recent := 0
+	for _, metaInstance := range listResp.Records {
+		for _, t := range remediationTimes(metaInstance) {
+			if now.Sub(t) < r.options.BudgetWindow {
+				recent++
+			}
+		}
+	}
+	return recent >= r.options.Budget, nil
+}
+
+// remediationTimes returns the times at which the remediation operations of the meta instance were added.
+func remediationTimes(metaInstance *mrdspb.MetaInstance) []time.Time {
+	var times []time.Time
+	for _, operation := range metaInstance.Operations {
+		if operation.IntentId == RemediationIntentID && operation.CreatedAt != nil {
+			times = append(times, operation.CreatedAt.AsTime())
+		}
+	}
+	return times
+}
@@ -0,0 +1,136 @@
+package operators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemediate(t *testing.T) {
+	running := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true}
+	failed := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_FAILED}
+
+	testCases := []struct {
+		name            string
+		statuses        []*mrdspb.RuntimeInstanceStatus
+		allocated       bool
+		expectOperation mrdspb.OperationType // No operation is expected when UNKNOWN.
+	}{
+		{
+			name:            "Failed instance on an allocated node is restarted",
+			statuses:        []*mrdspb.RuntimeInstanceStatus{failed},
+			allocated:       true,
+			expectOperation: mrdspb.OperationType_OperationType_RESTART,
+		},
+		{
+			name:            "Failed instance on a node which is no longer allocated is relocated",
+			statuses:        []*mrdspb.RuntimeInstanceStatus{failed},
+			allocated:       false,
+			expectOperation: mrdspb.OperationType_OperationType_RELOCATE,
+		},
+		{
+			name:      "Running instance is not remediated",
+			statuses:  []*mrdspb.RuntimeInstanceStatus{running},
+			allocated: true,
+		},
+		{
+			name:      "Failed passive instance is not remediated",
+			statuses:  []*mrdspb.RuntimeInstanceStatus{failed, running},
+			allocated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, err := testserver.NewTestServer()
+			require.NoError(t, err)
+			defer ts.Close()
+
+			ctx := context.Background()
+			metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+			metaInstance := createTestMetaInstance(t, ts, tc.statuses, tc.allocated)
+
+			r := NewRemediationOperator(metaInstances, mrdspb.NewNodesClient(ts.Conn()), DefaultRemediationOptions).(*remediationOperator)
+			require.NoError(t, r.remediate(ctx, metaInstance))
+
+			getResp, err := metaInstances.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstance.Metadata.Id})
+			require.NoError(t, err)
+			if tc.expectOperation == mrdspb.OperationType_OperationType_UNKNOWN {
+				require.Empty(t, getResp.Record.Operations)
+				return
+			}
+			require.Len(t, getResp.Record.Operations, 1)
+			require.Equal(t, tc.expectOperation, getResp.Record.Operations[0].Type)
+			require.Equal(t, RemediationIntentID, getResp.Record.Operations[0].IntentId)
+			require.Equal(t, mrdspb.OperationState_OperationState_PENDING, getResp.Record.Operations[0].Status.State)
+		})
+	}
+}
+
+func TestRemediateBackoffAndBudget(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx := context.Background()
+	metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+	failed := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_FAILED}
+	metaInstance := createTestMetaInstance(t, ts, []*mrdspb.RuntimeInstanceStatus{failed}, true)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	// newOperator creates the operator, which keeps no state of its own between remediations.
+	newOperator := func() *remediationOperator {
+		r := NewRemediationOperator(metaInstances, mrdspb.NewNodesClient(ts.Conn()), RemediationOptions{
+			Budget:                 3,
+			BudgetWindow:           time.Hour,
+			InitialBackoff:         time.Minute,
+			MaxBackoff:             10 * time.Minute,
+			RestartsBeforeRelocate: 2,
+		}).(*remediationOperator)
+		r.now = func() time.Time { return now }
+		return r
+	}
+	r := newOperator()
+
+	// remediateAt runs the operator at the given offset and returns the type of the operation it added. The
+	// operation is completed, leaving the runtime instance FAILED.
+	remediateAt := func(offset time.Duration) mrdspb.OperationType {
+		now = start.Add(offset)
+		require.NoError(t, r.remediate(ctx, metaInstance))
+
+		getResp, err := metaInstances.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstance.Metadata.Id})
+		require.NoError(t, err)
+		metaInstance = getResp.Record
+		for _, operation := range metaInstance.Operations {
+			if operation.Status.State != mrdspb.OperationState_OperationState_PENDING {
+				continue
+			}
+			updateResp, err := metaInstances.UpdateOperationStatus(ctx, &mrdspb.UpdateOperationStatusRequest{
+				Metadata:    metaInstance.Metadata,
+				OperationId: operation.Id,
+				Status:      &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_SUCCEEDED},
+			})
+			require.NoError(t, err)
+			metaInstance = updateResp.Record
+			return operation.Type
+		}
+		return mrdspb.OperationType_OperationType_UNKNOWN
+	}
+
+	require.Equal(t, mrdspb.OperationType_OperationType_RESTART, remediateAt(0))
+	require.Equal(t, mrdspb.OperationType_OperationType_UNKNOWN, remediateAt(30*time.Second), "backing off for a minute")
+	require.Equal(t, mrdspb.OperationType_OperationType_RESTART, remediateAt(time.Minute))
+
+	// The backoff and budget are read from the ledger by an operator which was restarted.
+	r = newOperator()
+	require.Equal(t, mrdspb.OperationType_OperationType_UNKNOWN, remediateAt(2*time.Minute+30*time.Second), "backing off for two minutes")
+	require.Equal(t, mrdspb.OperationType_OperationType_RELOCATE, remediateAt(3*time.Minute), "restarts did not help")
+	require.Equal(t, mrdspb.OperationType_OperationType_UNKNOWN, remediateAt(20*time.Minute), "budget is spent")
+	require.Equal(t, mrdspb.OperationType_OperationType_RESTART, remediateAt(2*time.Hour), "budget and backoff are reset")
+}
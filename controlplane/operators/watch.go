@@ -0,0 +1,105 @@
+package operators
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchRetryInterval is how long an operator waits before it watches again after its watch failed.
+var watchRetryInterval = 5 * time.Second
+
+// watchResyncInterval is how often an operator watches again from the current records. A record whose handling
+// failed, or whose workflow is gone, is then handled again without waiting for its next change.
+var watchResyncInterval = time.Minute
+
+// errResync is returned by watchOnce when its stream was closed to watch again from the current records.
+var errResync = errors.New("resync")
+
+// watchEvent is an event of the Watch streams of the MRDS services, where E is its message type.
+type watchEvent[E any] interface {
+	*E
+	GetSequence() uint64
+}
+
+// watchOpener opens a Watch stream of the events after the given sequence.
+type watchOpener[E any] func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[E], error)
+
+// watch calls handle with every event of the stream opened by open until the context is cancelled. When the
+// stream breaks or handle fails, the stream is opened again after the last event which was handled, so that
+// events are handled at least once. When the events after it were pruned, the stream is opened again from
+// the current records. The stream is also opened again from the current records every watchResyncInterval,
+// so that every record is handled again periodically.
+func watch[E any, P watchEvent[E]](ctx context.Context, open watchOpener[E], handle func(ctx context.Context, event P) error) error {
+	logger := ctxslog.FromContext(ctx)
+
+	var lastSequence uint64
+	for {
+		err := watchOnce(ctx, open, handle, &lastSequence)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, errResync) {
+			lastSequence = 0
+			continue
+		}
+		if status.Code(err) == codes.OutOfRange {
+			// The changes after the last handled event were pruned, so the watch starts again from the
+			// current records.
+			lastSequence = 0
+		}
+		logger.Error("Watch failed, watching again", "afterSequence", lastSequence, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+// watchOnce handles the events of a single stream, recording the sequence of each handled event. The stream is
+// closed after watchResyncInterval, without interrupting the event being handled.
+func watchOnce[E any, P watchEvent[E]](ctx context.Context, open watchOpener[E], handle func(ctx context.Context, event P) error, lastSequence *uint64) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var resync atomic.Bool
+	timer := time.AfterFunc(watchResyncInterval, func() {
+		resync.Store(true)
+		cancel()
+	})
+	defer timer.Stop()
+
+	stream, err := open(streamCtx, *lastSequence)
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if resync.Load() {
+			return errResync
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("stream closed by the server")
+			}
+			return err
+		}
+		err = handle(ctx, P(event))
+		if err != nil {
+			return err
+		}
+		// The events sent when a watch starts have sequence 0, and are sent again when the watch is resumed
+		// before a later event was handled.
+		if sequence := P(event).GetSequence(); sequence > 0 {
+			*lastSequence = sequence
+		}
+	}
+}
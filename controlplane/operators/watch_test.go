@@ -0,0 +1,127 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatch(t *testing.T) {
+	watchRetryInterval = 10 * time.Millisecond
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createNode := func(name string) {
+		_, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    name,
+			UpdateDomain:            "ud-1",
+			TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(t, err)
+	}
+	createNode("node-1")
+
+	var openedAfter []uint64
+	handled := make(chan string)
+	failNext := map[string]bool{"node-3": true}
+	done := make(chan error)
+	go func() {
+		done <- watch(ctx,
+			func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[mrdspb.WatchNodeResponse], error) {
+				openedAfter = append(openedAfter, afterSequence)
+				return nodes.Watch(ctx, &mrdspb.WatchNodeRequest{AfterSequence: afterSequence})
+			},
+			func(ctx context.Context, event *mrdspb.WatchNodeResponse) error {
+				if failNext[event.Record.Name] {
+					failNext[event.Record.Name] = false
+					return fmt.Errorf("failed to handle %s", event.Record.Name)
+				}
+				handled <- event.Record.Name
+				return nil
+			},
+		)
+	}()
+
+	// The current node is handled first, followed by the created ones. The failed event is handled again
+	// once the watch is resumed after the last handled event.
+	require.Equal(t, "node-1", <-handled)
+	createNode("node-2")
+	require.Equal(t, "node-2", <-handled)
+	createNode("node-3")
+	require.Equal(t, "node-3", <-handled)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Len(t, openedAfter, 2)
+	require.Equal(t, uint64(0), openedAfter[0])
+	require.Greater(t, openedAfter[1], uint64(0), "resumed after node-2")
+}
+
+// prunedStream is a Watch stream failing with OutOfRange, as when the changes after its sequence were pruned.
+type prunedStream struct {
+	grpc.ServerStreamingClient[mrdspb.WatchNodeResponse]
+}
+
+func (s *prunedStream) Recv() (*mrdspb.WatchNodeResponse, error) {
+	return nil, status.Error(codes.OutOfRange, "changes after the sequence were pruned from the change log")
+}
+
+func TestWatchPruned(t *testing.T) {
+	watchRetryInterval = 10 * time.Millisecond
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err = nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+		Name:                    "node-1",
+		UpdateDomain:            "ud-1",
+		TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+		SystemReservedResources: &mrdspb.Resources{},
+	})
+	require.NoError(t, err)
+
+	// The watch is resumed after a pruned sequence, and starts again from the current records.
+	var openedAfter []uint64
+	handled := make(chan string)
+	done := make(chan error)
+	go func() {
+		done <- watch(ctx,
+			func(ctx context.Context, afterSequence uint64) (grpc.ServerStreamingClient[mrdspb.WatchNodeResponse], error) {
+				openedAfter = append(openedAfter, afterSequence)
+				if len(openedAfter) == 1 {
+					return &prunedStream{}, nil
+				}
+				return nodes.Watch(ctx, &mrdspb.WatchNodeRequest{AfterSequence: afterSequence})
+			},
+			func(ctx context.Context, event *mrdspb.WatchNodeResponse) error {
+				handled <- event.Record.Name
+				return nil
+			},
+		)
+	}()
+
+	require.Equal(t, "node-1", <-handled)
+	cancel()
+	require.NoError(t, <-done)
+	require.Equal(t, []uint64{0, 0}, openedAfter)
+}
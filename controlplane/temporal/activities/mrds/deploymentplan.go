@@ -24,6 +24,7 @@ func NewDeploymentPlanActivities(client mrdspb.DeploymentPlansClient, registry w
 	registry.RegisterActivity(a.DeleteDeploymentPlan)
 	registry.RegisterActivity(a.AddDeployment)
 	registry.RegisterActivity(a.UpdateDeploymentStatus)
+	registry.RegisterActivity(a.UpdateDeploymentProgress)
 
 	return a
 }
@@ -140,3 +141,37 @@ func (c *DeploymentPlanActivities) UpdateDeploymentStatus(ctx context.Context, r
 
 	return &UpdateDeploymentStatusResponse{DeploymentPlan: resp.Record}, nil
 }
+
+type UpdateDeploymentProgressRequest struct {
+	DeploymentPlanID string
+	DeploymentID     string
+	Progress         *mrdspb.RolloutProgress
+}
+
+type UpdateDeploymentProgressResponse struct {
+	DeploymentPlan *mrdspb.DeploymentPlanRecord
+}
+
+// UpdateDeploymentProgress records the rollout progress of a deployment in a DeploymentPlan.
+func (c *DeploymentPlanActivities) UpdateDeploymentProgress(ctx context.Context, req *UpdateDeploymentProgressRequest) (*UpdateDeploymentProgressResponse, error) {
+	activity.GetLogger(ctx).Info("Updating Deployment progress", "request", req)
+
+	// Get the DeploymentPlan by ID
+	deploymentPlanResp, err := c.GetDeploymentPlanByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: req.DeploymentPlanID})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to get DeploymentPlan by ID", "error", err)
+		return nil, fmt.Errorf("failed to get DeploymentPlan by ID: %w", err)
+	}
+
+	resp, err := c.client.UpdateDeploymentProgress(ctx, &mrdspb.UpdateDeploymentProgressRequest{
+		Metadata:     deploymentPlanResp.Record.Metadata,
+		DeploymentId: req.DeploymentID,
+		Progress:     req.Progress,
+	})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to update Deployment progress", "error", err)
+		return nil, fmt.Errorf("failed to update Deployment progress: %w", err)
+	}
+
+	return &UpdateDeploymentProgressResponse{DeploymentPlan: resp.Record}, nil
+}
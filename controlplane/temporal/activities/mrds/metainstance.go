@@ -3,7 +3,6 @@ package mrds
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/msanath/mrds/gen/api/mrdspb"
 
@@ -22,12 +21,12 @@ func NewMetaInstanceActivities(client mrdspb.MetaInstancesClient, registry worke
 	registry.RegisterActivity(a.GetMetaInstanceByID)
 	registry.RegisterActivity(a.GetMetaInstanceByName)
 	registry.RegisterActivity(a.UpdateMetaInstanceStatus)
-	registry.RegisterActivity(a.WaitForOperationStatusApproved)
 	registry.RegisterActivity(a.ListMetaInstance)
 	registry.RegisterActivity(a.DeleteMetaInstance)
 	registry.RegisterActivity(a.AddRuntimeInstance)
 	registry.RegisterActivity(a.UpdateRuntimeStatus)
 	registry.RegisterActivity(a.UpdateRuntimeActiveState)
+	registry.RegisterActivity(a.SwitchActiveRuntimeInstances)
 	registry.RegisterActivity(a.RemoveRuntimeInstance)
 	registry.RegisterActivity(a.AddOperation)
 	registry.RegisterActivity(a.UpdateOperationStatus)
@@ -267,6 +266,45 @@ func (c *MetaInstanceActivities) UpdateRuntimeActiveState(ctx context.Context, r
 	}, nil
 }
 
+type SwitchActiveRuntimeInstancesRequest struct {
+	ActiveRuntimeInstanceIDs map[string]string // Runtime instance to make active, by MetaInstance ID.
+}
+
+type SwitchActiveRuntimeInstancesResponse struct {
+	MetaInstances []*mrdspb.MetaInstance
+}
+
+// SwitchActiveRuntimeInstances is an activity that interacts with the gRPC service to switch the active RuntimeInstances of MetaInstances at once.
+func (c *MetaInstanceActivities) SwitchActiveRuntimeInstances(ctx context.Context, req *SwitchActiveRuntimeInstancesRequest) (*SwitchActiveRuntimeInstancesResponse, error) {
+	activity.GetLogger(ctx).Info("Switching active RuntimeInstances", "request", req)
+
+	var activeRuntimeInstances []*mrdspb.ActiveRuntimeInstance
+	for metaInstanceID, runtimeInstanceID := range req.ActiveRuntimeInstanceIDs {
+		// Get the Meta Instance by ID
+		metaInstance, err := c.GetMetaInstanceByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstanceID})
+		if err != nil {
+			activity.GetLogger(ctx).Error("Failed to get MetaInstance by ID", "error", err)
+			return nil, fmt.Errorf("failed to get MetaInstance by ID: %w", err)
+		}
+		activeRuntimeInstances = append(activeRuntimeInstances, &mrdspb.ActiveRuntimeInstance{
+			Metadata:          metaInstance.Record.Metadata,
+			RuntimeInstanceId: runtimeInstanceID,
+		})
+	}
+
+	resp, err := c.client.SwitchActiveRuntimeInstances(ctx, &mrdspb.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstances: activeRuntimeInstances,
+	})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to switch active RuntimeInstances", "error", err)
+		return nil, fmt.Errorf("failed to switch active RuntimeInstances: %w", err)
+	}
+
+	return &SwitchActiveRuntimeInstancesResponse{
+		MetaInstances: resp.Records,
+	}, nil
+}
+
 type RemoveRuntimeInstanceRequest struct {
 	MetaInstanceID    string
 	RuntimeInstanceID string
@@ -375,39 +413,6 @@ func (c *MetaInstanceActivities) UpdateOperationStatus(ctx context.Context, req
 	}, nil
 }
 
-type WaitForOperationStatusApprovedRequest struct {
-	MetaInstanceID string
-	OperationID    string
-}
-
-type WaitForOperationStatusApprovedResponse struct {
-	MetaInstance *mrdspb.MetaInstance
-}
-
-func (c *MetaInstanceActivities) WaitForOperationStatusApproved(ctx context.Context, req *WaitForOperationStatusApprovedRequest) (*WaitForOperationStatusApprovedResponse, error) {
-	activity.GetLogger(ctx).Info("Waiting for Operation status to be approved", "request", req)
-
-	timeInterval := 5 * time.Second
-
-	for {
-		resp, err := c.client.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
-			Id: req.MetaInstanceID,
-		})
-		if err != nil {
-			activity.GetLogger(ctx).Error("Failed to get MetaInstance by ID", "error", err)
-			return nil, fmt.Errorf("failed to get MetaInstance by ID: %w", err)
-		}
-		for _, operation := range resp.Record.Operations {
-			if operation.Id == req.OperationID && operation.Status.State == mrdspb.OperationState_OperationState_APPROVED {
-				return &WaitForOperationStatusApprovedResponse{MetaInstance: resp.Record}, nil
-			}
-		}
-
-		activity.GetLogger(ctx).Info("Operation status is not approved yet, waiting...", "interval", timeInterval)
-		time.Sleep(timeInterval)
-	}
-}
-
 type RemoveOperationRequest struct {
 	MetaInstanceID string
 	OperationID    string
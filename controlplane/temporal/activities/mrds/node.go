@@ -23,6 +23,9 @@ func NewNodeActivities(client mrdspb.NodesClient, registry worker.Registry) *Nod
 	registry.RegisterActivity(a.UpdateNodeStatus)
 	registry.RegisterActivity(a.ListNode)
 	registry.RegisterActivity(a.DeleteNode)
+	registry.RegisterActivity(a.UpdateNodeDisruptionStatus)
+	registry.RegisterActivity(a.RemoveNodeDisruption)
+	registry.RegisterActivity(a.EvictNode)
 	return a
 }
 
@@ -168,3 +171,140 @@ func (c *NodeActivities) RemoveCapability(ctx context.Context, req *mrdspb.Remov
 
 	return resp, nil
 }
+
+type UpdateNodeDisruptionStatusRequest struct {
+	NodeID       string
+	DisruptionID string
+	Status       *mrdspb.DisruptionStatus
+}
+
+type UpdateNodeDisruptionStatusResponse struct {
+	Node *mrdspb.Node
+}
+
+// UpdateNodeDisruptionStatus updates the status of a disruption of the Node with the given ID.
+func (c *NodeActivities) UpdateNodeDisruptionStatus(ctx context.Context, req *UpdateNodeDisruptionStatusRequest) (*UpdateNodeDisruptionStatusResponse, error) {
+	activity.GetLogger(ctx).Info("Updating disruption status of Node", "request", req)
+
+	// Get the Node by ID
+	node, err := c.GetNodeByID(ctx, &mrdspb.GetNodeByIDRequest{Id: req.NodeID})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to get Node by ID", "error", err)
+		return nil, fmt.Errorf("failed to get Node by ID: %w", err)
+	}
+
+	for _, disruption := range node.Record.Disruptions {
+		if disruption.Id == req.DisruptionID && disruption.Status.State == req.Status.State {
+			activity.GetLogger(ctx).Info("Disruption status is already updated", "status", req.Status)
+			return &UpdateNodeDisruptionStatusResponse{
+				Node: node.Record,
+			}, nil
+		}
+	}
+
+	resp, err := c.client.UpdateDisruptionStatus(ctx, &mrdspb.UpdateDisruptionStatusRequest{
+		Metadata:     node.Record.Metadata,
+		DisruptionId: req.DisruptionID,
+		Status:       req.Status,
+	})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to update disruption status of Node", "error", err)
+		return nil, fmt.Errorf("failed to update disruption status of Node: %w", err)
+	}
+
+	return &UpdateNodeDisruptionStatusResponse{
+		Node: resp.Record,
+	}, nil
+}
+
+type RemoveNodeDisruptionRequest struct {
+	NodeID       string
+	DisruptionID string
+}
+
+type RemoveNodeDisruptionResponse struct {
+	Node *mrdspb.Node
+}
+
+// RemoveNodeDisruption removes a completed disruption from the Node with the given ID.
+func (c *NodeActivities) RemoveNodeDisruption(ctx context.Context, req *RemoveNodeDisruptionRequest) (*RemoveNodeDisruptionResponse, error) {
+	activity.GetLogger(ctx).Info("Removing disruption from Node", "request", req)
+
+	// Get the Node by ID
+	node, err := c.GetNodeByID(ctx, &mrdspb.GetNodeByIDRequest{Id: req.NodeID})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to get Node by ID", "error", err)
+		return nil, fmt.Errorf("failed to get Node by ID: %w", err)
+	}
+
+	found := false
+	for _, disruption := range node.Record.Disruptions {
+		if disruption.Id == req.DisruptionID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		activity.GetLogger(ctx).Info("Disruption is already removed", "disruptionID", req.DisruptionID)
+		return &RemoveNodeDisruptionResponse{
+			Node: node.Record,
+		}, nil
+	}
+
+	resp, err := c.client.RemoveDisruption(ctx, &mrdspb.RemoveDisruptionRequest{
+		Metadata:     node.Record.Metadata,
+		DisruptionId: req.DisruptionID,
+	})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to remove disruption from Node", "error", err)
+		return nil, fmt.Errorf("failed to remove disruption from Node: %w", err)
+	}
+
+	return &RemoveNodeDisruptionResponse{
+		Node: resp.Record,
+	}, nil
+}
+
+type EvictNodeRequest struct {
+	NodeID  string
+	Message string
+}
+
+type EvictNodeResponse struct {
+	Node *mrdspb.Node
+}
+
+// EvictNode moves the Node with the given ID to the EVICTED state.
+func (c *NodeActivities) EvictNode(ctx context.Context, req *EvictNodeRequest) (*EvictNodeResponse, error) {
+	activity.GetLogger(ctx).Info("Evicting Node", "request", req)
+
+	// Get the Node by ID
+	node, err := c.GetNodeByID(ctx, &mrdspb.GetNodeByIDRequest{Id: req.NodeID})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to get Node by ID", "error", err)
+		return nil, fmt.Errorf("failed to get Node by ID: %w", err)
+	}
+
+	if node.Record.Status.State == mrdspb.NodeState_NodeState_EVICTED {
+		activity.GetLogger(ctx).Info("Node is already evicted")
+		return &EvictNodeResponse{
+			Node: node.Record,
+		}, nil
+	}
+
+	resp, err := c.client.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+		Metadata: node.Record.Metadata,
+		Status: &mrdspb.NodeStatus{
+			State:   mrdspb.NodeState_NodeState_EVICTED,
+			Message: req.Message,
+		},
+	})
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to evict Node", "error", err)
+		return nil, fmt.Errorf("failed to evict Node: %w", err)
+	}
+
+	return &EvictNodeResponse{
+		Node: resp.Record,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// RuntimeDetails are the records describing a runtime instance, from which runtimes build the instance.
+type RuntimeDetails struct {
+	MetaInstance    *mrdspb.MetaInstance
+	RuntimeInstance *mrdspb.RuntimeInstance
+	DeploymentPlan  *mrdspb.DeploymentPlanRecord
+	Node            *mrdspb.Node
+}
+
+// GetRuntimeDetails fetches the records describing the runtime instance of the request.
+func GetRuntimeDetails(
+	ctx context.Context,
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+	req *RuntimeActivityRequest,
+) (*RuntimeDetails, error) {
+	metaInstanceGetResp, err := metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
+		Id: req.MetaInstanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var runtimeInstance *mrdspb.RuntimeInstance
+	for _, ri := range metaInstanceGetResp.Record.RuntimeInstances {
+		if ri.Id == req.RuntimeInstanceID {
+			runtimeInstance = ri
+			break
+		}
+	}
+	if runtimeInstance == nil {
+		return nil, fmt.Errorf("runtime instance %s not found in meta instance %s", req.RuntimeInstanceID, req.MetaInstanceID)
+	}
+
+	nodeGetResp, err := nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{
+		Id: runtimeInstance.NodeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentPlanGetResp, err := deploymentPlanClient.GetByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{
+		Id: metaInstanceGetResp.Record.DeploymentPlanId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RuntimeDetails{
+		MetaInstance:    metaInstanceGetResp.Record,
+		RuntimeInstance: runtimeInstance,
+		DeploymentPlan:  deploymentPlanGetResp.Record,
+		Node:            nodeGetResp.Record,
+	}, nil
+}
+
+// Deployment returns the deployment of the deployment plan which the meta instance runs.
+func (d *RuntimeDetails) Deployment() (*mrdspb.Deployment, error) {
+	for _, deployment := range d.DeploymentPlan.Deployments {
+		if deployment.Id == d.MetaInstance.DeploymentId {
+			return deployment, nil
+		}
+	}
+	return nil, fmt.Errorf("deployment with ID %s not found", d.MetaInstance.DeploymentId)
+}
+
+// Application returns the application of the deployment plan running the given payload, or nil when the
+// payload has none.
+func (d *RuntimeDetails) Application(payloadName string) *mrdspb.Application {
+	for _, app := range d.DeploymentPlan.Applications {
+		if app.PayloadName == payloadName {
+			return app
+		}
+	}
+	return nil
+}
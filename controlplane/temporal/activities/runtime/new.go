@@ -12,13 +12,26 @@ type RuntimeActivities interface {
 	Register(registry worker.Registry) // Register the activities with the worker
 	StartInstance(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeActivityResponse, error)
 	StopInstance(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeActivityResponse, error)
+	// GetInstanceStatus returns the observed status of a runtime instance without updating the ledger. It is
+	// also called outside of activities, by the reconciler.
+	GetInstanceStatus(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeInstanceStatusResponse, error)
 }
 
 type RuntimeActivityRequest struct {
 	MetaInstanceID    string
 	RuntimeInstanceID string
+	// Restart restarts a started instance even when it runs the requested deployment. A started instance
+	// running another deployment is always restarted.
+	Restart bool
+	// Remove stops an instance whose runtime instance is removed afterwards, so that the runtime also releases
+	// what outlives a stop, such as persistent volumes.
+	Remove bool
 }
 
 type RuntimeActivityResponse struct {
 	MetaInstance *mrdspb.MetaInstance
 }
+
+type RuntimeInstanceStatusResponse struct {
+	Status *mrdspb.RuntimeInstanceStatus
+}
@@ -0,0 +1,199 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"go.temporal.io/sdk/worker"
+)
+
+// Factory creates a runtime from the runtime config of a cluster.
+type Factory func(config map[string]string) (RuntimeActivities, error)
+
+// Reconfigurable is implemented by the runtimes which hold the state of the instances they run, such as the
+// processes they started. They are reconfigured in place when the runtime config of their cluster changes,
+// rather than replaced, so that the instances they run are not lost.
+type Reconfigurable interface {
+	Reconfigure(config map[string]string) error
+}
+
+// Registry is the runtime of a control plane driving many runtimes. It routes each request to the runtime of
+// the cluster of the node hosting the runtime instance, as selected by the runtime type of the cluster.
+// Nodes whose cluster has no record or no runtime type use the default runtime type.
+//
+// The runtimes are created on first use and kept for each cluster. When the runtime of the cluster changes, a
+// Reconfigurable runtime of the same runtime type is reconfigured, and any other runtime is replaced and
+// closed when it is an io.Closer. Only the registry registers activities, so that the workflows are not
+// aware of the runtimes.
+type Registry struct {
+	metaInstancesClient mrdspb.MetaInstancesClient
+	nodesClient         mrdspb.NodesClient
+	clustersClient      mrdspb.ClustersClient
+	factories           map[string]Factory
+	defaultRuntimeType  string
+
+	mu       sync.Mutex
+	runtimes map[string]clusterRuntime // keyed by cluster ID
+}
+
+// clusterRuntime is the runtime created for a cluster.
+type clusterRuntime struct {
+	runtimeType string
+	config      map[string]string
+	runtime     RuntimeActivities
+}
+
+var _ RuntimeActivities = &Registry{}
+
+func NewRegistry(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	nodesClient mrdspb.NodesClient,
+	clustersClient mrdspb.ClustersClient,
+	defaultRuntimeType string,
+) *Registry {
+	return &Registry{
+		metaInstancesClient: metaInstancesClient,
+		nodesClient:         nodesClient,
+		clustersClient:      clustersClient,
+		factories:           make(map[string]Factory),
+		defaultRuntimeType:  defaultRuntimeType,
+		runtimes:            make(map[string]clusterRuntime),
+	}
+}
+
+// RegisterRuntime adds the factory of a runtime type.
+func (r *Registry) RegisterRuntime(runtimeType string, factory Factory) {
+	r.factories[runtimeType] = factory
+}
+
+// RuntimeTypes returns the registered runtime types.
+func (r *Registry) RuntimeTypes() []string {
+	return slices.Sorted(maps.Keys(r.factories))
+}
+
+func (r *Registry) Register(w worker.Registry) {
+	w.RegisterActivity(r.StartInstance)
+	w.RegisterActivity(r.StopInstance)
+	w.RegisterActivity(r.GetInstanceStatus)
+}
+
+func (r *Registry) StartInstance(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeActivityResponse, error) {
+	rt, err := r.runtimeFor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rt.StartInstance(ctx, req)
+}
+
+func (r *Registry) StopInstance(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeActivityResponse, error) {
+	rt, err := r.runtimeFor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rt.StopInstance(ctx, req)
+}
+
+func (r *Registry) GetInstanceStatus(ctx context.Context, req *RuntimeActivityRequest) (*RuntimeInstanceStatusResponse, error) {
+	rt, err := r.runtimeFor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rt.GetInstanceStatus(ctx, req)
+}
+
+// runtimeFor returns the runtime of the cluster of the node hosting the runtime instance.
+func (r *Registry) runtimeFor(ctx context.Context, req *RuntimeActivityRequest) (RuntimeActivities, error) {
+	metaInstanceResp, err := r.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
+		Id: req.MetaInstanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var nodeID string
+	for _, ri := range metaInstanceResp.Record.RuntimeInstances {
+		if ri.Id == req.RuntimeInstanceID {
+			nodeID = ri.NodeId
+			break
+		}
+	}
+	if nodeID == "" {
+		return nil, fmt.Errorf("runtime instance %s not found in meta instance %s", req.RuntimeInstanceID, req.MetaInstanceID)
+	}
+
+	nodeResp, err := r.nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: nodeID})
+	if err != nil {
+		return nil, err
+	}
+	clusterID := nodeResp.Record.ClusterId
+
+	runtimeType := r.defaultRuntimeType
+	var config map[string]string
+	if clusterID != "" {
+		// A list is used rather than a get so that a missing cluster is told apart from a failed request.
+		listResp, err := r.clustersClient.List(ctx, &mrdspb.ListClusterRequest{IdIn: []string{clusterID}})
+		if err != nil {
+			return nil, err
+		}
+		if len(listResp.Records) > 0 && listResp.Records[0].RuntimeType != "" {
+			runtimeType = listResp.Records[0].RuntimeType
+			config = listResp.Records[0].RuntimeConfig
+		}
+	}
+	return r.getOrCreate(ctx, clusterID, runtimeType, config)
+}
+
+func (r *Registry) getOrCreate(ctx context.Context, clusterID string, runtimeType string, config map[string]string) (RuntimeActivities, error) {
+	rt, replaced, err := r.swap(clusterID, runtimeType, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replaced runtime is closed outside of the lock, as closing it may wait for its instances to stop. A
+	// failed close is only logged, since the replacement is already in place.
+	if closer, ok := replaced.runtime.(io.Closer); ok {
+		err := closer.Close()
+		if err != nil {
+			ctxslog.FromContext(ctx).Error("Failed to close replaced runtime", "cluster", clusterID, "runtimeType", replaced.runtimeType, "error", err)
+		}
+	}
+	return rt, nil
+}
+
+// swap returns the runtime of the cluster, creating or reconfiguring it when the runtime type or config of the
+// cluster changed. It also returns the runtime which the new one replaced, to be closed by the caller.
+func (r *Registry) swap(clusterID string, runtimeType string, config map[string]string) (RuntimeActivities, clusterRuntime, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.runtimes[clusterID]
+	if ok && cached.runtimeType == runtimeType {
+		if maps.Equal(cached.config, config) {
+			return cached.runtime, clusterRuntime{}, nil
+		}
+		if reconfigurable, ok := cached.runtime.(Reconfigurable); ok {
+			err := reconfigurable.Reconfigure(config)
+			if err != nil {
+				return nil, clusterRuntime{}, fmt.Errorf("failed to reconfigure %s runtime of cluster %q: %w", runtimeType, clusterID, err)
+			}
+			r.runtimes[clusterID] = clusterRuntime{runtimeType: runtimeType, config: maps.Clone(config), runtime: cached.runtime}
+			return cached.runtime, clusterRuntime{}, nil
+		}
+	}
+
+	factory, ok := r.factories[runtimeType]
+	if !ok {
+		return nil, clusterRuntime{}, fmt.Errorf("unknown runtime type %q of cluster %q. One of %v", runtimeType, clusterID, r.RuntimeTypes())
+	}
+	rt, err := factory(config)
+	if err != nil {
+		return nil, clusterRuntime{}, fmt.Errorf("failed to create %s runtime of cluster %q: %w", runtimeType, clusterID, err)
+	}
+	r.runtimes[clusterID] = clusterRuntime{runtimeType: runtimeType, config: maps.Clone(config), runtime: rt}
+	return rt, cached, nil
+}
@@ -0,0 +1,239 @@
+package runtime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/worker"
+)
+
+// namedRuntime is a runtime which reports its name as the message of the status of every runtime instance.
+// Closing it waits for release when it is set, and fails with closeErr.
+type namedRuntime struct {
+	name     string
+	config   map[string]string
+	closed   bool
+	release  chan struct{}
+	closeErr error
+}
+
+func (r *namedRuntime) Reconfigure(config map[string]string) error {
+	r.config = config
+	return nil
+}
+
+func (r *namedRuntime) Close() error {
+	if r.release != nil {
+		<-r.release
+	}
+	r.closed = true
+	return r.closeErr
+}
+
+func (r *namedRuntime) Register(registry worker.Registry) {}
+
+func (r *namedRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *namedRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *namedRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	return &runtime.RuntimeInstanceStatusResponse{
+		Status: &mrdspb.RuntimeInstanceStatus{Message: r.name + r.config["suffix"]},
+	}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx := context.Background()
+	metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+	deploymentPlans := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	nodes := mrdspb.NewNodesClient(ts.Conn())
+	clusters := mrdspb.NewClustersClient(ts.Conn())
+
+	createCluster := func(name string, runtimeType string, config map[string]string) string {
+		resp, err := clusters.Create(ctx, &mrdspb.CreateClusterRequest{
+			Name:          name,
+			RuntimeType:   runtimeType,
+			RuntimeConfig: config,
+		})
+		require.NoError(t, err)
+		require.Equal(t, runtimeType, resp.Record.RuntimeType)
+		return resp.Record.Metadata.Id
+	}
+	fooCluster := createCluster("foo-cluster", "foo", map[string]string{"suffix": "-1"})
+	untypedCluster := createCluster("untyped-cluster", "", nil)
+	unknownTypeCluster := createCluster("unknown-type-cluster", "unknown", nil)
+	barCluster := createCluster("bar-cluster", "bar", map[string]string{"suffix": "-2"})
+
+	// A node in each cluster, hosting a runtime instance of the meta instance.
+	nodeClusters := []string{fooCluster, untypedCluster, "no-record-cluster", "", unknownTypeCluster, barCluster}
+	planResp, err := deploymentPlans.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "plan",
+		Namespace:   "test",
+		ServiceName: "plan",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = deploymentPlans.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+	createResp, err := metaInstances.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             "meta-instance",
+		DeploymentPlanId: planResp.Record.Metadata.Id,
+		DeploymentId:     "deployment",
+	})
+	require.NoError(t, err)
+	metaInstance := createResp.Record
+	for i, clusterID := range nodeClusters {
+		nodeResp, err := nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    fmt.Sprintf("node-%d", i),
+			UpdateDomain:            "ud-1",
+			TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(t, err)
+		if clusterID != "" {
+			_, err = nodes.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+				Metadata:  nodeResp.Record.Metadata,
+				Status:    &mrdspb.NodeStatus{State: mrdspb.NodeState_NodeState_ALLOCATING},
+				ClusterId: clusterID,
+			})
+			require.NoError(t, err)
+		}
+		resp, err := metaInstances.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+			Metadata: metaInstance.Metadata,
+			RuntimeInstance: &mrdspb.RuntimeInstance{
+				Id:     fmt.Sprintf("runtime-%d", i),
+				NodeId: nodeResp.Record.Metadata.Id,
+				Status: &mrdspb.RuntimeInstanceStatus{},
+			},
+		})
+		require.NoError(t, err)
+		metaInstance = resp.Record
+	}
+
+	var created []*namedRuntime
+	factory := func(name string) runtime.Factory {
+		return func(config map[string]string) (runtime.RuntimeActivities, error) {
+			rt := &namedRuntime{name: name, config: config}
+			created = append(created, rt)
+			return rt, nil
+		}
+	}
+	registry := runtime.NewRegistry(metaInstances, nodes, clusters, "bar")
+	registry.RegisterRuntime("foo", factory("foo"))
+	registry.RegisterRuntime("bar", factory("bar"))
+	require.Equal(t, []string{"bar", "foo"}, registry.RuntimeTypes())
+
+	getStatus := func(runtimeInstanceID string) (string, error) {
+		resp, err := registry.GetInstanceStatus(ctx, &runtime.RuntimeActivityRequest{
+			MetaInstanceID:    metaInstance.Metadata.Id,
+			RuntimeInstanceID: runtimeInstanceID,
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.Status.Message, nil
+	}
+
+	testCases := []struct {
+		name              string
+		runtimeInstanceID string
+		expect            string
+		expectErr         bool
+	}{
+		{name: "Cluster runtime with its config", runtimeInstanceID: "runtime-0", expect: "foo-1"},
+		{name: "Cluster without runtime type uses the default", runtimeInstanceID: "runtime-1", expect: "bar"},
+		{name: "Cluster without record uses the default", runtimeInstanceID: "runtime-2", expect: "bar"},
+		{name: "Node without cluster uses the default", runtimeInstanceID: "runtime-3", expect: "bar"},
+		{name: "Unknown runtime type fails", runtimeInstanceID: "runtime-4", expectErr: true},
+		{name: "Cluster with the default runtime type uses its config", runtimeInstanceID: "runtime-5", expect: "bar-2"},
+		{name: "Unknown runtime instance fails", runtimeInstanceID: "runtime-6", expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			message, err := getStatus(tc.runtimeInstanceID)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, message)
+		})
+	}
+
+	// The runtimes are created once for each cluster.
+	require.Len(t, created, 5)
+	_, err = getStatus("runtime-0")
+	require.NoError(t, err)
+	require.Len(t, created, 5)
+
+	deleteCluster := func(clusterID string) {
+		getResp, err := clusters.GetByID(ctx, &mrdspb.GetClusterByIDRequest{Id: clusterID})
+		require.NoError(t, err)
+		_, err = clusters.Delete(ctx, &mrdspb.DeleteClusterRequest{Metadata: getResp.Record.Metadata})
+		require.NoError(t, err)
+	}
+
+	t.Run("Runtime of another type replaces and closes the runtime", func(t *testing.T) {
+		fooRuntime := created[0]
+		fooRuntime.release = make(chan struct{})
+		fooRuntime.closeErr = fmt.Errorf("failed to stop the instances")
+		deleteCluster(fooCluster)
+
+		type result struct {
+			message string
+			err     error
+		}
+		replaced := make(chan result)
+		go func() {
+			message, err := getStatus("runtime-0")
+			replaced <- result{message: message, err: err}
+		}()
+
+		// The runtimes of the other clusters are used while the replaced runtime is closing.
+		message, err := getStatus("runtime-3")
+		require.NoError(t, err)
+		require.Equal(t, "bar", message)
+		close(fooRuntime.release)
+
+		// A failed close does not fail the request, which uses the replacement.
+		res := <-replaced
+		require.NoError(t, res.err)
+		require.Equal(t, "bar", res.message)
+		require.Len(t, created, 6)
+		require.True(t, fooRuntime.closed)
+	})
+
+	t.Run("Changed config reconfigures the runtime", func(t *testing.T) {
+		barRuntime := created[4]
+		deleteCluster(barCluster)
+
+		message, err := getStatus("runtime-5")
+		require.NoError(t, err)
+		require.Equal(t, "bar", message)
+		require.Len(t, created, 6)
+		require.False(t, barRuntime.closed)
+	})
+}
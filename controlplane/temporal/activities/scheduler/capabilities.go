@@ -0,0 +1,264 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// capabilityIndex holds the compute capabilities referenced by a deployment plan, indexed for matching.
+type capabilityIndex struct {
+	byID         map[string]*mrdspb.ComputeCapability            // capability ID -> capability
+	byTypeByName map[string]map[string]*mrdspb.ComputeCapability // capability type -> capability name -> capability
+}
+
+const (
+	CapabilitiesFilterName = "capabilities"
+	CapabilitiesScoreName  = "capability-score"
+
+	capabilityIndexStateKey = "capability-index"
+)
+
+// capabilitiesFilter excludes the nodes which do not satisfy the compute capabilities required by the plan.
+type capabilitiesFilter struct {
+	client mrdspb.ComputeCapabilitiesClient
+}
+
+func (p *capabilitiesFilter) Name() string { return CapabilitiesFilterName }
+
+func (p *capabilitiesFilter) PreFilter(ctx context.Context, state *CycleState) error {
+	return preFilterCapabilities(ctx, p.client, state)
+}
+
+func (p *capabilitiesFilter) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	index := readCapabilityIndex(state)
+	if nodeMatchesComputeCapabilities(node, state.DeploymentPlan.MatchingComputeCapabilities, index) {
+		return true, ""
+	}
+	return false, "node does not match the required compute capabilities"
+}
+
+// capabilitiesScore prefers the nodes with the highest scoring capabilities of the types referenced by the plan.
+// The score of every type is relative to the highest scoring known capability of that type.
+type capabilitiesScore struct {
+	client mrdspb.ComputeCapabilitiesClient
+}
+
+func (p *capabilitiesScore) Name() string { return CapabilitiesScoreName }
+
+func (p *capabilitiesScore) PreFilter(ctx context.Context, state *CycleState) error {
+	return preFilterCapabilities(ctx, p.client, state)
+}
+
+func (p *capabilitiesScore) Score(_ context.Context, state *CycleState, node *mrdspb.Node) int64 {
+	index := readCapabilityIndex(state)
+	if len(state.DeploymentPlan.MatchingComputeCapabilities) == 0 {
+		return 0
+	}
+
+	maxScoreByType := make(map[string]uint32)
+	for _, capability := range index.byID {
+		if capability.Score > maxScoreByType[capability.Type] {
+			maxScoreByType[capability.Type] = capability.Score
+		}
+	}
+	nodeScoreByType := make(map[string]uint32)
+	for _, capabilityID := range node.CapabilityIds {
+		capability, ok := index.byID[capabilityID]
+		if !ok {
+			continue
+		}
+		if capability.Score > nodeScoreByType[capability.Type] {
+			nodeScoreByType[capability.Type] = capability.Score
+		}
+	}
+
+	var total int64
+	var count int64
+	seen := make(map[string]bool)
+	for _, mc := range state.DeploymentPlan.MatchingComputeCapabilities {
+		if seen[mc.CapabilityType] {
+			continue
+		}
+		seen[mc.CapabilityType] = true
+		count++
+		if maxScoreByType[mc.CapabilityType] == 0 {
+			continue
+		}
+		total += int64(nodeScoreByType[mc.CapabilityType]) * MaxNodeScore / int64(maxScoreByType[mc.CapabilityType])
+	}
+	return total / count
+}
+
+// preFilterCapabilities builds the capability index once per cycle and shares it between the capability plugins.
+func preFilterCapabilities(ctx context.Context, client mrdspb.ComputeCapabilitiesClient, state *CycleState) error {
+	if _, ok := state.Read(capabilityIndexStateKey); ok {
+		return nil
+	}
+	index, err := buildCapabilityIndex(ctx, client, state.DeploymentPlan.MatchingComputeCapabilities)
+	if err != nil {
+		return err
+	}
+	state.Write(capabilityIndexStateKey, index)
+	return nil
+}
+
+func readCapabilityIndex(state *CycleState) *capabilityIndex {
+	value, ok := state.Read(capabilityIndexStateKey)
+	if !ok {
+		return &capabilityIndex{}
+	}
+	return value.(*capabilityIndex)
+}
+
+// buildCapabilityIndex fetches all the compute capabilities of the types referenced by the matching
+// compute capabilities of a deployment plan.
+func buildCapabilityIndex(
+	ctx context.Context, client mrdspb.ComputeCapabilitiesClient, matchingCapabilities []*mrdspb.MatchingComputeCapability,
+) (*capabilityIndex, error) {
+	index := &capabilityIndex{
+		byID:         make(map[string]*mrdspb.ComputeCapability),
+		byTypeByName: make(map[string]map[string]*mrdspb.ComputeCapability),
+	}
+	if len(matchingCapabilities) == 0 {
+		return index, nil
+	}
+
+	capabilityTypes := make([]string, 0, len(matchingCapabilities))
+	for _, mc := range matchingCapabilities {
+		capabilityTypes = append(capabilityTypes, mc.CapabilityType)
+	}
+
+	listResp, err := client.List(ctx, &mrdspb.ListComputeCapabilityRequest{
+		TypeIn: capabilityTypes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compute capabilities: %w", err)
+	}
+
+	for _, capability := range listResp.Records {
+		index.byID[capability.Metadata.Id] = capability
+		if _, ok := index.byTypeByName[capability.Type]; !ok {
+			index.byTypeByName[capability.Type] = make(map[string]*mrdspb.ComputeCapability)
+		}
+		index.byTypeByName[capability.Type][capability.Name] = capability
+	}
+
+	// GTE and LTE comparisons are relative to the named capabilities. They can only be evaluated
+	// if at least one of the named capabilities is known.
+	for _, mc := range matchingCapabilities {
+		if mc.Comparator != mrdspb.Comparator_Comparator_GTE && mc.Comparator != mrdspb.Comparator_ComparatorE_LTE {
+			continue
+		}
+		found := false
+		for _, name := range mc.CapabilityNames {
+			if _, ok := index.byTypeByName[mc.CapabilityType][name]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(
+				"none of the capabilities %v of type %s are known. Cannot evaluate %s",
+				mc.CapabilityNames, mc.CapabilityType, mc.Comparator,
+			)
+		}
+	}
+
+	return index, nil
+}
+
+// nodeMatchesComputeCapabilities returns true if the node satisfies every matching compute capability.
+func nodeMatchesComputeCapabilities(
+	node *mrdspb.Node, matchingCapabilities []*mrdspb.MatchingComputeCapability, index *capabilityIndex,
+) bool {
+	// Resolve the capabilities of the node and group them by type.
+	nodeCapabilitiesByType := make(map[string][]*mrdspb.ComputeCapability)
+	for _, capabilityID := range node.CapabilityIds {
+		capability, ok := index.byID[capabilityID]
+		if !ok {
+			continue
+		}
+		nodeCapabilitiesByType[capability.Type] = append(nodeCapabilitiesByType[capability.Type], capability)
+	}
+
+	for _, mc := range matchingCapabilities {
+		if !capabilityMatches(nodeCapabilitiesByType[mc.CapabilityType], mc, index) {
+			return false
+		}
+	}
+	return true
+}
+
+// capabilityMatches evaluates a single matching compute capability against the node capabilities of the same type.
+func capabilityMatches(
+	nodeCapabilities []*mrdspb.ComputeCapability, mc *mrdspb.MatchingComputeCapability, index *capabilityIndex,
+) bool {
+	names := make(map[string]bool)
+	for _, name := range mc.CapabilityNames {
+		names[name] = true
+	}
+
+	switch mc.Comparator {
+	case mrdspb.Comparator_Comparator_IN:
+		// The node must have at least one of the named capabilities.
+		for _, capability := range nodeCapabilities {
+			if names[capability.Name] {
+				return true
+			}
+		}
+		return false
+
+	case mrdspb.Comparator_Comparator_NOT_IN:
+		// The node must not have any of the named capabilities.
+		for _, capability := range nodeCapabilities {
+			if names[capability.Name] {
+				return false
+			}
+		}
+		return true
+
+	case mrdspb.Comparator_Comparator_GTE:
+		// The node must have a capability which scores at least as high as the lowest scoring named capability.
+		var minScore uint32
+		first := true
+		for _, name := range mc.CapabilityNames {
+			named, ok := index.byTypeByName[mc.CapabilityType][name]
+			if !ok {
+				continue
+			}
+			if first || named.Score < minScore {
+				minScore = named.Score
+				first = false
+			}
+		}
+		for _, capability := range nodeCapabilities {
+			if capability.Score >= minScore {
+				return true
+			}
+		}
+		return false
+
+	case mrdspb.Comparator_ComparatorE_LTE:
+		// The node must have a capability which scores at most as high as the highest scoring named capability.
+		var maxScore uint32
+		for _, name := range mc.CapabilityNames {
+			named, ok := index.byTypeByName[mc.CapabilityType][name]
+			if !ok {
+				continue
+			}
+			if named.Score > maxScore {
+				maxScore = named.Score
+			}
+		}
+		for _, capability := range nodeCapabilities {
+			if capability.Score <= maxScore {
+				return true
+			}
+		}
+		return false
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeMatchesComputeCapabilities(t *testing.T) {
+	capabilities := []*mrdspb.ComputeCapability{
+		{Metadata: &mrdspb.Metadata{Id: "gpu-p100"}, Name: "nvidia-p100", Type: "GPU", Score: 10},
+		{Metadata: &mrdspb.Metadata{Id: "gpu-v100"}, Name: "nvidia-v100", Type: "GPU", Score: 20},
+		{Metadata: &mrdspb.Metadata{Id: "gpu-a100"}, Name: "nvidia-a100", Type: "GPU", Score: 30},
+		{Metadata: &mrdspb.Metadata{Id: "cpu-xeon"}, Name: "intel-xeon", Type: "CPU", Score: 10},
+	}
+	index := &capabilityIndex{
+		byID:         make(map[string]*mrdspb.ComputeCapability),
+		byTypeByName: make(map[string]map[string]*mrdspb.ComputeCapability),
+	}
+	for _, capability := range capabilities {
+		index.byID[capability.Metadata.Id] = capability
+		if _, ok := index.byTypeByName[capability.Type]; !ok {
+			index.byTypeByName[capability.Type] = make(map[string]*mrdspb.ComputeCapability)
+		}
+		index.byTypeByName[capability.Type][capability.Name] = capability
+	}
+
+	testCases := []struct {
+		name          string
+		capabilityIDs []string
+		matching      []*mrdspb.MatchingComputeCapability
+		expected      bool
+	}{
+		{
+			name:          "No requirements",
+			capabilityIDs: nil,
+			matching:      nil,
+			expected:      true,
+		},
+		{
+			name:          "IN matches",
+			capabilityIDs: []string{"gpu-v100", "cpu-xeon"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_IN, CapabilityNames: []string{"nvidia-v100", "nvidia-a100"}},
+			},
+			expected: true,
+		},
+		{
+			name:          "IN does not match CPU only node",
+			capabilityIDs: []string{"cpu-xeon"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_IN, CapabilityNames: []string{"nvidia-v100"}},
+			},
+			expected: false,
+		},
+		{
+			name:          "NOT_IN matches",
+			capabilityIDs: []string{"gpu-a100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_NOT_IN, CapabilityNames: []string{"nvidia-p100"}},
+			},
+			expected: true,
+		},
+		{
+			name:          "NOT_IN does not match",
+			capabilityIDs: []string{"gpu-p100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_NOT_IN, CapabilityNames: []string{"nvidia-p100"}},
+			},
+			expected: false,
+		},
+		{
+			name:          "GTE matches higher score",
+			capabilityIDs: []string{"gpu-a100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_GTE, CapabilityNames: []string{"nvidia-v100"}},
+			},
+			expected: true,
+		},
+		{
+			name:          "GTE does not match lower score",
+			capabilityIDs: []string{"gpu-p100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_GTE, CapabilityNames: []string{"nvidia-v100"}},
+			},
+			expected: false,
+		},
+		{
+			name:          "GTE does not match node without capability type",
+			capabilityIDs: []string{"cpu-xeon"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_GTE, CapabilityNames: []string{"nvidia-p100"}},
+			},
+			expected: false,
+		},
+		{
+			name:          "LTE matches lower score",
+			capabilityIDs: []string{"gpu-p100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_ComparatorE_LTE, CapabilityNames: []string{"nvidia-v100"}},
+			},
+			expected: true,
+		},
+		{
+			name:          "LTE does not match higher score",
+			capabilityIDs: []string{"gpu-a100"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_ComparatorE_LTE, CapabilityNames: []string{"nvidia-v100"}},
+			},
+			expected: false,
+		},
+		{
+			name:          "All requirements must match",
+			capabilityIDs: []string{"gpu-a100", "cpu-xeon"},
+			matching: []*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_GTE, CapabilityNames: []string{"nvidia-v100"}},
+				{CapabilityType: "CPU", Comparator: mrdspb.Comparator_Comparator_NOT_IN, CapabilityNames: []string{"intel-xeon"}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			node := &mrdspb.Node{
+				Metadata:      &mrdspb.Metadata{Id: "node-1"},
+				CapabilityIds: tc.capabilityIDs,
+			}
+			require.Equal(t, tc.expected, nodeMatchesComputeCapabilities(node, tc.matching, index))
+		})
+	}
+}
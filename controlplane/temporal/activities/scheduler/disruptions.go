@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const (
+	DisruptionsFilterName = "disruptions"
+
+	disruptionsFreeNodesStateKey = "disruptions-free-nodes"
+)
+
+// disruptionsFilter excludes the nodes which have a disruption that is not yet completed, so that instances
+// are not placed on nodes about to be disrupted. The disruptions are matched by the ledger.
+type disruptionsFilter struct {
+	nodes mrdspb.NodesClient
+}
+
+func (p *disruptionsFilter) Name() string { return DisruptionsFilterName }
+
+// PreFilter finds the nodes which have no active disruption.
+func (p *disruptionsFilter) PreFilter(ctx context.Context, state *CycleState) error {
+	listResp, err := p.nodes.List(ctx, &mrdspb.ListNodeRequest{
+		StateIn:            []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATED},
+		NoActiveDisruption: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	freeNodes := make(map[string]bool)
+	for _, node := range listResp.Records {
+		freeNodes[node.Metadata.Id] = true
+	}
+	state.Write(disruptionsFreeNodesStateKey, freeNodes)
+	return nil
+}
+
+func (p *disruptionsFilter) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	value, ok := state.Read(disruptionsFreeNodesStateKey)
+	if !ok {
+		return true, ""
+	}
+	if !value.(map[string]bool)[node.Metadata.Id] {
+		return false, "the node has an active disruption"
+	}
+	return true, ""
+}
@@ -0,0 +1,242 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// MaxNodeScore is the highest score a ScorePlugin can give to a node.
+const MaxNodeScore int64 = 100
+
+// Plugin is the base interface implemented by all scheduler plugins.
+type Plugin interface {
+	// Name returns the unique name of the plugin. It is used to reference the plugin from profiles.
+	Name() string
+}
+
+// PreFilterPlugin is implemented by plugins which need to prepare state before nodes are evaluated.
+// PreFilter is called once per scheduling cycle, before any Filter or Score call.
+type PreFilterPlugin interface {
+	Plugin
+	PreFilter(ctx context.Context, state *CycleState) error
+}
+
+// FilterPlugin excludes nodes which cannot run the instance being scheduled.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns true if the node can run the instance. If not, a human-readable reason is returned.
+	Filter(ctx context.Context, state *CycleState, node *mrdspb.Node) (bool, string)
+}
+
+// NodeSetFilterPlugin is implemented by filters which need to see all the nodes which passed the preceding
+// filters before evaluating them one by one. This is used for constraints spanning multiple nodes.
+type NodeSetFilterPlugin interface {
+	FilterPlugin
+	PrepareFilter(ctx context.Context, state *CycleState, nodes []*mrdspb.Node) error
+}
+
+// ScorePlugin ranks the nodes which passed all the filters.
+type ScorePlugin interface {
+	Plugin
+	// Score returns a value between 0 and MaxNodeScore. Higher is better.
+	Score(ctx context.Context, state *CycleState, node *mrdspb.Node) int64
+}
+
+// CycleState holds the information about the instance being scheduled. It is shared by all the plugins
+// for the duration of a single scheduling cycle.
+type CycleState struct {
+	MetaInstance   *mrdspb.MetaInstance
+	DeploymentPlan *mrdspb.DeploymentPlanRecord
+
+	data map[string]any // Plugin specific data, keyed by plugin name.
+}
+
+// NewCycleState creates the state for scheduling the given meta instance.
+func NewCycleState(metaInstance *mrdspb.MetaInstance, deploymentPlan *mrdspb.DeploymentPlanRecord) *CycleState {
+	return &CycleState{
+		MetaInstance:   metaInstance,
+		DeploymentPlan: deploymentPlan,
+		data:           make(map[string]any),
+	}
+}
+
+// Write stores plugin specific data in the cycle state.
+func (s *CycleState) Write(key string, value any) {
+	s.data[key] = value
+}
+
+// Read returns plugin specific data previously stored in the cycle state.
+func (s *CycleState) Read(key string) (any, bool) {
+	value, ok := s.data[key]
+	return value, ok
+}
+
+// RequestedResources returns the sum of the resources requested by all the applications of the plan.
+func (s *CycleState) RequestedResources() (cores uint32, memory uint32) {
+	for _, app := range s.DeploymentPlan.Applications {
+		cores += app.Resources.Cores
+		memory += app.Resources.Memory
+	}
+	return cores, memory
+}
+
+// NodeScore is the final score of a node after all the score plugins have run.
+type NodeScore struct {
+	Node  *mrdspb.Node
+	Score int64
+}
+
+// weightedScorePlugin is a ScorePlugin with the weight assigned to it by a profile.
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+// framework runs the plugins of a single profile.
+type framework struct {
+	profileName string
+	preFilters  []PreFilterPlugin
+	filters     []FilterPlugin
+	scores      []weightedScorePlugin
+}
+
+// newFramework instantiates the plugins referenced by the profile.
+func newFramework(profile Profile, clients Clients) (*framework, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	f := &framework{profileName: profile.Name}
+	instances := make(map[string]Plugin)
+
+	getPlugin := func(name string) (Plugin, error) {
+		if p, ok := instances[name]; ok {
+			return p, nil
+		}
+		factory, ok := pluginRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown scheduler plugin %q in profile %q", name, profile.Name)
+		}
+		p := factory(clients)
+		instances[name] = p
+		if preFilter, ok := p.(PreFilterPlugin); ok {
+			f.preFilters = append(f.preFilters, preFilter)
+		}
+		return p, nil
+	}
+
+	for _, name := range profile.Filters {
+		p, err := getPlugin(name)
+		if err != nil {
+			return nil, err
+		}
+		filter, ok := p.(FilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("scheduler plugin %q is not a filter plugin", name)
+		}
+		f.filters = append(f.filters, filter)
+	}
+
+	for _, s := range profile.Scores {
+		p, err := getPlugin(s.Name)
+		if err != nil {
+			return nil, err
+		}
+		score, ok := p.(ScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("scheduler plugin %q is not a score plugin", s.Name)
+		}
+		weight := s.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		f.scores = append(f.scores, weightedScorePlugin{ScorePlugin: score, weight: weight})
+	}
+
+	return f, nil
+}
+
+// schedule runs a full scheduling cycle over the given nodes and returns the feasible nodes ordered
+// from the most preferred to the least preferred.
+func (f *framework) schedule(ctx context.Context, state *CycleState, nodes []*mrdspb.Node) ([]NodeScore, error) {
+	for _, p := range f.preFilters {
+		if err := p.PreFilter(ctx, state); err != nil {
+			return nil, fmt.Errorf("pre-filter %s failed: %w", p.Name(), err)
+		}
+	}
+
+	// Filters run in order, each one over the nodes which passed the previous filters.
+	feasible := nodes
+	rejections := make(map[string]int)
+	reasons := make(map[string]string) // An example rejection reason per filter.
+	for _, filter := range f.filters {
+		if nodeSetFilter, ok := filter.(NodeSetFilterPlugin); ok {
+			if err := nodeSetFilter.PrepareFilter(ctx, state, feasible); err != nil {
+				return nil, fmt.Errorf("filter %s failed: %w", filter.Name(), err)
+			}
+		}
+		remaining := make([]*mrdspb.Node, 0, len(feasible))
+		for _, node := range feasible {
+			ok, reason := filter.Filter(ctx, state, node)
+			if !ok {
+				rejections[filter.Name()]++
+				reasons[filter.Name()] = fmt.Sprintf("%s: %s", node.Name, reason)
+				continue
+			}
+			remaining = append(remaining, node)
+		}
+		feasible = remaining
+	}
+
+	if len(feasible) == 0 {
+		return nil, fmt.Errorf("no nodes available to allocate: 0/%d nodes are available%s", len(nodes), formatRejections(rejections, reasons))
+	}
+
+	scores := make([]NodeScore, 0, len(feasible))
+	for _, node := range feasible {
+		var total int64
+		for _, s := range f.scores {
+			total += s.weight * clampScore(s.Score(ctx, state, node))
+		}
+		scores = append(scores, NodeScore{Node: node, Score: total})
+	}
+
+	// Break ties by node name so that the placement is deterministic.
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Node.Name < scores[j].Node.Name
+	})
+	return scores, nil
+}
+
+func clampScore(score int64) int64 {
+	if score < 0 {
+		return 0
+	}
+	if score > MaxNodeScore {
+		return MaxNodeScore
+	}
+	return score
+}
+
+func formatRejections(rejections map[string]int, reasons map[string]string) string {
+	if len(rejections) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(rejections))
+	for name := range rejections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%d rejected by %s (%s)", rejections[name], name, reasons[name]))
+	}
+	return ": " + strings.Join(messages, ", ")
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const (
+	PortsFilterName = "ports"
+
+	portsFreeNodesStateKey = "ports-free-nodes"
+)
+
+// portsFilter excludes the nodes on which a port requested by the plan is already allocated to another
+// runtime instance. The port allocations are tracked by the ledger, which also rejects a runtime instance
+// whose ports collide with the ones already allocated on the node.
+type portsFilter struct {
+	nodes mrdspb.NodesClient
+}
+
+func (p *portsFilter) Name() string { return PortsFilterName }
+
+// PreFilter finds the nodes on which none of the requested ports are allocated.
+func (p *portsFilter) PreFilter(ctx context.Context, state *CycleState) error {
+	ports := requestedPorts(state.DeploymentPlan)
+	if len(ports) == 0 {
+		return nil
+	}
+
+	listResp, err := p.nodes.List(ctx, &mrdspb.ListNodeRequest{
+		StateIn:       []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATED},
+		PortsNotInUse: ports,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	freeNodes := make(map[string]bool)
+	for _, node := range listResp.Records {
+		freeNodes[node.Metadata.Id] = true
+	}
+	state.Write(portsFreeNodesStateKey, freeNodes)
+	return nil
+}
+
+func (p *portsFilter) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	value, ok := state.Read(portsFreeNodesStateKey)
+	if !ok {
+		return true, ""
+	}
+	if !value.(map[string]bool)[node.Metadata.Id] {
+		return false, "a requested port is already in use"
+	}
+	return true, ""
+}
+
+// requestedPorts returns the ports requested by all the applications of the plan.
+func requestedPorts(plan *mrdspb.DeploymentPlanRecord) []*mrdspb.NodePort {
+	ports := make([]*mrdspb.NodePort, 0)
+	for _, app := range plan.Applications {
+		for _, port := range app.Ports {
+			ports = append(ports, &mrdspb.NodePort{Protocol: port.Protocol, Port: port.Port})
+		}
+	}
+	return ports
+}
@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const (
+	// DefaultProfileName spreads the instances across the nodes with the most free resources.
+	DefaultProfileName = "default"
+	// BinPackingProfileName packs the instances onto the fewest possible nodes.
+	BinPackingProfileName = "bin-packing"
+)
+
+// Clients are the gRPC clients made available to the plugins when they are instantiated.
+type Clients struct {
+	MetaInstances       mrdspb.MetaInstancesClient
+	Nodes               mrdspb.NodesClient
+	DeploymentPlans     mrdspb.DeploymentPlansClient
+	ComputeCapabilities mrdspb.ComputeCapabilitiesClient
+}
+
+// PluginFactory creates an instance of a plugin.
+type PluginFactory func(clients Clients) Plugin
+
+// ScorePluginConfig references a score plugin and the weight given to its score.
+type ScorePluginConfig struct {
+	Name   string
+	Weight int64
+}
+
+// Profile is a named set of filter and score plugins. Filters run in the order in which they are listed.
+type Profile struct {
+	Name    string
+	Filters []string
+	Scores  []ScorePluginConfig
+}
+
+var (
+	registryMu     sync.RWMutex
+	pluginRegistry = map[string]PluginFactory{
+		ResourcesFilterName:    func(Clients) Plugin { return &resourcesFilter{} },
+		CapabilitiesFilterName: func(c Clients) Plugin { return &capabilitiesFilter{client: c.ComputeCapabilities} },
+		VolumesFilterName:      func(Clients) Plugin { return &volumesFilter{} },
+		PortsFilterName:        func(c Clients) Plugin { return &portsFilter{nodes: c.Nodes} },
+		DisruptionsFilterName:  func(c Clients) Plugin { return &disruptionsFilter{nodes: c.Nodes} },
+		BinPackingScoreName:    func(Clients) Plugin { return &binPackingScore{} },
+		SpreadingScoreName:     func(Clients) Plugin { return &spreadingScore{} },
+		CapabilitiesScoreName:  func(c Clients) Plugin { return &capabilitiesScore{client: c.ComputeCapabilities} },
+		UpdateDomainSpreadName: func(c Clients) Plugin {
+			return &updateDomainSpread{metaInstances: c.MetaInstances, nodes: c.Nodes, maxSkew: defaultMaxSkew}
+		},
+	}
+
+	defaultFilters = []string{
+		DisruptionsFilterName,
+		ResourcesFilterName,
+		CapabilitiesFilterName,
+		VolumesFilterName,
+		PortsFilterName,
+		UpdateDomainSpreadName, // Must be last. The eligible update domains are those of the nodes which passed the other filters.
+	}
+
+	profileRegistry = map[string]Profile{
+		DefaultProfileName: {
+			Name:    DefaultProfileName,
+			Filters: defaultFilters,
+			Scores: []ScorePluginConfig{
+				{Name: SpreadingScoreName, Weight: 1},
+				{Name: CapabilitiesScoreName, Weight: 1},
+				{Name: UpdateDomainSpreadName, Weight: 1},
+			},
+		},
+		BinPackingProfileName: {
+			Name:    BinPackingProfileName,
+			Filters: defaultFilters,
+			Scores: []ScorePluginConfig{
+				{Name: BinPackingScoreName, Weight: 1},
+				{Name: CapabilitiesScoreName, Weight: 1},
+				{Name: UpdateDomainSpreadName, Weight: 1},
+			},
+		},
+	}
+)
+
+// RegisterPlugin makes a plugin available to the scheduler profiles.
+func RegisterPlugin(name string, factory PluginFactory) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := pluginRegistry[name]; ok {
+		return fmt.Errorf("scheduler plugin %q is already registered", name)
+	}
+	pluginRegistry[name] = factory
+	return nil
+}
+
+// RegisterProfile makes a profile selectable by deployment plans and by the controlplane configuration.
+func RegisterProfile(profile Profile) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if profile.Name == "" {
+		return fmt.Errorf("scheduler profile name is required")
+	}
+	if _, ok := profileRegistry[profile.Name]; ok {
+		return fmt.Errorf("scheduler profile %q is already registered", profile.Name)
+	}
+	profileRegistry[profile.Name] = profile
+	return nil
+}
+
+// ProfileNames returns the names of all the registered profiles.
+func ProfileNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(profileRegistry))
+	for name := range profileRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getProfile(name string) (Profile, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	profile, ok := profileRegistry[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown scheduler profile %q", name)
+	}
+	return profile, nil
+}
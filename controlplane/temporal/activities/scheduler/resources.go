@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const (
+	ResourcesFilterName = "resources"
+	BinPackingScoreName = "bin-packing"
+	SpreadingScoreName  = "spreading"
+)
+
+// resourcesFilter excludes the nodes which do not have enough remaining cores and memory.
+type resourcesFilter struct{}
+
+func (p *resourcesFilter) Name() string { return ResourcesFilterName }
+
+func (p *resourcesFilter) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	cores, memory := state.RequestedResources()
+	remaining := node.GetRemainingResources()
+	if remaining.GetCores() < cores {
+		return false, fmt.Sprintf("insufficient cores. Requested: %d, Available: %d", cores, remaining.GetCores())
+	}
+	if remaining.GetMemory() < memory {
+		return false, fmt.Sprintf("insufficient memory. Requested: %d, Available: %d", memory, remaining.GetMemory())
+	}
+	return true, ""
+}
+
+// binPackingScore prefers the nodes which are the most utilized once the instance is placed on them.
+type binPackingScore struct{}
+
+func (p *binPackingScore) Name() string { return BinPackingScoreName }
+
+func (p *binPackingScore) Score(_ context.Context, state *CycleState, node *mrdspb.Node) int64 {
+	return utilizationAfterPlacement(state, node)
+}
+
+// spreadingScore prefers the nodes which are the least utilized once the instance is placed on them.
+type spreadingScore struct{}
+
+func (p *spreadingScore) Name() string { return SpreadingScoreName }
+
+func (p *spreadingScore) Score(_ context.Context, state *CycleState, node *mrdspb.Node) int64 {
+	return MaxNodeScore - utilizationAfterPlacement(state, node)
+}
+
+// utilizationAfterPlacement returns the average of the core and memory utilization of the node, scaled
+// between 0 and MaxNodeScore, assuming the instance is placed on it.
+func utilizationAfterPlacement(state *CycleState, node *mrdspb.Node) int64 {
+	cores, memory := state.RequestedResources()
+	coreUtilization := utilization(
+		node.GetTotalResources().GetCores()-node.GetSystemReservedResources().GetCores(),
+		node.GetRemainingResources().GetCores(),
+		cores,
+	)
+	memoryUtilization := utilization(
+		node.GetTotalResources().GetMemory()-node.GetSystemReservedResources().GetMemory(),
+		node.GetRemainingResources().GetMemory(),
+		memory,
+	)
+	return (coreUtilization + memoryUtilization) / 2
+}
+
+func utilization(allocatable, remaining, requested uint32) int64 {
+	if allocatable == 0 {
+		return MaxNodeScore
+	}
+	used := int64(allocatable) - int64(remaining) + int64(requested)
+	return clampScore(used * MaxNodeScore / int64(allocatable))
+}
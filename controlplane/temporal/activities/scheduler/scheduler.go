@@ -11,23 +11,36 @@ import (
 	"go.temporal.io/sdk/worker"
 )
 
+// maxAllocationAttempts is how many times a runtime instance is scheduled again when it cannot be added to
+// the node it was placed on, before the allocation fails.
+const maxAllocationAttempts = 10
+
 type SchedulerActivities struct {
-	metaInstancesClient   mrdspb.MetaInstancesClient
-	nodesClient           mrdspb.NodesClient
-	deploymentPlansClient mrdspb.DeploymentPlansClient
+	metaInstancesClient       mrdspb.MetaInstancesClient
+	nodesClient               mrdspb.NodesClient
+	deploymentPlansClient     mrdspb.DeploymentPlansClient
+	computeCapabilitiesClient mrdspb.ComputeCapabilitiesClient
+	defaultProfile            string // The profile used for deployment plans which do not select one.
 }
 
-// NewSchedulerActivities creates a new instance of ClusterActivities.
+// NewSchedulerActivities creates a new instance of SchedulerActivities.
 func NewSchedulerActivities(
 	metaInstancesClient mrdspb.MetaInstancesClient,
 	nodesClient mrdspb.NodesClient,
 	deploymentPlansClient mrdspb.DeploymentPlansClient,
+	computeCapabilitiesClient mrdspb.ComputeCapabilitiesClient,
+	defaultProfile string,
 	registry worker.Registry,
 ) *SchedulerActivities {
+	if defaultProfile == "" {
+		defaultProfile = DefaultProfileName
+	}
 	a := &SchedulerActivities{
-		metaInstancesClient:   metaInstancesClient,
-		nodesClient:           nodesClient,
-		deploymentPlansClient: deploymentPlansClient,
+		metaInstancesClient:       metaInstancesClient,
+		nodesClient:               nodesClient,
+		deploymentPlansClient:     deploymentPlansClient,
+		computeCapabilitiesClient: computeCapabilitiesClient,
+		defaultProfile:            defaultProfile,
 	}
 	registry.RegisterActivity(a.AllocateRuntimeInstance)
 	return a
@@ -43,9 +56,9 @@ type AllocateRuntimeInstanceResponse struct {
 	RuntimeInstance *mrdspb.RuntimeInstance
 }
 
-// CreateCluster is an activity that interacts with the gRPC service to create a Cluster.
+// AllocateRuntimeInstance is an activity which places a new runtime instance of a meta instance on a node.
 func (c *SchedulerActivities) AllocateRuntimeInstance(ctx context.Context, req *AllocateRuntimeInstanceParams) (*AllocateRuntimeInstanceResponse, error) {
-	activity.GetLogger(ctx).Info("Creating Cluster", "request", req)
+	activity.GetLogger(ctx).Info("Allocating runtime instance", "request", req)
 
 	// Get the meta instance
 	metaInstanceGetResp, err := c.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
@@ -78,62 +91,87 @@ func (c *SchedulerActivities) AllocateRuntimeInstance(ctx context.Context, req *
 	dp := deploymentPlanGetResp.Record
 
 	payloadNames := make([]string, 0)
-	requestedCores := uint32(0)
-	requestedMemory := uint32(0)
-
 	for _, app := range dp.Applications {
 		payloadNames = append(payloadNames, app.PayloadName)
-		requestedCores += app.Resources.Cores
-		requestedMemory += app.Resources.Memory
 	}
 
-	// Find a node that can accomodate the requested resources and does not have
-	// existing instances of the same payload.
-	nodeListResp, err := c.nodesClient.List(ctx, &mrdspb.ListNodeRequest{
-		StateIn:            []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATED},
-		RemainingCoresGte:  requestedCores,
-		RemainingMemoryGte: requestedMemory,
-		PayloadNameNotIn:   payloadNames,
-	})
-	if err != nil {
-		activity.GetLogger(ctx).Error("Failed to list nodes", "error", err)
-		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	profileName := dp.SchedulerProfile
+	if profileName == "" {
+		profileName = c.defaultProfile
 	}
-	if len(nodeListResp.Records) == 0 {
-		activity.GetLogger(ctx).Error("No nodes available to allocate", "error", err)
-		return nil, fmt.Errorf("no nodes available to allocate")
+	profile, err := getProfile(profileName)
+	if err != nil {
+		activity.GetLogger(ctx).Error("Failed to get scheduler profile", "error", err)
+		return nil, err
 	}
-
-	// For now, pick the first node that matches the criteria.
-	chosenNode := nodeListResp.Records[0]
-
-	// The metaInstance could've been updated, so get the latest version.
-	metaInstanceGetResp, err = c.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
-		Id: metaInstance.Metadata.Id,
+	fw, err := newFramework(profile, Clients{
+		MetaInstances:       c.metaInstancesClient,
+		Nodes:               c.nodesClient,
+		DeploymentPlans:     c.deploymentPlansClient,
+		ComputeCapabilities: c.computeCapabilitiesClient,
 	})
 	if err != nil {
-		activity.GetLogger(ctx).Error("Failed to get MetaInstance", "error", err)
-		return nil, fmt.Errorf("failed to get MetaInstance: %w", err)
+		activity.GetLogger(ctx).Error("Failed to initialize scheduler profile", "error", err)
+		return nil, fmt.Errorf("failed to initialize scheduler profile: %w", err)
 	}
 
-	// Create the runtime instance
-	runtimeInstance := &mrdspb.RuntimeInstance{
-		Id:       uuid.New().String(),
-		NodeId:   chosenNode.Metadata.Id,
-		IsActive: req.IsActive,
-		Status: &mrdspb.RuntimeInstanceStatus{
-			State:   mrdspb.RuntimeInstanceState_RuntimeState_PENDING,
-			Message: "",
-		},
-	}
+	// Concurrent allocations see the same nodes and may pick the same one, in which case all but one of them
+	// fail to add their runtime instance. The nodes are then listed and scored again, so that the node which
+	// was taken is left out or scored with the resources it has left.
+	var updateResp *mrdspb.UpdateMetaInstanceResponse
+	var runtimeInstance *mrdspb.RuntimeInstance
+	for attempt := 1; ; attempt++ {
+		// List the allocated nodes which do not have existing instances of the same payload.
+		// The plugins of the profile decide which of them can run the instance.
+		nodeListResp, err := c.nodesClient.List(ctx, &mrdspb.ListNodeRequest{
+			StateIn:          []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATED},
+			PayloadNameNotIn: payloadNames,
+		})
+		if err != nil {
+			activity.GetLogger(ctx).Error("Failed to list nodes", "error", err)
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
 
-	updateResp, err := c.metaInstancesClient.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
-		Metadata:        metaInstanceGetResp.Record.Metadata,
-		RuntimeInstance: runtimeInstance,
-	})
-	if err != nil {
-		activity.GetLogger(ctx).Error("Failed to add Runtime Instance", "error", err)
-		return nil, fmt.Errorf("failed to add Runtime Instance: %w", err)
+		nodeScores, err := fw.schedule(ctx, NewCycleState(metaInstance, dp), nodeListResp.Records)
+		if err != nil {
+			activity.GetLogger(ctx).Error("Failed to schedule instance", "profile", profileName, "error", err)
+			return nil, err
+		}
+		chosenNode := nodeScores[0].Node
+		activity.GetLogger(ctx).Info("Scheduled instance", "profile", profileName, "node", chosenNode.Name, "score", nodeScores[0].Score)
+
+		// The metaInstance could've been updated, so get the latest version.
+		metaInstanceGetResp, err = c.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
+			Id: metaInstance.Metadata.Id,
+		})
+		if err != nil {
+			activity.GetLogger(ctx).Error("Failed to get MetaInstance", "error", err)
+			return nil, fmt.Errorf("failed to get MetaInstance: %w", err)
+		}
+
+		// Create the runtime instance
+		runtimeInstance = &mrdspb.RuntimeInstance{
+			Id:       uuid.New().String(),
+			NodeId:   chosenNode.Metadata.Id,
+			IsActive: req.IsActive,
+			Status: &mrdspb.RuntimeInstanceStatus{
+				State:   mrdspb.RuntimeInstanceState_RuntimeState_PENDING,
+				Message: "",
+			},
+		}
+
+		updateResp, err = c.metaInstancesClient.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+			Metadata:        metaInstanceGetResp.Record.Metadata,
+			RuntimeInstance: runtimeInstance,
+		})
+		if err == nil {
+			break
+		}
+		if attempt == maxAllocationAttempts {
+			activity.GetLogger(ctx).Error("Failed to add Runtime Instance", "error", err)
+			return nil, fmt.Errorf("failed to add Runtime Instance: %w", err)
+		}
+		activity.GetLogger(ctx).Warn("Failed to add Runtime Instance, scheduling it again", "node", chosenNode.Name, "attempt", attempt, "error", err)
 	}
 
 	return &AllocateRuntimeInstanceResponse{
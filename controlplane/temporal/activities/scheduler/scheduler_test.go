@@ -0,0 +1,481 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type testNode struct {
+	name          string
+	updateDomain  string
+	cores         uint32
+	memory        uint32
+	localVolumes  []*mrdspb.NodeLocalVolume
+	capabilityIDs []string
+	disruption    mrdspb.DisruptionState // Adds a disruption in this state when set.
+}
+
+// existingInstance is an instance of another plan already placed on a node before the test runs.
+type existingInstance struct {
+	nodeName string
+	ports    []*mrdspb.ApplicationPort
+	volumes  []*mrdspb.ApplicationPersistentVolume
+}
+
+type testEnv struct {
+	ctx                 context.Context
+	activityEnv         *testsuite.TestActivityEnvironment
+	nodes               mrdspb.NodesClient
+	metaInstances       mrdspb.MetaInstancesClient
+	deploymentPlans     mrdspb.DeploymentPlansClient
+	computeCapabilities mrdspb.ComputeCapabilitiesClient
+	nodeIDs             map[string]string
+	instanceCount       int
+}
+
+func newTestEnv(t *testing.T, defaultProfile string) *testEnv {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Close)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := &testEnv{
+		ctx:                 context.Background(),
+		activityEnv:         testSuite.NewTestActivityEnvironment(),
+		nodes:               mrdspb.NewNodesClient(ts.Conn()),
+		metaInstances:       mrdspb.NewMetaInstancesClient(ts.Conn()),
+		deploymentPlans:     mrdspb.NewDeploymentPlansClient(ts.Conn()),
+		computeCapabilities: mrdspb.NewComputeCapabilitiesClient(ts.Conn()),
+		nodeIDs:             make(map[string]string),
+	}
+	activities := &SchedulerActivities{
+		metaInstancesClient:       env.metaInstances,
+		nodesClient:               env.nodes,
+		deploymentPlansClient:     env.deploymentPlans,
+		computeCapabilitiesClient: env.computeCapabilities,
+		defaultProfile:            defaultProfile,
+	}
+	env.activityEnv.RegisterActivity(activities.AllocateRuntimeInstance)
+	return env
+}
+
+func (e *testEnv) createCapabilities(t *testing.T, capabilities map[string]uint32) map[string]string {
+	ids := make(map[string]string)
+	for name, score := range capabilities {
+		resp, err := e.computeCapabilities.Create(e.ctx, &mrdspb.CreateComputeCapabilityRequest{
+			Name:  name,
+			Type:  "GPU",
+			Score: score,
+		})
+		require.NoError(t, err)
+		ids[name] = resp.Record.Metadata.Id
+	}
+	return ids
+}
+
+func (e *testEnv) createNode(t *testing.T, n testNode) {
+	updateDomain := n.updateDomain
+	if updateDomain == "" {
+		updateDomain = "ud-1"
+	}
+	resp, err := e.nodes.Create(e.ctx, &mrdspb.CreateNodeRequest{
+		Name:                    n.name,
+		UpdateDomain:            updateDomain,
+		TotalResources:          &mrdspb.Resources{Cores: n.cores, Memory: n.memory},
+		SystemReservedResources: &mrdspb.Resources{},
+		LocalVolumes:            n.localVolumes,
+		CapabilityIds:           n.capabilityIDs,
+	})
+	require.NoError(t, err)
+
+	metadata := resp.Record.Metadata
+	for _, state := range []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED} {
+		updateResp, err := e.nodes.UpdateStatus(e.ctx, &mrdspb.UpdateNodeStatusRequest{
+			Metadata:  metadata,
+			Status:    &mrdspb.NodeStatus{State: state},
+			ClusterId: "cluster-1",
+		})
+		require.NoError(t, err)
+		metadata = updateResp.Record.Metadata
+	}
+
+	if n.disruption != mrdspb.DisruptionState_DisruptionState_UNKNOWN {
+		updateResp, err := e.nodes.AddDisruption(e.ctx, &mrdspb.AddDisruptionRequest{
+			Metadata: metadata,
+			Disruption: &mrdspb.NodeDisruption{
+				Id:     "disruption-1",
+				Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+			},
+		})
+		require.NoError(t, err)
+		if n.disruption != mrdspb.DisruptionState_DisruptionState_SCHEDULED {
+			_, err = e.nodes.UpdateDisruptionStatus(e.ctx, &mrdspb.UpdateDisruptionStatusRequest{
+				Metadata:     updateResp.Record.Metadata,
+				DisruptionId: "disruption-1",
+				Status:       &mrdspb.DisruptionStatus{State: n.disruption},
+			})
+			require.NoError(t, err)
+		}
+	}
+	e.nodeIDs[n.name] = resp.Record.Metadata.Id
+}
+
+func (e *testEnv) createPlan(t *testing.T, req *mrdspb.CreateDeploymentPlanRequest) *mrdspb.DeploymentPlanRecord {
+	planResp, err := e.deploymentPlans.Create(e.ctx, req)
+	require.NoError(t, err)
+	coordinates := make([]*mrdspb.PayloadCoordinates, 0, len(req.Applications))
+	for _, app := range req.Applications {
+		coordinates = append(coordinates, &mrdspb.PayloadCoordinates{
+			PayloadName: app.PayloadName,
+			Coordinates: map[string]string{"image": "nginx:latest"},
+		})
+	}
+	_, err = e.deploymentPlans.AddDeployment(e.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:           planResp.Record.Metadata,
+		DeploymentId:       req.Name + "-deployment",
+		PayloadCoordinates: coordinates,
+		InstanceCount:      1,
+	})
+	require.NoError(t, err)
+	return planResp.Record
+}
+
+func (e *testEnv) createMetaInstance(t *testing.T, plan *mrdspb.DeploymentPlanRecord) *mrdspb.MetaInstance {
+	resp, err := e.metaInstances.Create(e.ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             fmt.Sprintf("%s-%d", plan.Name, len(e.nodeIDs)+e.instanceCount),
+		DeploymentPlanId: plan.Metadata.Id,
+		DeploymentId:     plan.Name + "-deployment",
+	})
+	require.NoError(t, err)
+	e.instanceCount++
+	return resp.Record
+}
+
+func (e *testEnv) addRuntimeInstance(t *testing.T, metaInstance *mrdspb.MetaInstance, nodeName string) {
+	_, err := e.metaInstances.AddRuntimeInstance(e.ctx, &mrdspb.AddRuntimeInstanceRequest{
+		Metadata: metaInstance.Metadata,
+		RuntimeInstance: &mrdspb.RuntimeInstance{
+			Id:       metaInstance.Name + "-runtime",
+			NodeId:   e.nodeIDs[nodeName],
+			IsActive: true,
+			Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func (e *testEnv) placeExistingInstance(t *testing.T, name string, instance existingInstance) {
+	plan := e.createPlan(t, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        name,
+		Namespace:   "test",
+		ServiceName: name,
+		Applications: []*mrdspb.Application{
+			{
+				PayloadName:       name,
+				Resources:         &mrdspb.ApplicationResources{Cores: 1, Memory: 1},
+				Ports:             instance.ports,
+				PersistentVolumes: instance.volumes,
+			},
+		},
+	})
+	e.addRuntimeInstance(t, e.createMetaInstance(t, plan), instance.nodeName)
+}
+
+func TestAllocateRuntimeInstance(t *testing.T) {
+	capabilities := map[string]uint32{"nvidia-p100": 10, "nvidia-a100": 30}
+
+	testCases := []struct {
+		name              string
+		defaultProfile    string
+		nodes             []testNode
+		existingInstances []existingInstance
+		planInstanceNodes []string // Nodes on which other instances of the plan are already placed.
+		plan              *mrdspb.CreateDeploymentPlanRequest
+		expectedNode      string
+		expectErr         bool
+	}{
+		{
+			name:           "Default profile spreads onto the least utilized node",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 4, memory: 4096},
+				{name: "node-b", cores: 16, memory: 16384},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Bin packing profile packs onto the most utilized node",
+			defaultProfile: BinPackingProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 4, memory: 4096},
+				{name: "node-b", cores: 16, memory: 16384},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-a",
+		},
+		{
+			name:           "Profile selected by the plan overrides the default",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 4, memory: 4096},
+				{name: "node-b", cores: 16, memory: 16384},
+			},
+			plan: func() *mrdspb.CreateDeploymentPlanRequest {
+				req := planRequest(nil, nil, nil)
+				req.SchedulerProfile = BinPackingProfileName
+				return req
+			}(),
+			expectedNode: "node-a",
+		},
+		{
+			name:           "Resources filter excludes nodes which are too small",
+			defaultProfile: BinPackingProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 1, memory: 4096},
+				{name: "node-b", cores: 16, memory: 16384},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Capabilities filter excludes nodes without the required capability",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, capabilityIDs: []string{"nvidia-p100"}},
+				{name: "node-b", cores: 4, memory: 4096, capabilityIDs: []string{"nvidia-a100"}},
+			},
+			plan: planRequest([]*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_IN, CapabilityNames: []string{"nvidia-a100"}},
+			}, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Capability score prefers the most capable node",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 8, memory: 8192, capabilityIDs: []string{"nvidia-p100"}},
+				{name: "node-b", cores: 8, memory: 8192, capabilityIDs: []string{"nvidia-a100"}},
+			},
+			plan: planRequest([]*mrdspb.MatchingComputeCapability{
+				{CapabilityType: "GPU", Comparator: mrdspb.Comparator_Comparator_GTE, CapabilityNames: []string{"nvidia-p100"}},
+			}, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Volumes filter excludes nodes without a matching local volume",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, localVolumes: []*mrdspb.NodeLocalVolume{
+					{MountPath: "/data", StorageClass: "HDD", StorageCapacity: 1000},
+				}},
+				{name: "node-b", cores: 4, memory: 4096, localVolumes: []*mrdspb.NodeLocalVolume{
+					{MountPath: "/data", StorageClass: "SSD", StorageCapacity: 1000},
+				}},
+			},
+			plan: planRequest(nil, []*mrdspb.ApplicationPersistentVolume{
+				{StorageClass: "SSD", Capacity: 500, MountPath: "/var/data"},
+			}, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Volumes filter accounts for capacity consumed by other instances",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, localVolumes: []*mrdspb.NodeLocalVolume{
+					{MountPath: "/data", StorageClass: "SSD", StorageCapacity: 1000},
+				}},
+				{name: "node-b", cores: 4, memory: 4096, localVolumes: []*mrdspb.NodeLocalVolume{
+					{MountPath: "/data", StorageClass: "SSD", StorageCapacity: 1000},
+				}},
+			},
+			existingInstances: []existingInstance{
+				{nodeName: "node-a", volumes: []*mrdspb.ApplicationPersistentVolume{
+					{StorageClass: "SSD", Capacity: 800, MountPath: "/var/data"},
+				}},
+			},
+			plan: planRequest(nil, []*mrdspb.ApplicationPersistentVolume{
+				{StorageClass: "SSD", Capacity: 500, MountPath: "/var/data"},
+			}, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Volumes filter allows several persistent volumes on one local volume",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, localVolumes: []*mrdspb.NodeLocalVolume{
+					{MountPath: "/data", StorageClass: "SSD", StorageCapacity: 1000},
+				}},
+			},
+			plan: planRequest(nil, []*mrdspb.ApplicationPersistentVolume{
+				{StorageClass: "SSD", Capacity: 500, MountPath: "/var/data"},
+				{StorageClass: "SSD", Capacity: 400, MountPath: "/var/logs"},
+			}, nil),
+			expectedNode: "node-a",
+		},
+		{
+			name:           "Ports filter excludes nodes on which the port is in use",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384},
+				{name: "node-b", cores: 4, memory: 4096},
+			},
+			existingInstances: []existingInstance{
+				{nodeName: "node-a", ports: []*mrdspb.ApplicationPort{{Protocol: "TCP", Port: 8080}}},
+			},
+			plan: planRequest(nil, nil, []*mrdspb.ApplicationPort{
+				{Protocol: "TCP", Port: 8080},
+			}),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Disruptions filter excludes nodes with a pending disruption",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, disruption: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+				{name: "node-b", cores: 4, memory: 4096},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Disruptions filter excludes nodes with an approved disruption",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, disruption: mrdspb.DisruptionState_DisruptionState_APPROVED},
+				{name: "node-b", cores: 4, memory: 4096},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-b",
+		},
+		{
+			name:           "Completed disruptions do not exclude the node",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384, disruption: mrdspb.DisruptionState_DisruptionState_COMPLETED},
+				{name: "node-b", cores: 4, memory: 4096},
+			},
+			plan:         planRequest(nil, nil, nil),
+			expectedNode: "node-a",
+		},
+		{
+			name:           "Update domain spread avoids the domain which already has an instance",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", updateDomain: "ud-1", cores: 16, memory: 16384},
+				{name: "node-b", updateDomain: "ud-1", cores: 16, memory: 16384},
+				{name: "node-c", updateDomain: "ud-2", cores: 4, memory: 4096},
+			},
+			planInstanceNodes: []string{"node-a"},
+			plan:              planRequest(nil, nil, nil),
+			expectedNode:      "node-c",
+		},
+		{
+			name:           "Update domain spread allows skew when no other domain is eligible",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", updateDomain: "ud-1", cores: 16, memory: 16384},
+				{name: "node-b", updateDomain: "ud-1", cores: 16, memory: 16384},
+				{name: "node-c", updateDomain: "ud-2", cores: 1, memory: 4096},
+			},
+			planInstanceNodes: []string{"node-a"},
+			plan:              planRequest(nil, nil, nil),
+			expectedNode:      "node-b",
+		},
+		{
+			name:           "Update domain spread takes precedence over bin packing",
+			defaultProfile: BinPackingProfileName,
+			nodes: []testNode{
+				{name: "node-a", updateDomain: "ud-1", cores: 16, memory: 16384},
+				{name: "node-b", updateDomain: "ud-1", cores: 4, memory: 4096},
+				{name: "node-c", updateDomain: "ud-2", cores: 16, memory: 16384},
+			},
+			planInstanceNodes: []string{"node-a"},
+			plan:              planRequest(nil, nil, nil),
+			expectedNode:      "node-c",
+		},
+		{
+			name:           "No feasible node",
+			defaultProfile: DefaultProfileName,
+			nodes: []testNode{
+				{name: "node-a", cores: 1, memory: 4096},
+			},
+			plan:      planRequest(nil, nil, nil),
+			expectErr: true,
+		},
+		{
+			name:           "Unknown profile",
+			defaultProfile: "does-not-exist",
+			nodes: []testNode{
+				{name: "node-a", cores: 16, memory: 16384},
+			},
+			plan:      planRequest(nil, nil, nil),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			env := newTestEnv(t, tc.defaultProfile)
+			capabilityIDs := env.createCapabilities(t, capabilities)
+			for _, n := range tc.nodes {
+				ids := make([]string, 0, len(n.capabilityIDs))
+				for _, name := range n.capabilityIDs {
+					ids = append(ids, capabilityIDs[name])
+				}
+				n.capabilityIDs = ids
+				env.createNode(t, n)
+			}
+			for i, instance := range tc.existingInstances {
+				env.placeExistingInstance(t, "existing-"+string(rune('a'+i)), instance)
+			}
+			plan := env.createPlan(t, tc.plan)
+			for _, nodeName := range tc.planInstanceNodes {
+				env.addRuntimeInstance(t, env.createMetaInstance(t, plan), nodeName)
+			}
+			metaInstance := env.createMetaInstance(t, plan)
+
+			val, err := env.activityEnv.ExecuteActivity(
+				"AllocateRuntimeInstance",
+				&AllocateRuntimeInstanceParams{MetaInstanceID: metaInstance.Metadata.Id, IsActive: true},
+			)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var resp AllocateRuntimeInstanceResponse
+			require.NoError(t, val.Get(&resp))
+			require.Equal(t, env.nodeIDs[tc.expectedNode], resp.RuntimeInstance.NodeId)
+		})
+	}
+}
+
+func planRequest(
+	matching []*mrdspb.MatchingComputeCapability,
+	volumes []*mrdspb.ApplicationPersistentVolume,
+	ports []*mrdspb.ApplicationPort,
+) *mrdspb.CreateDeploymentPlanRequest {
+	return &mrdspb.CreateDeploymentPlanRequest{
+		Name:                        "test-plan",
+		Namespace:                   "test",
+		ServiceName:                 "test-service",
+		MatchingComputeCapabilities: matching,
+		Applications: []*mrdspb.Application{
+			{
+				PayloadName:       "test-payload",
+				Resources:         &mrdspb.ApplicationResources{Cores: 2, Memory: 1024},
+				PersistentVolumes: volumes,
+				Ports:             ports,
+			},
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const (
+	UpdateDomainSpreadName = "update-domain-spread"
+
+	// defaultMaxSkew is the largest allowed difference between the number of instances of a plan in any
+	// two update domains.
+	defaultMaxSkew = 1
+)
+
+// updateDomainSpread spreads the instances of a deployment plan across update domains so that taking
+// down a single update domain affects as few instances as possible.
+//
+// As a filter, it enforces a max-skew constraint: placing the instance on a node must not make the count of
+// instances in the node's update domain exceed the count of the least populated eligible domain by more than
+// maxSkew. The eligible domains are those of the nodes which passed the preceding filters.
+// As a score, it prefers the update domains with the fewest instances of the plan.
+type updateDomainSpread struct {
+	metaInstances mrdspb.MetaInstancesClient
+	nodes         mrdspb.NodesClient
+	maxSkew       int
+}
+
+// updateDomainCounts is the cycle state of the updateDomainSpread plugin.
+type updateDomainCounts struct {
+	countByDomain map[string]int // update domain -> number of instances of the plan.
+	minCount      int            // Count of the least populated eligible update domain.
+	maxCount      int            // Count of the most populated eligible update domain.
+}
+
+func (p *updateDomainSpread) Name() string { return UpdateDomainSpreadName }
+
+// PreFilter counts the instances of the plan in each update domain. The instance being scheduled is not
+// counted since it is the one being placed.
+func (p *updateDomainSpread) PreFilter(ctx context.Context, state *CycleState) error {
+	counts := &updateDomainCounts{countByDomain: make(map[string]int)}
+	state.Write(UpdateDomainSpreadName, counts)
+
+	listResp, err := p.metaInstances.List(ctx, &mrdspb.ListMetaInstanceRequest{
+		DeploymentPlanIdIn: []string{state.DeploymentPlan.Metadata.Id},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list meta instances: %w", err)
+	}
+
+	nodeIDByMetaInstance := make(map[string]string)
+	nodeIDs := make([]string, 0)
+	for _, metaInstance := range listResp.Records {
+		if metaInstance.Metadata.Id == state.MetaInstance.Metadata.Id {
+			continue
+		}
+		nodeID := placedNodeID(metaInstance)
+		if nodeID == "" {
+			continue
+		}
+		nodeIDByMetaInstance[metaInstance.Metadata.Id] = nodeID
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	nodeListResp, err := p.nodes.List(ctx, &mrdspb.ListNodeRequest{IdIn: nodeIDs})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	domainByNodeID := make(map[string]string)
+	for _, node := range nodeListResp.Records {
+		domainByNodeID[node.Metadata.Id] = node.UpdateDomain
+	}
+	for _, nodeID := range nodeIDByMetaInstance {
+		domain, ok := domainByNodeID[nodeID]
+		if !ok {
+			continue
+		}
+		counts.countByDomain[domain]++
+	}
+	return nil
+}
+
+// PrepareFilter computes the least and most populated update domains among the eligible nodes.
+func (p *updateDomainSpread) PrepareFilter(_ context.Context, state *CycleState, nodes []*mrdspb.Node) error {
+	counts := readUpdateDomainCounts(state)
+	first := true
+	for _, node := range nodes {
+		count := counts.countByDomain[node.UpdateDomain]
+		if first || count < counts.minCount {
+			counts.minCount = count
+		}
+		if first || count > counts.maxCount {
+			counts.maxCount = count
+		}
+		first = false
+	}
+	return nil
+}
+
+func (p *updateDomainSpread) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	counts := readUpdateDomainCounts(state)
+	skew := counts.countByDomain[node.UpdateDomain] + 1 - counts.minCount
+	if skew > p.maxSkew {
+		return false, fmt.Sprintf("placing in update domain %q results in a skew of %d, max allowed is %d", node.UpdateDomain, skew, p.maxSkew)
+	}
+	return true, ""
+}
+
+func (p *updateDomainSpread) Score(_ context.Context, state *CycleState, node *mrdspb.Node) int64 {
+	counts := readUpdateDomainCounts(state)
+	if counts.maxCount == 0 {
+		return MaxNodeScore
+	}
+	return int64(counts.maxCount-counts.countByDomain[node.UpdateDomain]) * MaxNodeScore / int64(counts.maxCount)
+}
+
+func readUpdateDomainCounts(state *CycleState) *updateDomainCounts {
+	value, ok := state.Read(UpdateDomainSpreadName)
+	if !ok {
+		return &updateDomainCounts{}
+	}
+	return value.(*updateDomainCounts)
+}
+
+// placedNodeID returns the node of the active runtime instance of the meta instance, or of any of its
+// runtime instances if none is active.
+func placedNodeID(metaInstance *mrdspb.MetaInstance) string {
+	nodeID := ""
+	for _, runtimeInstance := range metaInstance.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			return runtimeInstance.NodeId
+		}
+		if nodeID == "" {
+			nodeID = runtimeInstance.NodeId
+		}
+	}
+	return nodeID
+}
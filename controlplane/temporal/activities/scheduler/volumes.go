@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+const VolumesFilterName = "volumes"
+
+// volumesFilter excludes the nodes which do not have a local volume for every persistent volume of the plan.
+// Each persistent volume needs a local volume of the same storage class with enough remaining capacity. A local
+// volume can back several persistent volumes as long as it has capacity left.
+type volumesFilter struct{}
+
+func (p *volumesFilter) Name() string { return VolumesFilterName }
+
+func (p *volumesFilter) Filter(_ context.Context, state *CycleState, node *mrdspb.Node) (bool, string) {
+	requested := make([]*mrdspb.ApplicationPersistentVolume, 0)
+	for _, app := range state.DeploymentPlan.Applications {
+		requested = append(requested, app.PersistentVolumes...)
+	}
+	if len(requested) == 0 {
+		return true, ""
+	}
+
+	// Place the largest volumes first, each on the local volume with the least remaining capacity which can
+	// hold it. This mirrors the allocation done by the ledger when the runtime instance is added.
+	sort.SliceStable(requested, func(i, j int) bool {
+		return requested[i].Capacity > requested[j].Capacity
+	})
+	remaining := make([]uint32, len(node.LocalVolumes))
+	for i, lv := range node.LocalVolumes {
+		remaining[i] = lv.RemainingCapacity
+	}
+	for _, pv := range requested {
+		best := -1
+		for i, lv := range node.LocalVolumes {
+			if lv.StorageClass != pv.StorageClass || remaining[i] < pv.Capacity {
+				continue
+			}
+			if best == -1 || remaining[i] < remaining[best] {
+				best = i
+			}
+		}
+		if best == -1 {
+			return false, fmt.Sprintf("no local volume of storage class %s with remaining capacity %d for %s", pv.StorageClass, pv.Capacity, pv.MountPath)
+		}
+		remaining[best] -= pv.Capacity
+	}
+	return true, ""
+}
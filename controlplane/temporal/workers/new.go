@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"time"
 
 	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
 	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
@@ -23,32 +24,47 @@ func NewWorker(
 	mrdsConn *grpc.ClientConn,
 	client client.Client,
 	runtimeActivities runtime.RuntimeActivities,
+	schedulerProfile string,
+	approvalTimeout time.Duration,
 ) error {
 	w := worker.New(client, DeploymentTaskQueue, worker.Options{})
 
 	// Initialize and Register all the activities
 	deploymentPlanActivities := mrds.NewDeploymentPlanActivities(mrdspb.NewDeploymentPlansClient(mrdsConn), w)
 	metaInstanceActivities := mrds.NewMetaInstanceActivities(mrdspb.NewMetaInstancesClient(mrdsConn), w)
+	nodeActivities := mrds.NewNodeActivities(mrdspb.NewNodesClient(mrdsConn), w)
 	schedulerActivities := scheduler.NewSchedulerActivities(
 		mrdspb.NewMetaInstancesClient(mrdsConn),
 		mrdspb.NewNodesClient(mrdsConn),
 		mrdspb.NewDeploymentPlansClient(mrdsConn),
+		mrdspb.NewComputeCapabilitiesClient(mrdsConn),
+		schedulerProfile,
 		w,
 	)
+	// Register the runtime activities
+	runtimeActivities.Register(w)
+
 	// Initialize and Register all the workflows
 	_ = workflows.NewDeploymentWorkflow(
 		deploymentPlanActivities,
 		metaInstanceActivities,
+		nodeActivities,
+		runtimeActivities,
 		w,
 	)
 
-	// Register the runtime activities
-	runtimeActivities.Register(w)
-
 	_ = workflows.NewOperationsWorkflow(
 		metaInstanceActivities,
 		schedulerActivities,
 		runtimeActivities,
+		approvalTimeout,
+		w,
+	)
+
+	_ = workflows.NewDisruptionWorkflow(
+		metaInstanceActivities,
+		nodeActivities,
+		runtimeActivities,
 		w,
 	)
 
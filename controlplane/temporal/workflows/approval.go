@@ -0,0 +1,10 @@
+package workflows
+
+import "time"
+
+// DefaultApprovalTimeout is how long an operation waits for approval when no timeout is configured.
+const DefaultApprovalTimeout = 24 * time.Hour
+
+// approvalPollInterval is how often the ledger is checked while an operation waits for approval, so that an
+// operation whose approval signal was lost runs without waiting for the approval timeout.
+const approvalPollInterval = time.Minute
@@ -0,0 +1,223 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// blueGreenReadyTimeout is how long a blue-green deployment waits for its passive instances to be ready.
+	blueGreenReadyTimeout = 30 * time.Minute
+	// blueGreenReadyPollInterval is how often the passive instances are checked while waiting.
+	blueGreenReadyPollInterval = 10 * time.Second
+)
+
+// runBlueGreen updates the instances by starting a passive runtime instance with the new deployment for each
+// of them. Once all the passive instances are ready they are made active in a single switch, and the
+// previous instances are torn down after the hold time. When the passive instances do not come up, they are
+// removed and the deployment is FAILED. It returns whether the switch happened.
+func (d *DeploymentWorkflow) runBlueGreen(
+	ctx workflow.Context,
+	params RunDeploymentWorkflowParams,
+	strategy *mrdspb.RolloutStrategy,
+	updates []pendingOperation,
+	previousDeploymentIDs map[string]string,
+) (bool, error) {
+	log := workflow.GetLogger(ctx)
+
+	// 1. Bring up the passive instances.
+	log.Info("Starting passive instances", "Instances", len(updates))
+	for i := range updates {
+		updates[i].passiveUpdate = true
+	}
+	err := d.runOperations(ctx, updates)
+	if err != nil {
+		reason := fmt.Sprintf("failed to start passive instances: %v", err)
+		return false, d.abortBlueGreen(ctx, params, updates, previousDeploymentIDs, reason)
+	}
+
+	// 2. Wait for all of them to be ready.
+	passiveRuntimeInstanceIDs, reason, err := d.waitForPassiveInstances(ctx, updates)
+	if err != nil {
+		return false, err
+	}
+	if reason != "" {
+		return false, d.abortBlueGreen(ctx, params, updates, previousDeploymentIDs, reason)
+	}
+
+	// 3. Make all the passive instances active at once.
+	log.Info("Switching to the passive instances", "Instances", len(passiveRuntimeInstanceIDs))
+	var switchResponse mrds.SwitchActiveRuntimeInstancesResponse
+	err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.SwitchActiveRuntimeInstances, &mrds.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstanceIDs: passiveRuntimeInstanceIDs,
+	}).Get(ctx, &switchResponse)
+	if err != nil {
+		return false, err
+	}
+
+	// 4. Keep the previous instances around, so that they can be switched back to during the hold time.
+	if strategy.GetHoldSeconds() > 0 {
+		log.Info("Holding the previous instances", "Seconds", strategy.GetHoldSeconds())
+		err := workflow.Sleep(ctx, time.Duration(strategy.GetHoldSeconds())*time.Second)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// 5. Tear down the previous instances, which are now passive, and complete the operations.
+	for _, op := range updates {
+		err := d.removePassiveInstances(ctx, op.instance.Metadata.Id)
+		if err != nil {
+			return false, err
+		}
+
+		var updateOperationStatusResponse mrds.UdpateOperationStatusResponse
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			OperationID:    op.operation.Id,
+			State:          mrdspb.OperationState_OperationState_SUCCEEDED,
+			Message:        "",
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// waitForPassiveInstances waits until the passive runtime instance of every updated instance is ready. It
+// returns the passive runtime instances by meta instance, or the reason they did not come up in time.
+func (d *DeploymentWorkflow) waitForPassiveInstances(ctx workflow.Context, updates []pendingOperation) (map[string]string, string, error) {
+	deadline := workflow.Now(ctx).Add(blueGreenReadyTimeout)
+	for {
+		passiveRuntimeInstanceIDs := make(map[string]string)
+		var notReady int
+		for _, op := range updates {
+			var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+				Id: op.instance.Metadata.Id,
+			}).Get(ctx, &getMetaInstanceResponse)
+			if err != nil {
+				return nil, "", err
+			}
+
+			ready := false
+			for _, runtimeInstance := range getMetaInstanceResponse.Record.RuntimeInstances {
+				if runtimeInstance.IsActive {
+					continue
+				}
+				passiveRuntimeInstanceIDs[op.instance.Metadata.Id] = runtimeInstance.Id
+				ready = isReady(runtimeInstance)
+			}
+			if !ready {
+				notReady++
+			}
+		}
+		if notReady == 0 {
+			return passiveRuntimeInstanceIDs, "", nil
+		}
+		if !workflow.Now(ctx).Before(deadline) {
+			return nil, fmt.Sprintf("%d of %d passive instances are not ready after %s", notReady, len(updates), blueGreenReadyTimeout), nil
+		}
+
+		workflow.GetLogger(ctx).Info("Waiting for passive instances", "NotReady", notReady)
+		err := workflow.Sleep(ctx, blueGreenReadyPollInterval)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+}
+
+// abortBlueGreen removes the passive instances before the switch and returns the instances to the deployment
+// they run. The deployment is FAILED.
+func (d *DeploymentWorkflow) abortBlueGreen(
+	ctx workflow.Context,
+	params RunDeploymentWorkflowParams,
+	updates []pendingOperation,
+	previousDeploymentIDs map[string]string,
+	reason string,
+) error {
+	log := workflow.GetLogger(ctx)
+	log.Info("Aborting blue-green deployment", "Reason", reason)
+
+	for _, op := range updates {
+		err := d.removePassiveInstances(ctx, op.instance.Metadata.Id)
+		if err != nil {
+			return err
+		}
+
+		var updateOperationStatusResponse mrds.UdpateOperationStatusResponse
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			OperationID:    op.operation.Id,
+			State:          mrdspb.OperationState_OperationState_FAILED,
+			Message:        fmt.Sprintf("Blue-green deployment %s aborted", params.Deployment.Id),
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return err
+		}
+
+		if previousDeploymentID := previousDeploymentIDs[op.instance.Metadata.Id]; previousDeploymentID != "" {
+			var updateDeploymentIDResponse mrds.UpdateDeploymentIDResponse
+			err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateDeploymentID, &mrds.UpdateDeploymentIDRequest{
+				MetaInstanceID: op.instance.Metadata.Id,
+				DeploymentID:   previousDeploymentID,
+			}).Get(ctx, &updateDeploymentIDResponse)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var updateDeploymentPlanResponse mrds.UpdateDeploymentStatusResponse
+	return workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentStatus, mrds.UpdateDeploymentStatusRequest{
+		DeploymentPlanID: params.DeploymentPlan.Metadata.Id,
+		DeploymentID:     params.Deployment.Id,
+		Status: &mrdspb.DeploymentStatus{
+			State:   mrdspb.DeploymentState_DeploymentState_FAILED,
+			Message: fmt.Sprintf("Blue-green switch aborted: %s", reason),
+		},
+	}).Get(ctx, &updateDeploymentPlanResponse)
+}
+
+// removePassiveInstances stops and removes the passive runtime instances of the meta instance.
+func (d *DeploymentWorkflow) removePassiveInstances(ctx workflow.Context, metaInstanceID string) error {
+	var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+	err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+		Id: metaInstanceID,
+	}).Get(ctx, &getMetaInstanceResponse)
+	if err != nil {
+		return err
+	}
+
+	for _, runtimeInstance := range getMetaInstanceResponse.Record.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			continue
+		}
+		var runtimeActivityResponse runtime.RuntimeActivityResponse
+		err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StopInstance, runtime.RuntimeActivityRequest{
+			MetaInstanceID:    metaInstanceID,
+			RuntimeInstanceID: runtimeInstance.Id,
+			Remove:            true,
+		}).Get(ctx, &runtimeActivityResponse)
+		if err != nil {
+			return err
+		}
+
+		var removeRuntimeInstanceResponse mrds.RemoveRuntimeInstanceResponse
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.RemoveRuntimeInstance, &mrds.RemoveRuntimeInstanceRequest{
+			MetaInstanceID:    metaInstanceID,
+			RuntimeInstanceID: runtimeInstance.Id,
+		}).Get(ctx, &removeRuntimeInstanceResponse)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
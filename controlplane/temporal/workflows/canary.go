@@ -0,0 +1,205 @@
+package workflows
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// canaryEnabled returns true if the deployment runs a canary phase.
+func canaryEnabled(canary *mrdspb.Canary) bool {
+	return canary.GetInstanceCount() > 0 || canary.GetPercentage() > 0
+}
+
+// selectCanaries picks the UPDATE operations whose instances form the canary. Only instances whose previous
+// deployment is known can be rolled back, so the others are never picked. It returns the canaries and the
+// remaining operations.
+func selectCanaries(operations []pendingOperation, canary *mrdspb.Canary, previousDeploymentIDs map[string]string) ([]pendingOperation, []pendingOperation) {
+	var candidates int
+	for _, op := range operations {
+		if isCanaryCandidate(op, previousDeploymentIDs) {
+			candidates++
+		}
+	}
+
+	count := int(canary.GetInstanceCount())
+	if count == 0 {
+		count = (candidates*int(canary.GetPercentage()) + 99) / 100
+	}
+	count = min(count, candidates)
+
+	var canaries, remaining []pendingOperation
+	for _, op := range operations {
+		if len(canaries) < count && isCanaryCandidate(op, previousDeploymentIDs) {
+			canaries = append(canaries, op)
+		} else {
+			remaining = append(remaining, op)
+		}
+	}
+	return canaries, remaining
+}
+
+func isCanaryCandidate(op pendingOperation, previousDeploymentIDs map[string]string) bool {
+	return op.operation.Type == mrdspb.OperationType_OperationType_UPDATE && previousDeploymentIDs[op.instance.Metadata.Id] != ""
+}
+
+// runCanary updates the canaries and analyzes them after the bake time. Canaries which are not ready after
+// the bake time are unhealthy. It returns the reason the canary failed, or an empty string when the canaries
+// are healthy.
+func (d *DeploymentWorkflow) runCanary(ctx workflow.Context, canary *mrdspb.Canary, canaries []pendingOperation) (string, error) {
+	log := workflow.GetLogger(ctx)
+
+	log.Info("Running canary", "Instances", len(canaries))
+	err := d.executeOperations(ctx, canaries)
+	if err != nil {
+		return fmt.Sprintf("canary operation failed: %v", err), nil
+	}
+
+	if canary.GetBakeSeconds() > 0 {
+		log.Info("Baking canary", "Seconds", canary.GetBakeSeconds())
+		err := workflow.Sleep(ctx, time.Duration(canary.GetBakeSeconds())*time.Second)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var unhealthy []string
+	for _, op := range canaries {
+		var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+			Id: op.instance.Metadata.Id,
+		}).Get(ctx, &getMetaInstanceResponse)
+		if err != nil {
+			return "", err
+		}
+		if !isActiveReady(getMetaInstanceResponse.Record) {
+			unhealthy = append(unhealthy, getMetaInstanceResponse.Record.Name)
+		}
+	}
+	if len(unhealthy) > int(canary.GetMaxUnhealthyInstances()) {
+		return fmt.Sprintf("%d of %d canaries are not ready: %s", len(unhealthy), len(canaries), strings.Join(unhealthy, ", ")), nil
+	}
+	log.Info("Canary succeeded", "UnhealthyInstances", len(unhealthy))
+	return "", nil
+}
+
+// rollbackCanary returns the instances of the deployment to the deployment they ran before. The canaries are
+// updated back to their previous deployment and the operations which have not run are cancelled. Instances
+// created by the deployment are removed, while instances marked for deletion can not be restored and are
+// still deleted. The deployment is FAILED once the rollback completes.
+func (d *DeploymentWorkflow) rollbackCanary(
+	ctx workflow.Context,
+	params RunDeploymentWorkflowParams,
+	canaries []pendingOperation,
+	remaining []pendingOperation,
+	previousDeploymentIDs map[string]string,
+	reason string,
+) error {
+	log := workflow.GetLogger(ctx)
+	log.Info("Canary failed. Rolling back deployment", "Reason", reason)
+
+	var deletions []pendingOperation
+	for _, op := range remaining {
+		if op.operation.Type == mrdspb.OperationType_OperationType_DELETE {
+			deletions = append(deletions, op)
+			continue
+		}
+
+		var updateOperationStatusResponse mrds.UdpateOperationStatusResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			OperationID:    op.operation.Id,
+			State:          mrdspb.OperationState_OperationState_FAILED,
+			Message:        fmt.Sprintf("Cancelled by the rollback of deployment %s", params.Deployment.Id),
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return err
+		}
+
+		previousDeploymentID := previousDeploymentIDs[op.instance.Metadata.Id]
+		if previousDeploymentID == "" {
+			var deleteMetaInstanceResponse mrds.DeleteMetaInstanceResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.DeleteMetaInstance, &mrds.DeleteMetaInstanceRequest{
+				MetaInstanceID: op.instance.Metadata.Id,
+			}).Get(ctx, &deleteMetaInstanceResponse)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		var updateDeploymentIDResponse mrds.UpdateDeploymentIDResponse
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateDeploymentID, &mrds.UpdateDeploymentIDRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			DeploymentID:   previousDeploymentID,
+		}).Get(ctx, &updateDeploymentIDResponse)
+		if err != nil {
+			return err
+		}
+	}
+
+	rollbacks := deletions
+	previousDeployments := make(map[string]bool)
+	for _, op := range canaries {
+		previousDeploymentID := previousDeploymentIDs[op.instance.Metadata.Id]
+		previousDeployments[previousDeploymentID] = true
+
+		var updateDeploymentIDResponse mrds.UpdateDeploymentIDResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateDeploymentID, &mrds.UpdateDeploymentIDRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			DeploymentID:   previousDeploymentID,
+		}).Get(ctx, &updateDeploymentIDResponse)
+		if err != nil {
+			return err
+		}
+
+		// The operation ID is derived from the canary so that it is stable across workflow replays.
+		operation := &mrdspb.Operation{
+			Id:       fmt.Sprintf("UPDATE-ROLLBACK-%s-%s", params.Deployment.Id, op.instance.Metadata.Id),
+			Type:     mrdspb.OperationType_OperationType_UPDATE,
+			IntentId: previousDeploymentID,
+			Status: &mrdspb.OperationStatus{
+				State:   mrdspb.OperationState_OperationState_PREPARING,
+				Message: fmt.Sprintf("Instance rollback of deployment %s requested", params.Deployment.Id),
+			},
+		}
+		var addOperationResponse mrds.AddOperationResponse
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.AddOperation, &mrds.AddOperationRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			Operation:      operation,
+		}).Get(ctx, &addOperationResponse)
+		if err != nil {
+			return err
+		}
+		rollbacks = append(rollbacks, pendingOperation{
+			instance:  addOperationResponse.MetaInstance,
+			operation: operation,
+		})
+	}
+
+	err := d.runOperations(ctx, rollbacks)
+	if err != nil {
+		return err
+	}
+
+	var rolledBackTo []string
+	for deploymentID := range previousDeployments {
+		rolledBackTo = append(rolledBackTo, deploymentID)
+	}
+	sort.Strings(rolledBackTo)
+
+	var updateDeploymentPlanResponse mrds.UpdateDeploymentStatusResponse
+	return workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentStatus, mrds.UpdateDeploymentStatusRequest{
+		DeploymentPlanID: params.DeploymentPlan.Metadata.Id,
+		DeploymentID:     params.Deployment.Id,
+		Status: &mrdspb.DeploymentStatus{
+			State:   mrdspb.DeploymentState_DeploymentState_FAILED,
+			Message: fmt.Sprintf("Canary failed, rolled back to deployment %s: %s", strings.Join(rolledBackTo, ", "), reason),
+		},
+	}).Get(ctx, &updateDeploymentPlanResponse)
+}
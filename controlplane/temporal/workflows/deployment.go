@@ -3,14 +3,18 @@ package workflows
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
 
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 )
@@ -18,18 +22,24 @@ import (
 type DeploymentWorkflow struct {
 	deploymentPlanActivities *mrds.DeploymentPlanActivities
 	metaInstanceActivities   *mrds.MetaInstanceActivities
+	nodeActivities           *mrds.NodeActivities
+	runtimeActivities        runtime.RuntimeActivities
 }
 
 // DeploymentWorkflow is a Temporal workflow that deploys a new cluster.
 func NewDeploymentWorkflow(
 	deploymentPlan *mrds.DeploymentPlanActivities,
 	metaInstance *mrds.MetaInstanceActivities,
+	node *mrds.NodeActivities,
+	runtimeActivities runtime.RuntimeActivities,
 	registry worker.Registry,
 ) *DeploymentWorkflow {
 
 	d := &DeploymentWorkflow{
 		deploymentPlanActivities: deploymentPlan,
 		metaInstanceActivities:   metaInstance,
+		nodeActivities:           node,
+		runtimeActivities:        runtimeActivities,
 	}
 
 	registry.RegisterWorkflow(d.RunDeployment)
@@ -44,6 +54,8 @@ type RunDeploymentWorkflowParams struct {
 	ChildWorkflowParams []RunOperationWorkflowParams
 }
 
+// RunDeployment rolls out the deployment. A deployment whose rollout fails is marked FAILED, so that it is
+// not left IN_PROGRESS without a workflow.
 func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeploymentWorkflowParams) error {
 	ao := workflow.ActivityOptions{
 		ScheduleToCloseTimeout: 2 * time.Hour,
@@ -51,23 +63,47 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
-	// 1. Set the deployment state to InProgress
-	var updateDeploymentPlanResponse mrds.UpdateDeploymentStatusResponse
-	err := workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentStatus, mrds.UpdateDeploymentStatusRequest{
+	err := d.runDeployment(ctx, params)
+	if err == nil || temporal.IsCanceledError(err) {
+		return err
+	}
+	var updateDeploymentStatusResponse mrds.UpdateDeploymentStatusResponse
+	updateErr := workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentStatus, &mrds.UpdateDeploymentStatusRequest{
 		DeploymentPlanID: params.DeploymentPlan.Metadata.Id,
 		DeploymentID:     params.Deployment.Id,
 		Status: &mrdspb.DeploymentStatus{
-			State:   mrdspb.DeploymentState_DeploymentState_IN_PROGRESS,
-			Message: "Deployment is running",
+			State:   mrdspb.DeploymentState_DeploymentState_FAILED,
+			Message: fmt.Sprintf("Deployment failed: %v", err),
 		},
-	}).Get(ctx, &updateDeploymentPlanResponse)
-	if err != nil {
-		return err
+	}).Get(ctx, &updateDeploymentStatusResponse)
+	if updateErr != nil {
+		workflow.GetLogger(ctx).Error("Failed to mark the deployment as failed", "error", updateErr)
+	}
+	return err
+}
+
+func (d *DeploymentWorkflow) runDeployment(ctx workflow.Context, params RunDeploymentWorkflowParams) error {
+	// 1. Set the deployment state to InProgress. A deployment which is already in progress or paused is being
+	// resumed.
+	if params.Deployment.Status.State != mrdspb.DeploymentState_DeploymentState_IN_PROGRESS &&
+		params.Deployment.Status.State != mrdspb.DeploymentState_DeploymentState_PAUSED {
+		var updateDeploymentPlanResponse mrds.UpdateDeploymentStatusResponse
+		err := workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentStatus, mrds.UpdateDeploymentStatusRequest{
+			DeploymentPlanID: params.DeploymentPlan.Metadata.Id,
+			DeploymentID:     params.Deployment.Id,
+			Status: &mrdspb.DeploymentStatus{
+				State:   mrdspb.DeploymentState_DeploymentState_IN_PROGRESS,
+				Message: "Deployment is running",
+			},
+		}).Get(ctx, &updateDeploymentPlanResponse)
+		if err != nil {
+			return err
+		}
 	}
 
 	// 2. Get a list of instances that are tagged to the deployment
 	var listMetaInstancesResponse mrdspb.ListMetaInstanceResponse
-	err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.ListMetaInstance, &mrdspb.ListMetaInstanceRequest{
+	err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.ListMetaInstance, &mrdspb.ListMetaInstanceRequest{
 		DeploymentPlanIdIn: []string{params.DeploymentPlan.Metadata.Id},
 	}).Get(ctx, &listMetaInstancesResponse)
 	if err != nil {
@@ -128,8 +164,11 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 	}
 	metaInstances = listMetaInstancesResponse.Records
 
+	// The deployment each instance ran before, used to roll back a failed canary.
+	previousDeploymentIDs := make(map[string]string)
 	for _, instance := range metaInstances {
 		if instance.DeploymentId != params.Deployment.Id {
+			previousDeploymentIDs[instance.Metadata.Id] = instance.DeploymentId
 			var updateDeploymentIDResponse mrds.UpdateDeploymentIDResponse
 			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateDeploymentID, &mrds.UpdateDeploymentIDRequest{
 				MetaInstanceID: instance.Metadata.Id,
@@ -148,6 +187,13 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 				break
 			}
 		}
+		// A resumed deployment has already added the operations of some instances.
+		for _, operation := range instance.Operations {
+			if operation.IntentId == params.Deployment.Id {
+				alreadyDone = true
+				break
+			}
+		}
 		if alreadyDone {
 			continue
 		}
@@ -235,7 +281,7 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 	}
 	metaInstances = listMetaInstancesResponse.Records
 
-	var operationFutures []workflow.Future
+	var pendingOperations []pendingOperation
 	for _, instance := range metaInstances {
 		for _, operation := range instance.Operations {
 			if operation.Type != mrdspb.OperationType_OperationType_CREATE &&
@@ -246,41 +292,131 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 			if operation.Status.State != mrdspb.OperationState_OperationState_PREPARING {
 				continue
 			}
-			cwo := workflow.ChildWorkflowOptions{
-				WorkflowID:            fmt.Sprintf("%s-%s", instance.Name, operation.Id),
-				WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			// An instance whose resources or applications changed with the spec of the plan needs a new runtime
+			// instance.
+			reallocate := operation.Type == mrdspb.OperationType_OperationType_UPDATE &&
+				(resourcesChanged(params.DeploymentPlan, instance) ||
+					applicationsChanged(params.DeploymentPlan, previousDeploymentIDs[instance.Metadata.Id]))
+			pendingOperations = append(pendingOperations, pendingOperation{
+				instance:   instance,
+				operation:  operation,
+				reallocate: reallocate,
+			})
+		}
+	}
+
+	// A paused deployment does not start its rollout, and an aborted one cleans up the operations it added.
+	aborted, err := d.waitWhilePaused(ctx, params)
+	if err != nil {
+		return err
+	}
+	if aborted {
+		return d.abortDeployment(ctx, params, pendingOperations, previousDeploymentIDs)
+	}
+
+	// Update the canaries first and roll the deployment back when they are not healthy. A resumed deployment
+	// does not know the previous deployment of its instances, so the canary phase is not repeated.
+	if canaryEnabled(params.Deployment.GetCanary()) {
+		canaries, remaining := selectCanaries(pendingOperations, params.Deployment.GetCanary(), previousDeploymentIDs)
+		if len(canaries) > 0 {
+			reason, err := d.runCanary(ctx, params.Deployment.GetCanary(), canaries)
+			if err != nil {
+				return err
+			}
+			if reason != "" {
+				return d.rollbackCanary(ctx, params, canaries, remaining, previousDeploymentIDs, reason)
 			}
-			ctx = workflow.WithChildOptions(ctx, cwo)
-			operationFutures = append(operationFutures, workflow.ExecuteChildWorkflow(
-				ctx,
-				OperationsWorkflowName,
-				RunOperationWorkflowParams{
-					OperationID:    operation.Id,
-					OperationType:  operation.Type,
-					MetaInstanceID: instance.Metadata.Id,
-				},
-			))
+			pendingOperations = remaining
 		}
 	}
 
-	// Wait for all operations to complete
-	for _, f := range operationFutures {
-		var runOperationWorkflowResponse RunOperationWorkflowResponse
-		err := f.Get(ctx, &runOperationWorkflowResponse)
+	// A blue-green deployment switches all the updated instances at once, before the other operations run.
+	strategy := params.Deployment.GetRolloutStrategy()
+	if strategy.GetType() == mrdspb.RolloutStrategyType_RolloutStrategyType_BLUE_GREEN {
+		var updates, others []pendingOperation
+		for _, op := range pendingOperations {
+			if op.operation.Type == mrdspb.OperationType_OperationType_UPDATE {
+				updates = append(updates, op)
+			} else {
+				others = append(others, op)
+			}
+		}
+		if len(updates) > 0 {
+			switched, err := d.runBlueGreen(ctx, params, strategy, updates, previousDeploymentIDs)
+			if err != nil {
+				return err
+			}
+			if !switched {
+				return nil
+			}
+		}
+		pendingOperations = others
+	}
+
+	// Roll out one update domain at a time so that an upgrade never takes down more than one domain.
+	batches, err := d.batchByUpdateDomain(ctx, pendingOperations)
+	if err != nil {
+		return err
+	}
+	if strategy.GetType() == mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING {
+		batches, err = rollingBatches(batches, strategy)
 		if err != nil {
 			return err
 		}
-		workflow.GetLogger(ctx).Info("Operation completed", "MetaInstance", runOperationWorkflowResponse.MetaInstance)
+	}
 
-		if runOperationWorkflowResponse.MetaInstance.Status.State == mrdspb.MetaInstanceState_MetaInstanceState_MARKED_FOR_DELETION {
-			var deleteMetaInstanceResponse mrds.DeleteMetaInstanceResponse
-			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.DeleteMetaInstance, &mrds.DeleteMetaInstanceRequest{
-				MetaInstanceID: runOperationWorkflowResponse.MetaInstance.Metadata.Id,
-			}).Get(ctx, &deleteMetaInstanceResponse)
+	// Operations which completed before a restart are no longer PREPARING, so a resumed deployment only
+	// batches the remaining operations and continues counting from the recorded progress.
+	progress := &mrdspb.RolloutProgress{
+		CompletedBatches: params.Deployment.GetRolloutProgress().GetCompletedBatches(),
+	}
+	progress.TotalBatches = progress.CompletedBatches + uint32(len(batches))
+	err = d.updateProgress(ctx, params, progress)
+	if err != nil {
+		return err
+	}
+	for i, batch := range batches {
+		aborted, err := d.waitWhilePaused(ctx, params)
+		if err != nil {
+			return err
+		}
+		if aborted {
+			var remaining []pendingOperation
+			for _, b := range batches[i:] {
+				remaining = append(remaining, b.operations...)
+			}
+			return d.abortDeployment(ctx, params, remaining, previousDeploymentIDs)
+		}
+
+		if i > 0 && strategy.GetPauseSeconds() > 0 {
+			workflow.GetLogger(ctx).Info("Pausing between batches", "Seconds", strategy.GetPauseSeconds())
+			err := workflow.Sleep(ctx, time.Duration(strategy.GetPauseSeconds())*time.Second)
 			if err != nil {
 				return err
 			}
 		}
+
+		workflow.GetLogger(ctx).Info("Rolling out batch", "Batch", progress.CompletedBatches+1, "TotalBatches", progress.TotalBatches,
+			"UpdateDomain", batch.updateDomain, "Operations", len(batch.operations))
+		err = d.runOperations(ctx, batch.operations)
+		if err != nil {
+			return err
+		}
+
+		progress.CompletedBatches++
+		err = d.updateProgress(ctx, params, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A deployment paused during its last batch is only completed once it is resumed.
+	aborted, err = d.waitWhilePaused(ctx, params)
+	if err != nil {
+		return err
+	}
+	if aborted {
+		return nil
 	}
 
 	// Mark the deployment as completed
@@ -300,6 +436,245 @@ func (d *DeploymentWorkflow) RunDeployment(ctx workflow.Context, params RunDeplo
 	return nil
 }
 
+type pendingOperation struct {
+	instance      *mrdspb.MetaInstance
+	operation     *mrdspb.Operation
+	passiveUpdate bool // Runs an UPDATE on a passive runtime instance. See RunOperationWorkflowParams.
+	reallocate    bool // Runs an UPDATE on a new runtime instance. See RunOperationWorkflowParams.
+}
+
+type updateDomainBatch struct {
+	updateDomain string
+	operations   []pendingOperation
+}
+
+// batchByUpdateDomain groups the operations by the update domain of the node on which the instance is running.
+// Instances which are not running anywhere yet are not disrupted by their operations, so they are grouped
+// into the first batch. The remaining batches are ordered by update domain name.
+func (d *DeploymentWorkflow) batchByUpdateDomain(ctx workflow.Context, operations []pendingOperation) ([]updateDomainBatch, error) {
+	nodeIDs := make([]string, 0)
+	for _, op := range operations {
+		if nodeID := activeNodeID(op.instance); nodeID != "" {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+
+	domainByNodeID := make(map[string]string)
+	if len(nodeIDs) > 0 {
+		var listNodeResponse mrdspb.ListNodeResponse
+		err := workflow.ExecuteActivity(ctx, d.nodeActivities.ListNode, &mrdspb.ListNodeRequest{
+			IdIn: nodeIDs,
+		}).Get(ctx, &listNodeResponse)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range listNodeResponse.Records {
+			domainByNodeID[node.Metadata.Id] = node.UpdateDomain
+		}
+	}
+
+	var unplaced []pendingOperation
+	byDomain := make(map[string][]pendingOperation)
+	for _, op := range operations {
+		nodeID := activeNodeID(op.instance)
+		if nodeID == "" {
+			unplaced = append(unplaced, op)
+			continue
+		}
+		domain := domainByNodeID[nodeID]
+		byDomain[domain] = append(byDomain[domain], op)
+	}
+
+	domains := make([]string, 0, len(byDomain))
+	for domain := range byDomain {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	var batches []updateDomainBatch
+	if len(unplaced) > 0 {
+		batches = append(batches, updateDomainBatch{operations: unplaced})
+	}
+	for _, domain := range domains {
+		batches = append(batches, updateDomainBatch{updateDomain: domain, operations: byDomain[domain]})
+	}
+	return batches, nil
+}
+
+// rollingBatches splits the batch of every update domain into smaller batches which respect the limits of
+// the rolling strategy. Operations which disrupt a running instance are bounded by MaxUnavailable, operations
+// which create an instance or reallocate it are bounded by MaxSurge, and all operations are bounded by
+// BatchSize. An operation bounded by a limit of 0 cannot run, which fails the deployment.
+func rollingBatches(batches []updateDomainBatch, strategy *mrdspb.RolloutStrategy) ([]updateDomainBatch, error) {
+	maxUnavailable := strategy.GetMaxUnavailable()
+	maxSurge := strategy.GetMaxSurge()
+	batchSize := strategy.GetBatchSize()
+
+	var rolling []updateDomainBatch
+	for _, batch := range batches {
+		current := updateDomainBatch{updateDomain: batch.updateDomain}
+		var unavailable, surge uint32
+		for _, op := range batch.operations {
+			creates := op.operation.Type == mrdspb.OperationType_OperationType_CREATE || op.reallocate
+			disrupts := op.operation.Type != mrdspb.OperationType_OperationType_CREATE && activeNodeID(op.instance) != ""
+			if disrupts && maxUnavailable == 0 {
+				return nil, fmt.Errorf("operation %s of instance %s disrupts a running instance, but the rolling strategy allows no unavailable instances",
+					op.operation.Id, op.instance.Metadata.Id)
+			}
+			if creates && maxSurge == 0 {
+				return nil, fmt.Errorf("operation %s of instance %s creates a runtime instance, but the rolling strategy allows no surge",
+					op.operation.Id, op.instance.Metadata.Id)
+			}
+
+			full := (batchSize > 0 && uint32(len(current.operations)) >= batchSize) ||
+				(disrupts && unavailable >= maxUnavailable) ||
+				(creates && surge >= maxSurge)
+			if full && len(current.operations) > 0 {
+				rolling = append(rolling, current)
+				current = updateDomainBatch{updateDomain: batch.updateDomain}
+				unavailable, surge = 0, 0
+			}
+
+			current.operations = append(current.operations, op)
+			if disrupts {
+				unavailable++
+			}
+			if creates {
+				surge++
+			}
+		}
+		if len(current.operations) > 0 {
+			rolling = append(rolling, current)
+		}
+	}
+	return rolling, nil
+}
+
+// updateProgress records the progress of the rollout on the deployment.
+func (d *DeploymentWorkflow) updateProgress(ctx workflow.Context, params RunDeploymentWorkflowParams, progress *mrdspb.RolloutProgress) error {
+	var updateDeploymentProgressResponse mrds.UpdateDeploymentProgressResponse
+	return workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.UpdateDeploymentProgress, &mrds.UpdateDeploymentProgressRequest{
+		DeploymentPlanID: params.DeploymentPlan.Metadata.Id,
+		DeploymentID:     params.Deployment.Id,
+		Progress:         progress,
+	}).Get(ctx, &updateDeploymentProgressResponse)
+}
+
+// runOperations runs the operations and waits for the instances they started to be ready.
+func (d *DeploymentWorkflow) runOperations(ctx workflow.Context, operations []pendingOperation) error {
+	err := d.executeOperations(ctx, operations)
+	if err != nil {
+		return err
+	}
+	return d.waitForReady(ctx, operations)
+}
+
+// executeOperations runs the operations as child workflows and waits for all of them to complete.
+func (d *DeploymentWorkflow) executeOperations(ctx workflow.Context, operations []pendingOperation) error {
+	var operationFutures []workflow.Future
+	for _, op := range operations {
+		cwo := workflow.ChildWorkflowOptions{
+			WorkflowID:            signals.OperationWorkflowID(op.instance.Name, op.operation.Id),
+			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		}
+		childCtx := workflow.WithChildOptions(ctx, cwo)
+		operationFutures = append(operationFutures, workflow.ExecuteChildWorkflow(
+			childCtx,
+			OperationsWorkflowName,
+			RunOperationWorkflowParams{
+				OperationID:    op.operation.Id,
+				OperationType:  op.operation.Type,
+				MetaInstanceID: op.instance.Metadata.Id,
+				PassiveUpdate:  op.passiveUpdate,
+				Reallocate:     op.reallocate,
+			},
+		))
+	}
+
+	// Wait for all operations to complete, so that no operation is still running when an error is returned.
+	var operationErr error
+	for _, f := range operationFutures {
+		var runOperationWorkflowResponse RunOperationWorkflowResponse
+		err := f.Get(ctx, &runOperationWorkflowResponse)
+		if err != nil {
+			if operationErr == nil {
+				operationErr = err
+			}
+			continue
+		}
+		workflow.GetLogger(ctx).Info("Operation completed", "MetaInstance", runOperationWorkflowResponse.MetaInstance)
+
+		if runOperationWorkflowResponse.MetaInstance.Status.State == mrdspb.MetaInstanceState_MetaInstanceState_MARKED_FOR_DELETION {
+			var deleteMetaInstanceResponse mrds.DeleteMetaInstanceResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.DeleteMetaInstance, &mrds.DeleteMetaInstanceRequest{
+				MetaInstanceID: runOperationWorkflowResponse.MetaInstance.Metadata.Id,
+			}).Get(ctx, &deleteMetaInstanceResponse)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return operationErr
+}
+
+// activeNodeID returns the node of the active runtime instance of the meta instance, if any.
+func activeNodeID(instance *mrdspb.MetaInstance) string {
+	for _, runtimeInstance := range instance.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			return runtimeInstance.NodeId
+		}
+	}
+	return ""
+}
+
+// resourcesChanged reports whether the resources reserved for the active runtime instance of the meta instance
+// differ from the resources of the current spec of the deployment plan. Runtime instances which did not record
+// their resources are not reallocated.
+func resourcesChanged(deploymentPlan *mrdspb.DeploymentPlanRecord, instance *mrdspb.MetaInstance) bool {
+	var cores, memory uint32
+	for _, app := range deploymentPlan.GetApplications() {
+		cores += app.GetResources().GetCores()
+		memory += app.GetResources().GetMemory()
+	}
+	for _, runtimeInstance := range instance.RuntimeInstances {
+		if !runtimeInstance.IsActive {
+			continue
+		}
+		resources := runtimeInstance.GetResources()
+		if resources.GetCores() == 0 && resources.GetMemory() == 0 {
+			return false
+		}
+		return resources.GetCores() != cores || resources.GetMemory() != memory
+	}
+	return false
+}
+
+// applicationsChanged reports whether the payloads of the deployment an instance ran before differ from the
+// applications of the current spec of the deployment plan. The payloads of an application added or removed
+// are not held by the runtime instance of the previous deployment. Instances whose previous deployment is
+// not known are not reallocated.
+func applicationsChanged(deploymentPlan *mrdspb.DeploymentPlanRecord, previousDeploymentID string) bool {
+	for _, deployment := range deploymentPlan.GetDeployments() {
+		if deployment.Id != previousDeploymentID {
+			continue
+		}
+		payloadNames := make(map[string]bool)
+		for _, coordinates := range deployment.PayloadCoordinates {
+			payloadNames[coordinates.PayloadName] = true
+		}
+		if len(payloadNames) != len(deploymentPlan.GetApplications()) {
+			return true
+		}
+		for _, app := range deploymentPlan.GetApplications() {
+			if !payloadNames[app.PayloadName] {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
 func shortUUID() string {
 	u := uuid.New()
 	encoded := base64.URLEncoding.EncodeToString(u[:])
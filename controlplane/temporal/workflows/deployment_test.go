@@ -0,0 +1,874 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestRunDeploymentRollingStrategy(t *testing.T) {
+	testCases := []struct {
+		name            string
+		strategy        *mrdspb.RolloutStrategy
+		applications    []*mrdspb.Application // Applications of an updated spec, which reallocates the instances.
+		resumedBatches  uint32                // Resumes the deployment after this many batches of two instances completed.
+		expectBatches   []int                 // Number of operations started in each batch.
+		expectProgress  [2]uint32             // Completed and total batches at the end of the deployment.
+		expectPauseTime time.Duration
+		expectError     string // Error which fails the deployment before any batch.
+	}{
+		{
+			name:           "Update domain strategy runs the domain in one batch",
+			expectBatches:  []int{4},
+			expectProgress: [2]uint32{1, 1},
+		},
+		{
+			name: "Rolling strategy bounds the disrupted instances",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxUnavailable: 2,
+				PauseSeconds:   60,
+			},
+			expectBatches:   []int{2, 2},
+			expectProgress:  [2]uint32{2, 2},
+			expectPauseTime: time.Minute,
+		},
+		{
+			name: "Batch size bounds the batch",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxUnavailable: 4,
+				BatchSize:      3,
+			},
+			expectBatches:  []int{3, 1},
+			expectProgress: [2]uint32{2, 2},
+		},
+		{
+			name: "Resumed deployment runs the remaining batches",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxUnavailable: 2,
+			},
+			resumedBatches: 1,
+			expectBatches:  []int{2},
+			expectProgress: [2]uint32{2, 2},
+		},
+		{
+			name: "Surge bounds the reallocated instances",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxUnavailable: 4,
+				MaxSurge:       2,
+			},
+			applications: []*mrdspb.Application{
+				{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+				{PayloadName: "sidecar", Resources: &mrdspb.ApplicationResources{}},
+			},
+			expectBatches:  []int{2, 2},
+			expectProgress: [2]uint32{2, 2},
+		},
+		{
+			name: "Zero unavailable is not taken as one",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:     mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxSurge: 2,
+			},
+			expectError: "allows no unavailable instances",
+		},
+		{
+			name: "Zero surge is not taken as one",
+			strategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+				MaxUnavailable: 2,
+			},
+			applications: []*mrdspb.Application{
+				{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+				{PayloadName: "sidecar", Resources: &mrdspb.ApplicationResources{}},
+			},
+			expectError: "allows no surge",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newDeploymentFixture(t)
+			defer f.ts.Close()
+
+			coordinates := []*mrdspb.PayloadCoordinates{
+				{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+			}
+			if tc.applications != nil {
+				_, err := f.deploymentPlansClient.UpdateSpec(f.ctx, &mrdspb.UpdateDeploymentPlanSpecRequest{
+					Metadata:     f.planMetadata(t),
+					Applications: tc.applications,
+				})
+				require.NoError(t, err)
+				coordinates = nil
+				for _, app := range tc.applications {
+					coordinates = append(coordinates, &mrdspb.PayloadCoordinates{
+						PayloadName: app.PayloadName,
+						Coordinates: map[string]string{"image": "nginx:2"},
+					})
+				}
+			}
+			updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:           f.planMetadata(t),
+				DeploymentId:       "deployment-2",
+				PayloadCoordinates: coordinates,
+				InstanceCount:      4,
+				RolloutStrategy:    tc.strategy,
+			})
+			require.NoError(t, err)
+
+			if tc.expectError != "" {
+				batches, _, err := f.executeDeployment(t, updateResp.Record, "deployment-2", nil)
+				require.ErrorContains(t, err, tc.expectError)
+				require.Empty(t, batches)
+				deployment := f.getDeployment(t, "deployment-2")
+				require.Equal(t, mrdspb.DeploymentState_DeploymentState_FAILED, deployment.Status.State)
+				require.Contains(t, deployment.Status.Message, tc.expectError)
+				return
+			}
+
+			if tc.resumedBatches > 0 {
+				// Simulate a deployment whose workflow stopped after the first batches had completed.
+				updateResp, err = f.deploymentPlansClient.UpdateDeploymentStatus(f.ctx, &mrdspb.UpdateDeploymentStatusRequest{
+					Metadata:     updateResp.Record.Metadata,
+					DeploymentId: "deployment-2",
+					Status:       &mrdspb.DeploymentStatus{State: mrdspb.DeploymentState_DeploymentState_IN_PROGRESS},
+				})
+				require.NoError(t, err)
+				updateResp, err = f.deploymentPlansClient.UpdateDeploymentProgress(f.ctx, &mrdspb.UpdateDeploymentProgressRequest{
+					Metadata:     updateResp.Record.Metadata,
+					DeploymentId: "deployment-2",
+					Progress:     &mrdspb.RolloutProgress{CompletedBatches: tc.resumedBatches, TotalBatches: tc.resumedBatches + 1},
+				})
+				require.NoError(t, err)
+				for i := 0; i < int(tc.resumedBatches)*2; i++ {
+					_, err := f.metaInstancesClient.AddOperation(f.ctx, &mrdspb.AddOperationRequest{
+						Metadata: f.metaInstances[i].Metadata,
+						Operation: &mrdspb.Operation{
+							Id:       fmt.Sprintf("operation-%d", i),
+							Type:     mrdspb.OperationType_OperationType_UPDATE,
+							IntentId: "deployment-2",
+							Status:   &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_SUCCEEDED},
+						},
+					})
+					require.NoError(t, err)
+				}
+			}
+
+			batches, startTimes := f.runDeployment(t, updateResp.Record, "deployment-2", nil)
+			require.Equal(t, tc.expectBatches, batches)
+			for i := 1; i < len(startTimes); i++ {
+				require.GreaterOrEqual(t, startTimes[i].Sub(startTimes[i-1]), tc.expectPauseTime)
+			}
+
+			deployment := f.getDeployment(t, "deployment-2")
+			require.Equal(t, mrdspb.DeploymentState_DeploymentState_COMPLETED, deployment.Status.State)
+			require.Equal(t, tc.expectProgress[0], deployment.RolloutProgress.CompletedBatches)
+			require.Equal(t, tc.expectProgress[1], deployment.RolloutProgress.TotalBatches)
+		})
+	}
+}
+
+func TestRunDeploymentFailure(t *testing.T) {
+	f := newDeploymentFixture(t)
+	defer f.ts.Close()
+
+	updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     f.planMetadata(t),
+		DeploymentId: "deployment-2",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+		},
+		InstanceCount: 4,
+	})
+	require.NoError(t, err)
+
+	// A failed rollout marks the deployment FAILED rather than leaving it in progress.
+	_, _, err = f.executeDeployment(t, updateResp.Record, "deployment-2", func(params RunOperationWorkflowParams) error {
+		return errors.New("simulated operation failure")
+	})
+	require.ErrorContains(t, err, "simulated operation failure")
+
+	deployment := f.getDeployment(t, "deployment-2")
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_FAILED, deployment.Status.State)
+	require.Contains(t, deployment.Status.Message, "Deployment failed")
+	require.Contains(t, deployment.Status.Message, "simulated operation failure")
+}
+
+func TestRunDeploymentCanary(t *testing.T) {
+	testCases := []struct {
+		name              string
+		canary            *mrdspb.Canary
+		failCanary        bool                        // Fails the operations of the new deployment.
+		canaryState       mrdspb.RuntimeInstanceState // State of the canaries after their operation when set. Other instances are ready.
+		expectBatches     []int
+		expectState       mrdspb.DeploymentState
+		expectDeployment  string // Deployment of all the instances at the end.
+		expectRollbacks   int    // Instances whose canary is rolled back.
+		expectMessageLike string
+	}{
+		{
+			name:             "Healthy canary proceeds with the rest of the instances",
+			canary:           &mrdspb.Canary{InstanceCount: 1, BakeSeconds: 300},
+			expectBatches:    []int{1, 3},
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectDeployment: "deployment-2",
+		},
+		{
+			name:             "Canary percentage is rounded up",
+			canary:           &mrdspb.Canary{Percentage: 30},
+			expectBatches:    []int{2, 2},
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectDeployment: "deployment-2",
+		},
+		{
+			name:              "Canary which is not ready after the bake time is rolled back",
+			canary:            &mrdspb.Canary{InstanceCount: 2, BakeSeconds: 300},
+			canaryState:       mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			expectBatches:     []int{2, 2},
+			expectState:       mrdspb.DeploymentState_DeploymentState_FAILED,
+			expectDeployment:  "deployment-1",
+			expectRollbacks:   2,
+			expectMessageLike: "2 of 2 canaries are not ready",
+		},
+		{
+			name:             "Unhealthy canaries within the allowed count proceed",
+			canary:           &mrdspb.Canary{InstanceCount: 2, MaxUnhealthyInstances: 2},
+			canaryState:      mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			expectBatches:    []int{2, 2},
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectDeployment: "deployment-2",
+		},
+		{
+			name:              "Failed canary operation is rolled back",
+			canary:            &mrdspb.Canary{InstanceCount: 1},
+			failCanary:        true,
+			expectBatches:     []int{1, 1},
+			expectState:       mrdspb.DeploymentState_DeploymentState_FAILED,
+			expectDeployment:  "deployment-1",
+			expectRollbacks:   1,
+			expectMessageLike: "canary operation failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newDeploymentFixture(t)
+			defer f.ts.Close()
+
+			updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:     f.planMetadata(t),
+				DeploymentId: "deployment-2",
+				PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+					{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+				},
+				InstanceCount: 4,
+				Canary:        tc.canary,
+			})
+			require.NoError(t, err)
+
+			// Stands in for the runtime, which reports the state of the instances it started. The canaries are
+			// the first instances updated to the new deployment.
+			var canariesStarted int
+			runOperation := func(params RunOperationWorkflowParams) error {
+				getResp, err := f.metaInstancesClient.GetByID(f.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: params.MetaInstanceID})
+				require.NoError(t, err)
+				status := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true}
+				if getResp.Record.DeploymentId == "deployment-2" {
+					if tc.failCanary {
+						return fmt.Errorf("instance failed to start")
+					}
+					if tc.canaryState != mrdspb.RuntimeInstanceState_RuntimeState_UNKNOWN && canariesStarted < int(tc.canary.InstanceCount) {
+						status = &mrdspb.RuntimeInstanceStatus{State: tc.canaryState}
+						canariesStarted++
+					}
+				}
+				_, err = f.metaInstancesClient.UpdateRuntimeStatus(f.ctx, &mrdspb.UpdateRuntimeStatusRequest{
+					Metadata:          getResp.Record.Metadata,
+					RuntimeInstanceId: getResp.Record.RuntimeInstances[0].Id,
+					Status:            status,
+				})
+				require.NoError(t, err)
+				return nil
+			}
+			batches, _ := f.runDeployment(t, updateResp.Record, "deployment-2", runOperation)
+			require.Equal(t, tc.expectBatches, batches)
+
+			deployment := f.getDeployment(t, "deployment-2")
+			require.Equal(t, tc.expectState, deployment.Status.State)
+			require.Contains(t, deployment.Status.Message, tc.expectMessageLike)
+
+			listResp, err := f.metaInstancesClient.List(f.ctx, &mrdspb.ListMetaInstanceRequest{})
+			require.NoError(t, err)
+			require.Len(t, listResp.Records, 4)
+			var rollbacks int
+			for _, metaInstance := range listResp.Records {
+				require.Equal(t, tc.expectDeployment, metaInstance.DeploymentId)
+				for _, op := range metaInstance.Operations {
+					if op.Id == fmt.Sprintf("UPDATE-ROLLBACK-deployment-2-%s", metaInstance.Metadata.Id) {
+						rollbacks++
+					}
+				}
+			}
+			require.Equal(t, tc.expectRollbacks, rollbacks)
+		})
+	}
+}
+
+func TestRunDeploymentBlueGreen(t *testing.T) {
+	testCases := []struct {
+		name             string
+		passiveState     mrdspb.RuntimeInstanceState // State of the passive instances once started.
+		expectState      mrdspb.DeploymentState
+		expectDeployment string // Deployment of all the instances at the end.
+		expectActive     string // Suffix of the ID of the only runtime instance left on each instance.
+	}{
+		{
+			name:             "Passive instances are switched to active",
+			passiveState:     mrdspb.RuntimeInstanceState_RuntimeState_RUNNING,
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectDeployment: "deployment-2",
+			expectActive:     "-green",
+		},
+		{
+			name:             "Passive instances which do not run are removed",
+			passiveState:     mrdspb.RuntimeInstanceState_RuntimeState_STARTING,
+			expectState:      mrdspb.DeploymentState_DeploymentState_FAILED,
+			expectDeployment: "deployment-1",
+			expectActive:     "-runtime",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newDeploymentFixture(t)
+			defer f.ts.Close()
+
+			updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:     f.planMetadata(t),
+				DeploymentId: "deployment-2",
+				PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+					{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+				},
+				InstanceCount: 4,
+				RolloutStrategy: &mrdspb.RolloutStrategy{
+					Type:        mrdspb.RolloutStrategyType_RolloutStrategyType_BLUE_GREEN,
+					HoldSeconds: 600,
+				},
+			})
+			require.NoError(t, err)
+
+			// Stands in for the operation, which starts a passive instance on another node.
+			runOperation := func(params RunOperationWorkflowParams) error {
+				require.True(t, params.PassiveUpdate)
+				getResp, err := f.metaInstancesClient.GetByID(f.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: params.MetaInstanceID})
+				require.NoError(t, err)
+				nodeResp, err := f.nodesClient.Create(f.ctx, &mrdspb.CreateNodeRequest{
+					Name:                    getResp.Record.Name + "-green",
+					UpdateDomain:            "ud-2",
+					TotalResources:          &mrdspb.Resources{Cores: 4, Memory: 4096},
+					SystemReservedResources: &mrdspb.Resources{},
+				})
+				require.NoError(t, err)
+				_, err = f.metaInstancesClient.AddRuntimeInstance(f.ctx, &mrdspb.AddRuntimeInstanceRequest{
+					Metadata: getResp.Record.Metadata,
+					RuntimeInstance: &mrdspb.RuntimeInstance{
+						Id:       getResp.Record.Name + "-green",
+						NodeId:   nodeResp.Record.Metadata.Id,
+						IsActive: false,
+						Status:   &mrdspb.RuntimeInstanceStatus{State: tc.passiveState, Ready: true},
+					},
+				})
+				require.NoError(t, err)
+				return nil
+			}
+			batches, _ := f.runDeployment(t, updateResp.Record, "deployment-2", runOperation)
+			require.Equal(t, []int{4}, batches)
+
+			deployment := f.getDeployment(t, "deployment-2")
+			require.Equal(t, tc.expectState, deployment.Status.State)
+
+			listResp, err := f.metaInstancesClient.List(f.ctx, &mrdspb.ListMetaInstanceRequest{})
+			require.NoError(t, err)
+			require.Len(t, listResp.Records, 4)
+			for _, metaInstance := range listResp.Records {
+				require.Equal(t, tc.expectDeployment, metaInstance.DeploymentId)
+				require.Len(t, metaInstance.RuntimeInstances, 1)
+				require.Equal(t, metaInstance.Name+tc.expectActive, metaInstance.RuntimeInstances[0].Id)
+				require.True(t, metaInstance.RuntimeInstances[0].IsActive)
+			}
+		})
+	}
+}
+
+// deploymentFixture is a deployment plan with four running instances of deployment-1, each on its own node
+// of a single update domain.
+func TestRunDeploymentPauseAndAbort(t *testing.T) {
+	testCases := []struct {
+		name             string
+		pause            bool                       // Pauses the deployment during the first batch.
+		control          func(f *deploymentFixture) // Changes the deployment a minute after it started when set.
+		signal           bool                       // Signals the workflow after control.
+		abortInBatch     bool                       // Aborts the deployment during the first batch without a signal.
+		expectBatches    []int
+		expectState      mrdspb.DeploymentState
+		expectResumeTime time.Duration // Minimum and maximum time between the two batches.
+	}{
+		{
+			name:  "Paused deployment continues when it is resumed",
+			pause: true,
+			control: func(f *deploymentFixture) {
+				_, err := f.deploymentPlansClient.ResumeDeployment(f.ctx, &mrdspb.ResumeDeploymentRequest{
+					Metadata:     f.planMetadata(t),
+					DeploymentId: "deployment-2",
+				})
+				require.NoError(t, err)
+			},
+			signal:           true,
+			expectBatches:    []int{2, 2},
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectResumeTime: time.Minute,
+		},
+		{
+			name:  "Resume is noticed without a signal",
+			pause: true,
+			control: func(f *deploymentFixture) {
+				_, err := f.deploymentPlansClient.ResumeDeployment(f.ctx, &mrdspb.ResumeDeploymentRequest{
+					Metadata:     f.planMetadata(t),
+					DeploymentId: "deployment-2",
+				})
+				require.NoError(t, err)
+			},
+			expectBatches:    []int{2, 2},
+			expectState:      mrdspb.DeploymentState_DeploymentState_COMPLETED,
+			expectResumeTime: deploymentPausedPollInterval,
+		},
+		{
+			name:  "Paused deployment is aborted",
+			pause: true,
+			control: func(f *deploymentFixture) {
+				_, err := f.deploymentPlansClient.AbortDeployment(f.ctx, &mrdspb.AbortDeploymentRequest{
+					Metadata:     f.planMetadata(t),
+					DeploymentId: "deployment-2",
+				})
+				require.NoError(t, err)
+			},
+			signal:        true,
+			expectBatches: []int{2},
+			expectState:   mrdspb.DeploymentState_DeploymentState_CANCELLED,
+		},
+		{
+			name:          "Running deployment is aborted before the next batch",
+			abortInBatch:  true,
+			expectBatches: []int{2},
+			expectState:   mrdspb.DeploymentState_DeploymentState_CANCELLED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newDeploymentFixture(t)
+			defer f.ts.Close()
+
+			updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:     f.planMetadata(t),
+				DeploymentId: "deployment-2",
+				PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+					{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+				},
+				InstanceCount: 4,
+				RolloutStrategy: &mrdspb.RolloutStrategy{
+					Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+					MaxUnavailable: 2,
+				},
+			})
+			require.NoError(t, err)
+
+			if tc.control != nil {
+				f.setupEnv = func(env *testsuite.TestWorkflowEnvironment) {
+					env.RegisterDelayedCallback(func() {
+						tc.control(f)
+						if tc.signal {
+							env.SignalWorkflow(signals.DeploymentStateChangedSignalName, signals.DeploymentStateChangedSignal{DeploymentID: "deployment-2"})
+						}
+					}, time.Minute)
+				}
+			}
+			changed := false
+			runOperation := func(params RunOperationWorkflowParams) error {
+				if changed {
+					return nil
+				}
+				changed = true
+				if tc.pause {
+					_, err := f.deploymentPlansClient.PauseDeployment(f.ctx, &mrdspb.PauseDeploymentRequest{
+						Metadata:     f.planMetadata(t),
+						DeploymentId: "deployment-2",
+					})
+					require.NoError(t, err)
+				}
+				if tc.abortInBatch {
+					_, err := f.deploymentPlansClient.AbortDeployment(f.ctx, &mrdspb.AbortDeploymentRequest{
+						Metadata:     f.planMetadata(t),
+						DeploymentId: "deployment-2",
+					})
+					require.NoError(t, err)
+				}
+				return nil
+			}
+
+			batches, startTimes := f.runDeployment(t, updateResp.Record, "deployment-2", runOperation)
+			require.Equal(t, tc.expectBatches, batches)
+			if tc.expectResumeTime > 0 {
+				resumeTime := startTimes[1].Sub(startTimes[0])
+				require.GreaterOrEqual(t, resumeTime, tc.expectResumeTime)
+				require.Less(t, resumeTime, tc.expectResumeTime+time.Minute)
+			}
+			require.Equal(t, tc.expectState, f.getDeployment(t, "deployment-2").Status.State)
+
+			if tc.expectState != mrdspb.DeploymentState_DeploymentState_CANCELLED {
+				return
+			}
+			// The instances of the second batch are back on their deployment, with their operation cleaned up.
+			listResp, err := f.metaInstancesClient.List(f.ctx, &mrdspb.ListMetaInstanceRequest{DeploymentPlanIdIn: []string{f.planID}})
+			require.NoError(t, err)
+			var reverted int
+			for _, instance := range listResp.Records {
+				if instance.DeploymentId != "deployment-1" {
+					continue
+				}
+				reverted++
+				require.Len(t, instance.Operations, 1)
+				require.Equal(t, mrdspb.OperationState_OperationState_FAILED, instance.Operations[0].Status.State)
+			}
+			require.Equal(t, 2, reverted)
+		})
+	}
+}
+
+func TestRunDeploymentWaitsForReadiness(t *testing.T) {
+	f := newDeploymentFixture(t)
+	defer f.ts.Close()
+
+	updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     f.planMetadata(t),
+		DeploymentId: "deployment-2",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:2"}},
+		},
+		InstanceCount: 4,
+		RolloutStrategy: &mrdspb.RolloutStrategy{
+			Type:           mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING,
+			MaxUnavailable: 2,
+		},
+	})
+	require.NoError(t, err)
+
+	// The instances of the first batch are running, but only pass their readiness probes after a few minutes.
+	const readyAfter = 3 * time.Minute
+	var started []string
+	runOperation := func(params RunOperationWorkflowParams) error {
+		if len(started) == 2 {
+			return nil
+		}
+		started = append(started, params.MetaInstanceID)
+		getResp, err := f.metaInstancesClient.GetByID(f.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: params.MetaInstanceID})
+		require.NoError(t, err)
+		_, err = f.metaInstancesClient.UpdateRuntimeStatus(f.ctx, &mrdspb.UpdateRuntimeStatusRequest{
+			Metadata:          getResp.Record.Metadata,
+			RuntimeInstanceId: getResp.Record.RuntimeInstances[0].Id,
+			Status:            &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+		})
+		require.NoError(t, err)
+		return nil
+	}
+	f.setupEnv = func(env *testsuite.TestWorkflowEnvironment) {
+		env.RegisterDelayedCallback(func() {
+			for _, metaInstanceID := range started {
+				getResp, err := f.metaInstancesClient.GetByID(f.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstanceID})
+				require.NoError(t, err)
+				_, err = f.metaInstancesClient.UpdateRuntimeStatus(f.ctx, &mrdspb.UpdateRuntimeStatusRequest{
+					Metadata:          getResp.Record.Metadata,
+					RuntimeInstanceId: getResp.Record.RuntimeInstances[0].Id,
+					Status:            &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true},
+				})
+				require.NoError(t, err)
+			}
+		}, readyAfter)
+	}
+
+	batches, startTimes := f.runDeployment(t, updateResp.Record, "deployment-2", runOperation)
+	require.Equal(t, []int{2, 2}, batches)
+	require.GreaterOrEqual(t, startTimes[1].Sub(startTimes[0]), readyAfter)
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_COMPLETED, f.getDeployment(t, "deployment-2").Status.State)
+}
+
+func TestRunDeploymentSpecUpdate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		applications     []*mrdspb.Application // Applications of the updated spec.
+		expectReallocate bool
+	}{
+		{
+			name: "Instances with unchanged resources are updated in place",
+			applications: []*mrdspb.Application{
+				{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+			},
+		},
+		{
+			name: "Instances with changed resources are reallocated",
+			applications: []*mrdspb.Application{
+				{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 2, Memory: 1024}},
+			},
+			expectReallocate: true,
+		},
+		{
+			name: "Instances with an added application are reallocated",
+			applications: []*mrdspb.Application{
+				{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+				{PayloadName: "sidecar", Resources: &mrdspb.ApplicationResources{}},
+			},
+			expectReallocate: true,
+		},
+		{
+			name: "Instances with a replaced application are reallocated",
+			applications: []*mrdspb.Application{
+				{PayloadName: "other", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+			},
+			expectReallocate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newDeploymentFixture(t)
+			defer f.ts.Close()
+
+			_, err := f.deploymentPlansClient.UpdateSpec(f.ctx, &mrdspb.UpdateDeploymentPlanSpecRequest{
+				Metadata:     f.planMetadata(t),
+				Applications: tc.applications,
+			})
+			require.NoError(t, err)
+			var coordinates []*mrdspb.PayloadCoordinates
+			for _, app := range tc.applications {
+				coordinates = append(coordinates, &mrdspb.PayloadCoordinates{
+					PayloadName: app.PayloadName,
+					Coordinates: map[string]string{"image": "nginx:2"},
+				})
+			}
+			updateResp, err := f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:           f.planMetadata(t),
+				DeploymentId:       "deployment-2",
+				PayloadCoordinates: coordinates,
+				InstanceCount:      4,
+			})
+			require.NoError(t, err)
+
+			var reallocated, updated int
+			runOperation := func(params RunOperationWorkflowParams) error {
+				require.Equal(t, mrdspb.OperationType_OperationType_UPDATE, params.OperationType)
+				if params.Reallocate {
+					reallocated++
+				} else {
+					updated++
+				}
+				return nil
+			}
+			batches, _ := f.runDeployment(t, updateResp.Record, "deployment-2", runOperation)
+			require.Equal(t, []int{4}, batches)
+			if tc.expectReallocate {
+				require.Equal(t, 4, reallocated)
+			} else {
+				require.Equal(t, 4, updated)
+			}
+			require.Equal(t, mrdspb.DeploymentState_DeploymentState_COMPLETED, f.getDeployment(t, "deployment-2").Status.State)
+		})
+	}
+}
+
+type deploymentFixture struct {
+	ctx                   context.Context
+	ts                    *testserver.TestServer
+	metaInstancesClient   mrdspb.MetaInstancesClient
+	deploymentPlansClient mrdspb.DeploymentPlansClient
+	nodesClient           mrdspb.NodesClient
+	planID                string
+	metaInstances         []*mrdspb.MetaInstance
+	setupEnv              func(env *testsuite.TestWorkflowEnvironment) // Called before the deployment workflow runs when set.
+}
+
+func newDeploymentFixture(t *testing.T) *deploymentFixture {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+
+	f := &deploymentFixture{
+		ctx:                   context.Background(),
+		ts:                    ts,
+		metaInstancesClient:   mrdspb.NewMetaInstancesClient(ts.Conn()),
+		deploymentPlansClient: mrdspb.NewDeploymentPlansClient(ts.Conn()),
+		nodesClient:           mrdspb.NewNodesClient(ts.Conn()),
+	}
+
+	planResp, err := f.deploymentPlansClient.Create(f.ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "plan",
+		Namespace:   "test",
+		ServiceName: "plan",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+	})
+	require.NoError(t, err)
+	f.planID = planResp.Record.Metadata.Id
+	_, err = f.deploymentPlansClient.AddDeployment(f.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-1",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:1"}},
+		},
+		InstanceCount: 4,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		nodeResp, err := f.nodesClient.Create(f.ctx, &mrdspb.CreateNodeRequest{
+			Name:                    fmt.Sprintf("node-%d", i),
+			UpdateDomain:            "ud-1",
+			TotalResources:          &mrdspb.Resources{Cores: 4, Memory: 4096},
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(t, err)
+		createResp, err := f.metaInstancesClient.Create(f.ctx, &mrdspb.CreateMetaInstanceRequest{
+			Name:             fmt.Sprintf("plan-%d", i),
+			DeploymentPlanId: f.planID,
+			DeploymentId:     "deployment-1",
+		})
+		require.NoError(t, err)
+		updateResp, err := f.metaInstancesClient.AddRuntimeInstance(f.ctx, &mrdspb.AddRuntimeInstanceRequest{
+			Metadata: createResp.Record.Metadata,
+			RuntimeInstance: &mrdspb.RuntimeInstance{
+				Id:       fmt.Sprintf("plan-%d-runtime", i),
+				NodeId:   nodeResp.Record.Metadata.Id,
+				IsActive: true,
+				Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true},
+			},
+		})
+		require.NoError(t, err)
+		f.metaInstances = append(f.metaInstances, updateResp.Record)
+	}
+	return f
+}
+
+func (f *deploymentFixture) planMetadata(t *testing.T) *mrdspb.Metadata {
+	getResp, err := f.deploymentPlansClient.GetByID(f.ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: f.planID})
+	require.NoError(t, err)
+	return getResp.Record.Metadata
+}
+
+func (f *deploymentFixture) getDeployment(t *testing.T, deploymentID string) *mrdspb.Deployment {
+	getResp, err := f.deploymentPlansClient.GetByID(f.ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: f.planID})
+	require.NoError(t, err)
+	for _, d := range getResp.Record.Deployments {
+		if d.Id == deploymentID {
+			return d
+		}
+	}
+	require.FailNow(t, "deployment not found", deploymentID)
+	return nil
+}
+
+// runDeployment runs the deployment workflow to a successful completion. The operations are not run, but
+// recorded by their start time and passed to runOperation when set. It returns the number of operations started
+// at each time.
+func (f *deploymentFixture) runDeployment(
+	t *testing.T,
+	plan *mrdspb.DeploymentPlanRecord,
+	deploymentID string,
+	runOperation func(params RunOperationWorkflowParams) error,
+) ([]int, []time.Time) {
+	batches, startTimes, err := f.executeDeployment(t, plan, deploymentID, runOperation)
+	require.NoError(t, err)
+	return batches, startTimes
+}
+
+// executeDeployment runs the deployment workflow to completion as runDeployment does, and returns the error of
+// the workflow.
+func (f *deploymentFixture) executeDeployment(
+	t *testing.T,
+	plan *mrdspb.DeploymentPlanRecord,
+	deploymentID string,
+	runOperation func(params RunOperationWorkflowParams) error,
+) ([]int, []time.Time, error) {
+	var deployment *mrdspb.Deployment
+	for _, d := range plan.Deployments {
+		if d.Id == deploymentID {
+			deployment = d
+		}
+	}
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	runtimeActivities := &noopRuntime{}
+	runtimeActivities.Register(env)
+	w := NewDeploymentWorkflow(
+		mrds.NewDeploymentPlanActivities(f.deploymentPlansClient, env),
+		mrds.NewMetaInstanceActivities(f.metaInstancesClient, env),
+		mrds.NewNodeActivities(f.nodesClient, env),
+		runtimeActivities,
+		env,
+	)
+
+	var startTimes []time.Time
+	operationsStarted := make(map[time.Time]int)
+	env.RegisterWorkflowWithOptions(func(ctx workflow.Context, params RunOperationWorkflowParams) (*RunOperationWorkflowResponse, error) {
+		now := workflow.Now(ctx)
+		if operationsStarted[now] == 0 {
+			startTimes = append(startTimes, now)
+		}
+		operationsStarted[now]++
+		// Operations take a while so that each batch starts at a distinct time.
+		err := workflow.Sleep(ctx, time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if runOperation != nil {
+			err := runOperation(params)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &RunOperationWorkflowResponse{
+			MetaInstance: &mrdspb.MetaInstance{
+				Status: &mrdspb.MetaInstanceStatus{State: mrdspb.MetaInstanceState_MetaInstanceState_ACTIVE},
+			},
+		}, nil
+	}, workflow.RegisterOptions{Name: OperationsWorkflowName})
+
+	if f.setupEnv != nil {
+		f.setupEnv(env)
+	}
+	env.ExecuteWorkflow(w.RunDeployment, RunDeploymentWorkflowParams{
+		DeploymentPlan: plan,
+		Deployment:     deployment,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+
+	var batches []int
+	for _, startTime := range startTimes {
+		batches = append(batches, operationsStarted[startTime])
+	}
+	return batches, startTimes, env.GetWorkflowError()
+}
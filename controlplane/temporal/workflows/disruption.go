@@ -0,0 +1,318 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+type DisruptionWorkflow struct {
+	metaInstanceActivities *mrds.MetaInstanceActivities
+	nodeActivities         *mrds.NodeActivities
+	runtimeActivities      runtime.RuntimeActivities
+}
+
+// NewDisruptionWorkflow creates the workflow which drains a Node ahead of a disruption.
+func NewDisruptionWorkflow(
+	metaInstance *mrds.MetaInstanceActivities,
+	node *mrds.NodeActivities,
+	runtimeActivities runtime.RuntimeActivities,
+	registry worker.Registry,
+) *DisruptionWorkflow {
+	d := &DisruptionWorkflow{
+		metaInstanceActivities: metaInstance,
+		nodeActivities:         node,
+		runtimeActivities:      runtimeActivities,
+	}
+
+	registry.RegisterWorkflow(d.RunDisruption)
+	return d
+}
+
+const RunDisruptionWorkflowName = "RunDisruption"
+
+// disruptionCompletedPollInterval is how often the ledger is checked while waiting for a disruption to be
+// completed.
+const disruptionCompletedPollInterval = 30 * time.Second
+
+type RunDisruptionWorkflowParams struct {
+	NodeID       string
+	DisruptionID string
+}
+
+// RunDisruption drains the Node of a SCHEDULED disruption. Every runtime instance active on the Node is
+// relocated through a RELOCATE operation, and the passive ones are removed, after which the disruption is
+// APPROVED. Once the disruption is COMPLETED, the Node is evicted if the disruption asked for it. Otherwise the
+// disruption is removed and the Node is returned to scheduling.
+func (d *DisruptionWorkflow) RunDisruption(ctx workflow.Context, params RunDisruptionWorkflowParams) error {
+	log := workflow.GetLogger(ctx)
+	ao := workflow.ActivityOptions{
+		ScheduleToCloseTimeout: 24 * time.Hour,
+		StartToCloseTimeout:    24 * time.Hour,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var getNodeResponse mrdspb.GetNodeResponse
+	err := workflow.ExecuteActivity(ctx, d.nodeActivities.GetNodeByID, &mrdspb.GetNodeByIDRequest{
+		Id: params.NodeID,
+	}).Get(ctx, &getNodeResponse)
+	if err != nil {
+		return err
+	}
+	node := getNodeResponse.Record
+
+	var disruption *mrdspb.NodeDisruption
+	for _, nd := range node.Disruptions {
+		if nd.Id == params.DisruptionID {
+			disruption = nd
+			break
+		}
+	}
+	if disruption == nil {
+		return fmt.Errorf("disruption with ID %s not found on node %s", params.DisruptionID, node.Name)
+	}
+
+	if disruption.Status.State == mrdspb.DisruptionState_DisruptionState_SCHEDULED {
+		log.Info("Draining node", "node", node.Name, "disruptionID", disruption.Id)
+		err := d.drainNode(ctx, node, disruption)
+		if err != nil {
+			return err
+		}
+
+		var updateDisruptionResponse mrds.UpdateNodeDisruptionStatusResponse
+		err = workflow.ExecuteActivity(ctx, d.nodeActivities.UpdateNodeDisruptionStatus, &mrds.UpdateNodeDisruptionStatusRequest{
+			NodeID:       node.Metadata.Id,
+			DisruptionID: disruption.Id,
+			Status: &mrdspb.DisruptionStatus{
+				State:   mrdspb.DisruptionState_DisruptionState_APPROVED,
+				Message: "All instances have been relocated off the node",
+			},
+		}).Get(ctx, &updateDisruptionResponse)
+		if err != nil {
+			return err
+		}
+		log.Info("Disruption approved", "node", node.Name, "disruptionID", disruption.Id)
+	}
+
+	log.Info("Waiting for disruption to be completed", "node", node.Name, "disruptionID", disruption.Id)
+	err = d.waitForCompletion(ctx, node.Metadata.Id, disruption.Id)
+	if err != nil {
+		return err
+	}
+
+	if disruption.ShouldEvict {
+		var evictNodeResponse mrds.EvictNodeResponse
+		err = workflow.ExecuteActivity(ctx, d.nodeActivities.EvictNode, &mrds.EvictNodeRequest{
+			NodeID:  node.Metadata.Id,
+			Message: fmt.Sprintf("Evicted by disruption %s", disruption.Id),
+		}).Get(ctx, &evictNodeResponse)
+		if err != nil {
+			return err
+		}
+		log.Info("Node evicted", "node", node.Name, "disruptionID", disruption.Id)
+		return nil
+	}
+
+	// Removing the completed disruption returns the node to scheduling.
+	var removeDisruptionResponse mrds.RemoveNodeDisruptionResponse
+	err = workflow.ExecuteActivity(ctx, d.nodeActivities.RemoveNodeDisruption, &mrds.RemoveNodeDisruptionRequest{
+		NodeID:       node.Metadata.Id,
+		DisruptionID: disruption.Id,
+	}).Get(ctx, &removeDisruptionResponse)
+	if err != nil {
+		return err
+	}
+	log.Info("Node returned to scheduling", "node", node.Name, "disruptionID", disruption.Id)
+	return nil
+}
+
+// waitForCompletion waits until the disruption of the node is COMPLETED. The node is read from the ledger on
+// a timer, so that the workflow does not hold an activity for the whole disruption.
+func (d *DisruptionWorkflow) waitForCompletion(ctx workflow.Context, nodeID string, disruptionID string) error {
+	for {
+		var getNodeResponse mrdspb.GetNodeResponse
+		err := workflow.ExecuteActivity(ctx, d.nodeActivities.GetNodeByID, &mrdspb.GetNodeByIDRequest{
+			Id: nodeID,
+		}).Get(ctx, &getNodeResponse)
+		if err != nil {
+			return err
+		}
+
+		var disruption *mrdspb.NodeDisruption
+		for _, nd := range getNodeResponse.Record.Disruptions {
+			if nd.Id == disruptionID {
+				disruption = nd
+				break
+			}
+		}
+		if disruption == nil {
+			return fmt.Errorf("disruption %s not found on node %s", disruptionID, getNodeResponse.Record.Name)
+		}
+		if disruption.Status.State == mrdspb.DisruptionState_DisruptionState_COMPLETED {
+			return nil
+		}
+
+		err = workflow.Sleep(ctx, disruptionCompletedPollInterval)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// drainNode relocates every runtime instance which is active on the node and waits for the relocations to
+// complete. The scheduler does not place instances on nodes with a pending disruption, so the instances are
+// relocated to other nodes.
+//
+// Passive runtime instances on the node, such as those started by a blue-green deployment, are stopped and
+// removed instead. They are not serving, and a blue-green deployment whose passive instance is gone aborts and
+// keeps the active one.
+func (d *DisruptionWorkflow) drainNode(ctx workflow.Context, node *mrdspb.Node, disruption *mrdspb.NodeDisruption) error {
+	var listMetaInstancesResponse mrdspb.ListMetaInstanceResponse
+	err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.ListMetaInstance, &mrdspb.ListMetaInstanceRequest{}).Get(ctx, &listMetaInstancesResponse)
+	if err != nil {
+		return err
+	}
+
+	// The operation ID is derived from the disruption so that it is stable across workflow replays, and across
+	// runs of the disruption workflow started again after a failed drain.
+	operationID := fmt.Sprintf("RELOCATE-%s", disruption.Id)
+	var operationFutures []workflow.Future
+	for _, instance := range listMetaInstancesResponse.Records {
+		if activeNodeID(instance) != node.Metadata.Id {
+			for _, runtimeInstance := range instance.RuntimeInstances {
+				if runtimeInstance.NodeId != node.Metadata.Id {
+					continue
+				}
+				workflow.GetLogger(ctx).Info("Removing passive runtime instance", "MetaInstance", instance.Name, "RuntimeInstance", runtimeInstance.Id)
+				err := d.removeRuntimeInstance(ctx, instance.Metadata.Id, runtimeInstance.Id)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		future, err := d.relocateInstance(ctx, node, disruption, instance, operationID)
+		if err != nil {
+			return err
+		}
+		operationFutures = append(operationFutures, future)
+	}
+
+	// Wait for all relocations to complete
+	for _, f := range operationFutures {
+		var runOperationWorkflowResponse RunOperationWorkflowResponse
+		err := f.Get(ctx, &runOperationWorkflowResponse)
+		if err != nil {
+			return err
+		}
+		workflow.GetLogger(ctx).Info("Relocation completed", "MetaInstance", runOperationWorkflowResponse.MetaInstance)
+	}
+	return nil
+}
+
+// relocateInstance adds the RELOCATE operation of the disruption to the meta instance and runs it. When an
+// earlier run of the disruption workflow failed to relocate the instance, the operation is already there, and it
+// is prepared again and run once more.
+//
+// The relocation makes every passive runtime instance of the meta instance active, so the passive runtime
+// instances are removed first. Those include the runtime instance placed by a failed relocation.
+func (d *DisruptionWorkflow) relocateInstance(
+	ctx workflow.Context,
+	node *mrdspb.Node,
+	disruption *mrdspb.NodeDisruption,
+	instance *mrdspb.MetaInstance,
+	operationID string,
+) (workflow.Future, error) {
+	message := fmt.Sprintf("Instance relocation requested by disruption of node %s", node.Name)
+
+	for _, runtimeInstance := range instance.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			continue
+		}
+		err := d.removeRuntimeInstance(ctx, instance.Metadata.Id, runtimeInstance.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var existing *mrdspb.Operation
+	for _, op := range instance.Operations {
+		if op.Id == operationID {
+			existing = op
+			break
+		}
+	}
+	if existing == nil {
+		var addOperationResponse mrds.AddOperationResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.AddOperation, &mrds.AddOperationRequest{
+			MetaInstanceID: instance.Metadata.Id,
+			Operation: &mrdspb.Operation{
+				Id:       operationID,
+				Type:     mrdspb.OperationType_OperationType_RELOCATE,
+				IntentId: disruption.Id,
+				Status: &mrdspb.OperationStatus{
+					State:   mrdspb.OperationState_OperationState_PREPARING,
+					Message: message,
+				},
+			},
+		}).Get(ctx, &addOperationResponse)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		workflow.GetLogger(ctx).Info("Retrying relocation", "MetaInstance", instance.Name, "OperationState", existing.Status.State)
+		var updateOperationStatusResponse mrds.UdpateOperationStatusResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: instance.Metadata.Id,
+			OperationID:    operationID,
+			State:          mrdspb.OperationState_OperationState_PREPARING,
+			Message:        message,
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The operation workflow of a failed relocation failed as well, so that it can be started again.
+	cwo := workflow.ChildWorkflowOptions{
+		WorkflowID:            signals.OperationWorkflowID(instance.Name, operationID),
+		WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+	}
+	childCtx := workflow.WithChildOptions(ctx, cwo)
+	return workflow.ExecuteChildWorkflow(
+		childCtx,
+		OperationsWorkflowName,
+		RunOperationWorkflowParams{
+			OperationID:    operationID,
+			OperationType:  mrdspb.OperationType_OperationType_RELOCATE,
+			MetaInstanceID: instance.Metadata.Id,
+		},
+	), nil
+}
+
+// removeRuntimeInstance stops the runtime instance of the meta instance and removes it from the ledger.
+func (d *DisruptionWorkflow) removeRuntimeInstance(ctx workflow.Context, metaInstanceID string, runtimeInstanceID string) error {
+	var runtimeActivityResponse runtime.RuntimeActivityResponse
+	err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StopInstance, runtime.RuntimeActivityRequest{
+		MetaInstanceID:    metaInstanceID,
+		RuntimeInstanceID: runtimeInstanceID,
+		Remove:            true,
+	}).Get(ctx, &runtimeActivityResponse)
+	if err != nil {
+		return err
+	}
+
+	var removeRuntimeInstanceResponse mrds.RemoveRuntimeInstanceResponse
+	return workflow.ExecuteActivity(ctx, d.metaInstanceActivities.RemoveRuntimeInstance, &mrds.RemoveRuntimeInstanceRequest{
+		MetaInstanceID:    metaInstanceID,
+		RuntimeInstanceID: runtimeInstanceID,
+	}).Get(ctx, &removeRuntimeInstanceResponse)
+}
@@ -0,0 +1,78 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/runtime/fake"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestRunDisruptionWaitsForCompletion(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx := context.Background()
+	nodesClient := mrdspb.NewNodesClient(ts.Conn())
+	metaInstancesClient := mrdspb.NewMetaInstancesClient(ts.Conn())
+
+	createResp, err := nodesClient.Create(ctx, &mrdspb.CreateNodeRequest{
+		Name:                    "node-1",
+		UpdateDomain:            "ud-1",
+		TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+		SystemReservedResources: &mrdspb.Resources{},
+	})
+	require.NoError(t, err)
+	nodeID := createResp.Record.Metadata.Id
+	_, err = nodesClient.AddDisruption(ctx, &mrdspb.AddDisruptionRequest{
+		Metadata: createResp.Record.Metadata,
+		Disruption: &mrdspb.NodeDisruption{
+			Id:     "disruption-1",
+			Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+		},
+	})
+	require.NoError(t, err)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	w := NewDisruptionWorkflow(
+		mrds.NewMetaInstanceActivities(metaInstancesClient, env),
+		mrds.NewNodeActivities(nodesClient, env),
+		fake.NewFakeRuntime(metaInstancesClient, fake.Profile{}),
+		env,
+	)
+
+	// The disruption is completed in the ledger after an hour, while the workflow waits on its timer.
+	start := env.Now()
+	var completedAt time.Time
+	env.RegisterDelayedCallback(func() {
+		getResp, err := nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: nodeID})
+		require.NoError(t, err)
+		require.Equal(t, mrdspb.DisruptionState_DisruptionState_APPROVED, getResp.Record.Disruptions[0].Status.State)
+		_, err = nodesClient.UpdateDisruptionStatus(ctx, &mrdspb.UpdateDisruptionStatusRequest{
+			Metadata:     getResp.Record.Metadata,
+			DisruptionId: "disruption-1",
+			Status:       &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_COMPLETED},
+		})
+		require.NoError(t, err)
+		completedAt = env.Now()
+	}, time.Hour)
+
+	env.ExecuteWorkflow(w.RunDisruption, RunDisruptionWorkflowParams{NodeID: nodeID, DisruptionID: "disruption-1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// The workflow noticed the completion within a poll interval, and returned the node to scheduling.
+	require.Equal(t, time.Hour, completedAt.Sub(start))
+	require.LessOrEqual(t, env.Now().Sub(completedAt), disruptionCompletedPollInterval)
+	getResp, err := nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: nodeID})
+	require.NoError(t, err)
+	require.Empty(t, getResp.Record.Disruptions)
+}
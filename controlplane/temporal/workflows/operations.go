@@ -8,6 +8,7 @@ import (
 	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
 	"github.com/msanath/mrds/controlplane/temporal/activities/scheduler"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 )
@@ -16,18 +17,26 @@ type OperationsWorkflow struct {
 	metaInstanceActivities *mrds.MetaInstanceActivities
 	schedulerActivities    *scheduler.SchedulerActivities
 	runtimeActivities      runtime.RuntimeActivities
+	approvalTimeout        time.Duration
 }
 
+// NewOperationsWorkflow creates the workflow which runs the operations of meta instances. Operations which
+// are not approved within the approval timeout are FAILED.
 func NewOperationsWorkflow(
 	metaInstanceActivities *mrds.MetaInstanceActivities,
 	schedulerActivities *scheduler.SchedulerActivities,
 	runtimeActivities runtime.RuntimeActivities,
+	approvalTimeout time.Duration,
 	registry worker.Registry,
 ) *OperationsWorkflow {
+	if approvalTimeout == 0 {
+		approvalTimeout = DefaultApprovalTimeout
+	}
 	d := &OperationsWorkflow{
 		metaInstanceActivities: metaInstanceActivities,
 		schedulerActivities:    schedulerActivities,
 		runtimeActivities:      runtimeActivities,
+		approvalTimeout:        approvalTimeout,
 	}
 
 	registry.RegisterWorkflow(d.RunOperation)
@@ -40,6 +49,12 @@ type RunOperationWorkflowParams struct {
 	MetaInstanceID string
 	OperationID    string
 	OperationType  mrdspb.OperationType
+	// PassiveUpdate runs an UPDATE on a new passive runtime instance, leaving the active one running. The
+	// operation stays APPROVED, and the deployment completes it once the passive instance is made active.
+	PassiveUpdate bool
+	// Reallocate runs an UPDATE on a new runtime instance placed with the current spec of the deployment plan.
+	// The new runtime instance replaces the active one, as in a RELOCATE.
+	Reallocate bool
 }
 
 type RunOperationWorkflowResponse struct {
@@ -76,7 +91,13 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 		return nil, fmt.Errorf("operation with ID %s not found", params.OperationID)
 	}
 
-	switch params.OperationType {
+	// A reallocated update runs as a relocation onto a new runtime instance.
+	operationType := params.OperationType
+	if operationType == mrdspb.OperationType_OperationType_UPDATE && params.Reallocate && !params.PassiveUpdate {
+		operationType = mrdspb.OperationType_OperationType_RELOCATE
+	}
+
+	switch operationType {
 	case mrdspb.OperationType_OperationType_CREATE:
 		log.Info("Creating a new runtime instance")
 		var allocateRuntimeInstanceResponse scheduler.AllocateRuntimeInstanceResponse
@@ -89,6 +110,21 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 		}
 		log.Info("Allocated runtime instance", "metaInstance", allocateRuntimeInstanceResponse.MetaInstance, "runtimeInstance", allocateRuntimeInstanceResponse.RuntimeInstance)
 
+	case mrdspb.OperationType_OperationType_UPDATE:
+		if !params.PassiveUpdate {
+			break
+		}
+		log.Info("Creating a new passive runtime instance to update")
+		var allocateRuntimeInstanceResponse scheduler.AllocateRuntimeInstanceResponse
+		err := workflow.ExecuteActivity(ctx, d.schedulerActivities.AllocateRuntimeInstance, scheduler.AllocateRuntimeInstanceParams{
+			MetaInstanceID: params.MetaInstanceID,
+			IsActive:       false,
+		}).Get(ctx, &allocateRuntimeInstanceResponse)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Allocated runtime instance", "metaInstance", allocateRuntimeInstanceResponse.MetaInstance, "runtimeInstance", allocateRuntimeInstanceResponse.RuntimeInstance)
+
 	case mrdspb.OperationType_OperationType_RELOCATE:
 		log.Info("Creaing a new runtime instance to relocate to")
 		var allocateRuntimeInstanceResponse scheduler.AllocateRuntimeInstanceResponse
@@ -117,36 +153,51 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 	log.Info("Updated operation status to PENDING_APPROVAL", "metaInstance", updateOperationStatusResponse.MetaInstance)
 
 	// Wait for operation to be approved.
-	log.Info("Waiting for operation to be approved")
-	var waitResponse mrds.WaitForOperationStatusApprovedResponse
-	err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.WaitForOperationStatusApproved, mrds.WaitForOperationStatusApprovedRequest{
-		MetaInstanceID: params.MetaInstanceID,
-		OperationID:    params.OperationID,
-	}).Get(ctx, &waitResponse)
+	log.Info("Waiting for operation to be approved", "timeout", d.approvalTimeout)
+	approvedMetaInstance, err := d.waitForApproval(ctx, params)
 	if err != nil {
 		return nil, err
 	}
-	log.Info("Operation approved", "metaInstance", waitResponse.MetaInstance)
+	if approvedMetaInstance == nil {
+		message := fmt.Sprintf("Operation was not approved within %s", d.approvalTimeout)
+		log.Info("Approval timed out. Updating operation status to FAILED")
+		err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: params.MetaInstanceID,
+			OperationID:    params.OperationID,
+			State:          mrdspb.OperationState_OperationState_FAILED,
+			Message:        message,
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s", message)
+	}
+	log.Info("Operation approved", "metaInstance", approvedMetaInstance)
 
 	// After approval, run the post approval steps based on the type of operation.
 	// The operations act on the active instance unless it is a relocate operation.
 	log.Info("Running post approval steps")
 	var activityErr error
 
-	for _, ri := range waitResponse.MetaInstance.RuntimeInstances {
+	for _, ri := range approvedMetaInstance.RuntimeInstances {
 		if ri.IsActive {
-			switch params.OperationType {
+			switch operationType {
 			// If the operation type is create, restart or update - start the instance.
 			case mrdspb.OperationType_OperationType_CREATE:
 				fallthrough
 			case mrdspb.OperationType_OperationType_RESTART:
 				fallthrough
 			case mrdspb.OperationType_OperationType_UPDATE:
-				log.Info("Starting runtime instance", "metaInstance", waitResponse.MetaInstance, "runtimeInstance", ri)
+				if params.PassiveUpdate {
+					// The active instance keeps running until the passive one is made active.
+					break
+				}
+				log.Info("Starting runtime instance", "metaInstance", approvedMetaInstance, "runtimeInstance", ri)
 				var runtimeActivityResponse runtime.RuntimeActivityResponse
 				err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StartInstance, runtime.RuntimeActivityRequest{
 					MetaInstanceID:    params.MetaInstanceID,
 					RuntimeInstanceID: ri.Id,
+					Restart:           operationType == mrdspb.OperationType_OperationType_RESTART,
 				}).Get(ctx, &runtimeActivityResponse)
 				if err != nil {
 					activityErr = err
@@ -156,7 +207,7 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 
 			// If the operation type is stop - stop the instance. The runtime instance is not removed.
 			case mrdspb.OperationType_OperationType_STOP:
-				log.Info("Stopping runtime instance", "metaInstance", waitResponse.MetaInstance, "runtimeInstance", ri)
+				log.Info("Stopping runtime instance", "metaInstance", approvedMetaInstance, "runtimeInstance", ri)
 				var runtimeActivityResponse runtime.RuntimeActivityResponse
 				err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StopInstance, runtime.RuntimeActivityRequest{
 					MetaInstanceID:    params.MetaInstanceID,
@@ -172,11 +223,12 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 			case mrdspb.OperationType_OperationType_DELETE:
 				fallthrough
 			case mrdspb.OperationType_OperationType_RELOCATE:
-				log.Info("Stopping runtime instance", "metaInstance", waitResponse.MetaInstance, "runtimeInstance", ri)
+				log.Info("Stopping runtime instance", "metaInstance", approvedMetaInstance, "runtimeInstance", ri)
 				var runtimeActivityResponse runtime.RuntimeActivityResponse
 				err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StopInstance, runtime.RuntimeActivityRequest{
 					MetaInstanceID:    params.MetaInstanceID,
 					RuntimeInstanceID: ri.Id,
+					Remove:            true,
 				}).Get(ctx, &runtimeActivityResponse)
 				if err != nil {
 					activityErr = err
@@ -197,10 +249,27 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 				log.Info("Removed runtime instance", "metaInstance", removeRuntimeInstanceResponse.MetaInstance, "runtimeInstance", ri)
 			}
 		} else {
-			switch params.OperationType {
+			switch operationType {
+			// If the operation is a passive update - start the passive instance with the new deployment.
+			case mrdspb.OperationType_OperationType_UPDATE:
+				if !params.PassiveUpdate {
+					break
+				}
+				log.Info("Starting passive runtime instance", "metaInstance", approvedMetaInstance, "runtimeInstance", ri)
+				var runtimeActivityResponse runtime.RuntimeActivityResponse
+				err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StartInstance, runtime.RuntimeActivityRequest{
+					MetaInstanceID:    params.MetaInstanceID,
+					RuntimeInstanceID: ri.Id,
+				}).Get(ctx, &runtimeActivityResponse)
+				if err != nil {
+					activityErr = err
+					break
+				}
+				log.Info("Started passive runtime instance", "metaInstance", runtimeActivityResponse.MetaInstance, "runtimeInstance", ri)
+
 			// If the operation type is relocate - start the passive instance as the relocate operation has been approved.
 			case mrdspb.OperationType_OperationType_RELOCATE:
-				log.Info("Starting runtime instance", "metaInstance", waitResponse.MetaInstance, "runtimeInstance", ri)
+				log.Info("Starting runtime instance", "metaInstance", approvedMetaInstance, "runtimeInstance", ri)
 				var runtimeActivityResponse runtime.RuntimeActivityResponse
 				err := workflow.ExecuteActivity(ctx, d.runtimeActivities.StartInstance, runtime.RuntimeActivityRequest{
 					MetaInstanceID:    params.MetaInstanceID,
@@ -245,6 +314,17 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 		return nil, activityErr
 	}
 
+	if params.PassiveUpdate {
+		var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+			Id: params.MetaInstanceID,
+		}).Get(ctx, &getMetaInstanceResponse)
+		if err != nil {
+			return nil, err
+		}
+		return &RunOperationWorkflowResponse{MetaInstance: getMetaInstanceResponse.Record}, nil
+	}
+
 	// Update the operation status to SUCCESS
 	err = workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
 		MetaInstanceID: params.MetaInstanceID,
@@ -258,3 +338,58 @@ func (d *OperationsWorkflow) RunOperation(ctx workflow.Context, params RunOperat
 
 	return &RunOperationWorkflowResponse{MetaInstance: updateOperationStatusResponse.MetaInstance}, nil
 }
+
+// waitForApproval waits for the operation to be APPROVED, for at most the approval timeout. The operation is
+// approved by a signal, or in the ledger which is checked every approvalPollInterval, so that an approval whose
+// signal was lost is not kept waiting. It returns the approved meta instance, or nil when the operation was not
+// approved in time.
+func (d *OperationsWorkflow) waitForApproval(ctx workflow.Context, params RunOperationWorkflowParams) (*mrdspb.MetaInstance, error) {
+	log := workflow.GetLogger(ctx)
+
+	deadlineCtx, cancelDeadline := workflow.WithCancel(ctx)
+	defer cancelDeadline()
+	deadline := workflow.NewTimer(deadlineCtx, d.approvalTimeout)
+	signalChan := workflow.GetSignalChannel(ctx, signals.OperationApprovedSignalName)
+
+	for {
+		approved := false
+		timedOut := false
+		pollCtx, cancelPoll := workflow.WithCancel(ctx)
+		poll := workflow.NewTimer(pollCtx, approvalPollInterval)
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signalChan, func(c workflow.ReceiveChannel, more bool) {
+			var signal signals.OperationApprovedSignal
+			c.Receive(ctx, &signal)
+			if signal.OperationID != params.OperationID {
+				log.Info("Ignoring approval of another operation", "operationID", signal.OperationID)
+				return
+			}
+			approved = true
+		})
+		selector.AddFuture(poll, func(f workflow.Future) {})
+		selector.AddFuture(deadline, func(f workflow.Future) {
+			timedOut = true
+		})
+		selector.Select(ctx)
+		cancelPoll()
+
+		var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+			Id: params.MetaInstanceID,
+		}).Get(ctx, &getMetaInstanceResponse)
+		if err != nil {
+			return nil, err
+		}
+		if approved {
+			return getMetaInstanceResponse.Record, nil
+		}
+		for _, op := range getMetaInstanceResponse.Record.Operations {
+			if op.Id == params.OperationID && op.Status.State == mrdspb.OperationState_OperationState_APPROVED {
+				return getMetaInstanceResponse.Record, nil
+			}
+		}
+		if timedOut {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/controlplane/temporal/activities/scheduler"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+)
+
+// noopRuntime is a runtime whose instances start and stop immediately.
+type noopRuntime struct{}
+
+func (r *noopRuntime) Register(registry worker.Registry) {
+	registry.RegisterActivity(r.StartInstance)
+	registry.RegisterActivity(r.StopInstance)
+	registry.RegisterActivity(r.GetInstanceStatus)
+}
+
+func (r *noopRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return &runtime.RuntimeActivityResponse{}, nil
+}
+
+func (r *noopRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	return &runtime.RuntimeActivityResponse{}, nil
+}
+
+func (r *noopRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	return &runtime.RuntimeInstanceStatusResponse{
+		Status: &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true},
+	}, nil
+}
+
+func TestRunOperationApproval(t *testing.T) {
+	testCases := []struct {
+		name          string
+		signal        *signals.OperationApprovedSignal // Sent to the workflow after a minute when set.
+		approveLedger bool                             // Approves the operation in the ledger without a signal.
+		expectState   mrdspb.OperationState
+	}{
+		{
+			name:        "Signalled operation runs",
+			signal:      &signals.OperationApprovedSignal{OperationID: "operation-1"},
+			expectState: mrdspb.OperationState_OperationState_SUCCEEDED,
+		},
+		{
+			name:        "Operation is failed when it is not approved in time",
+			expectState: mrdspb.OperationState_OperationState_FAILED,
+		},
+		{
+			name:        "Approval of another operation is ignored",
+			signal:      &signals.OperationApprovedSignal{OperationID: "operation-2"},
+			expectState: mrdspb.OperationState_OperationState_FAILED,
+		},
+		{
+			name:          "Operation approved without a signal runs within a poll interval",
+			approveLedger: true,
+			expectState:   mrdspb.OperationState_OperationState_SUCCEEDED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts, err := testserver.NewTestServer()
+			require.NoError(t, err)
+			defer ts.Close()
+
+			ctx := context.Background()
+			metaInstancesClient := mrdspb.NewMetaInstancesClient(ts.Conn())
+			deploymentPlansClient := mrdspb.NewDeploymentPlansClient(ts.Conn())
+			nodesClient := mrdspb.NewNodesClient(ts.Conn())
+
+			nodeResp, err := nodesClient.Create(ctx, &mrdspb.CreateNodeRequest{
+				Name:                    "node-1",
+				UpdateDomain:            "ud-1",
+				TotalResources:          &mrdspb.Resources{Cores: 4, Memory: 4096},
+				SystemReservedResources: &mrdspb.Resources{},
+			})
+			require.NoError(t, err)
+			planResp, err := deploymentPlansClient.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+				Name:        "plan",
+				Namespace:   "test",
+				ServiceName: "plan",
+				Applications: []*mrdspb.Application{
+					{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+				},
+			})
+			require.NoError(t, err)
+			_, err = deploymentPlansClient.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+				Metadata:     planResp.Record.Metadata,
+				DeploymentId: "deployment-1",
+				PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+					{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+				},
+				InstanceCount: 1,
+			})
+			require.NoError(t, err)
+			createResp, err := metaInstancesClient.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+				Name:             "plan-0",
+				DeploymentPlanId: planResp.Record.Metadata.Id,
+				DeploymentId:     "deployment-1",
+			})
+			require.NoError(t, err)
+			updateResp, err := metaInstancesClient.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+				Metadata: createResp.Record.Metadata,
+				RuntimeInstance: &mrdspb.RuntimeInstance{
+					Id:       "plan-0-runtime",
+					NodeId:   nodeResp.Record.Metadata.Id,
+					IsActive: true,
+					Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+				},
+			})
+			require.NoError(t, err)
+			updateResp, err = metaInstancesClient.AddOperation(ctx, &mrdspb.AddOperationRequest{
+				Metadata: updateResp.Record.Metadata,
+				Operation: &mrdspb.Operation{
+					Id:       "operation-1",
+					Type:     mrdspb.OperationType_OperationType_STOP,
+					IntentId: "deployment-1",
+					Status:   &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_PENDING},
+				},
+			})
+			require.NoError(t, err)
+
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			metaInstanceActivities := mrds.NewMetaInstanceActivities(metaInstancesClient, env)
+			schedulerActivities := scheduler.NewSchedulerActivities(
+				metaInstancesClient,
+				nodesClient,
+				deploymentPlansClient,
+				mrdspb.NewComputeCapabilitiesClient(ts.Conn()),
+				scheduler.DefaultProfileName,
+				env,
+			)
+			runtimeActivities := &noopRuntime{}
+			runtimeActivities.Register(env)
+			w := NewOperationsWorkflow(metaInstanceActivities, schedulerActivities, runtimeActivities, time.Hour, env)
+
+			if tc.signal != nil {
+				env.RegisterDelayedCallback(func() {
+					env.SignalWorkflow(signals.OperationApprovedSignalName, *tc.signal)
+				}, time.Minute)
+			}
+			if tc.approveLedger {
+				env.RegisterDelayedCallback(func() {
+					getResp, err := metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: createResp.Record.Metadata.Id})
+					require.NoError(t, err)
+					_, err = metaInstancesClient.UpdateOperationStatus(ctx, &mrdspb.UpdateOperationStatusRequest{
+						Metadata:    getResp.Record.Metadata,
+						OperationId: "operation-1",
+						Status:      &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_APPROVED},
+					})
+					require.NoError(t, err)
+				}, time.Minute)
+			}
+
+			start := env.Now()
+			env.ExecuteWorkflow(w.RunOperation, RunOperationWorkflowParams{
+				MetaInstanceID: createResp.Record.Metadata.Id,
+				OperationID:    "operation-1",
+				OperationType:  mrdspb.OperationType_OperationType_STOP,
+			})
+			require.True(t, env.IsWorkflowCompleted())
+			if tc.expectState == mrdspb.OperationState_OperationState_SUCCEEDED {
+				require.NoError(t, env.GetWorkflowError())
+				// Approved operations run within a poll interval of their approval, at a minute.
+				require.LessOrEqual(t, env.Now().Sub(start), time.Minute+approvalPollInterval)
+			} else {
+				require.Error(t, env.GetWorkflowError())
+			}
+
+			getResp, err := metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: createResp.Record.Metadata.Id})
+			require.NoError(t, err)
+			require.Len(t, getResp.Record.Operations, 1)
+			require.Equal(t, tc.expectState, getResp.Record.Operations[0].Status.State)
+		})
+	}
+}
+
+func TestRunOperationReallocate(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	ctx := context.Background()
+	metaInstancesClient := mrdspb.NewMetaInstancesClient(ts.Conn())
+	deploymentPlansClient := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	nodesClient := mrdspb.NewNodesClient(ts.Conn())
+
+	var nodeIDs []string
+	for _, name := range []string{"node-1", "node-2"} {
+		nodeResp, err := nodesClient.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    name,
+			UpdateDomain:            "ud-1",
+			TotalResources:          &mrdspb.Resources{Cores: 4, Memory: 4096},
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(t, err)
+		metadata := nodeResp.Record.Metadata
+		for _, state := range []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED} {
+			updateNodeResp, err := nodesClient.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+				Metadata:  metadata,
+				Status:    &mrdspb.NodeStatus{State: state},
+				ClusterId: "cluster-1",
+			})
+			require.NoError(t, err)
+			metadata = updateNodeResp.Record.Metadata
+		}
+		nodeIDs = append(nodeIDs, nodeResp.Record.Metadata.Id)
+	}
+	planResp, err := deploymentPlansClient.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "plan",
+		Namespace:   "test",
+		ServiceName: "plan",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+	})
+	require.NoError(t, err)
+	addResp, err := deploymentPlansClient.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-1",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+	createResp, err := metaInstancesClient.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             "plan-0",
+		DeploymentPlanId: planResp.Record.Metadata.Id,
+		DeploymentId:     "deployment-1",
+	})
+	require.NoError(t, err)
+	updateResp, err := metaInstancesClient.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+		Metadata: createResp.Record.Metadata,
+		RuntimeInstance: &mrdspb.RuntimeInstance{
+			Id:       "plan-0-runtime",
+			NodeId:   nodeIDs[0],
+			IsActive: true,
+			Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+		},
+	})
+	require.NoError(t, err)
+	_, err = metaInstancesClient.AddOperation(ctx, &mrdspb.AddOperationRequest{
+		Metadata: updateResp.Record.Metadata,
+		Operation: &mrdspb.Operation{
+			Id:       "operation-1",
+			Type:     mrdspb.OperationType_OperationType_UPDATE,
+			IntentId: "deployment-1",
+			Status:   &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_PREPARING},
+		},
+	})
+	require.NoError(t, err)
+
+	// Grow the application beyond what is left on the node of the instance.
+	_, err = deploymentPlansClient.UpdateSpec(ctx, &mrdspb.UpdateDeploymentPlanSpecRequest{
+		Metadata: addResp.Record.Metadata,
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 4, Memory: 1024}},
+		},
+	})
+	require.NoError(t, err)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	metaInstanceActivities := mrds.NewMetaInstanceActivities(metaInstancesClient, env)
+	schedulerActivities := scheduler.NewSchedulerActivities(
+		metaInstancesClient,
+		nodesClient,
+		deploymentPlansClient,
+		mrdspb.NewComputeCapabilitiesClient(ts.Conn()),
+		scheduler.DefaultProfileName,
+		env,
+	)
+	runtimeActivities := &noopRuntime{}
+	runtimeActivities.Register(env)
+	w := NewOperationsWorkflow(metaInstanceActivities, schedulerActivities, runtimeActivities, time.Hour, env)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(signals.OperationApprovedSignalName, signals.OperationApprovedSignal{OperationID: "operation-1"})
+	}, time.Minute)
+	env.ExecuteWorkflow(w.RunOperation, RunOperationWorkflowParams{
+		MetaInstanceID: createResp.Record.Metadata.Id,
+		OperationID:    "operation-1",
+		OperationType:  mrdspb.OperationType_OperationType_UPDATE,
+		Reallocate:     true,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// The instance runs on the other node with the resources of the new spec.
+	getResp, err := metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: createResp.Record.Metadata.Id})
+	require.NoError(t, err)
+	require.Len(t, getResp.Record.RuntimeInstances, 1)
+	runtimeInstance := getResp.Record.RuntimeInstances[0]
+	require.True(t, runtimeInstance.IsActive)
+	require.Equal(t, nodeIDs[1], runtimeInstance.NodeId)
+	require.Equal(t, uint32(4), runtimeInstance.Resources.Cores)
+	require.Equal(t, mrdspb.OperationState_OperationState_SUCCEEDED, getResp.Record.Operations[0].Status.State)
+
+	// The resources of the previous runtime instance are released.
+	nodeResp, err := nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: nodeIDs[0]})
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), nodeResp.Record.RemainingResources.Cores)
+}
@@ -0,0 +1,112 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/signals"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// deploymentPausedPollInterval is how often the ledger is checked while a deployment is paused, so that a
+// deployment whose signal was lost is not paused forever.
+const deploymentPausedPollInterval = 5 * time.Minute
+
+// waitWhilePaused is called before the rollout moves on. While the deployment is PAUSED it waits for the
+// deployment to be resumed or aborted. Signals only wake the workflow up, the state is always read from the
+// ledger. It returns true when the deployment was aborted, that is when it is no longer IN_PROGRESS or PAUSED.
+func (d *DeploymentWorkflow) waitWhilePaused(ctx workflow.Context, params RunDeploymentWorkflowParams) (bool, error) {
+	log := workflow.GetLogger(ctx)
+
+	signalChan := workflow.GetSignalChannel(ctx, signals.DeploymentStateChangedSignalName)
+	var signal signals.DeploymentStateChangedSignal
+	for signalChan.ReceiveAsync(&signal) {
+	}
+
+	for {
+		var getDeploymentPlanResponse mrdspb.GetDeploymentPlanResponse
+		err := workflow.ExecuteActivity(ctx, d.deploymentPlanActivities.GetDeploymentPlanByID, &mrdspb.GetDeploymentPlanByIDRequest{
+			Id: params.DeploymentPlan.Metadata.Id,
+		}).Get(ctx, &getDeploymentPlanResponse)
+		if err != nil {
+			return false, err
+		}
+
+		state := mrdspb.DeploymentState_DeploymentState_UNKNOWN
+		for _, deployment := range getDeploymentPlanResponse.Record.Deployments {
+			if deployment.Id == params.Deployment.Id {
+				state = deployment.Status.State
+			}
+		}
+		switch state {
+		case mrdspb.DeploymentState_DeploymentState_IN_PROGRESS:
+			return false, nil
+		case mrdspb.DeploymentState_DeploymentState_PAUSED:
+		default:
+			log.Info("Deployment is aborted", "State", state)
+			return true, nil
+		}
+
+		log.Info("Deployment is paused")
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		timer := workflow.NewTimer(timerCtx, deploymentPausedPollInterval)
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signalChan, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, &signal)
+			log.Info("Deployment state changed", "State", signal.State)
+		})
+		selector.AddFuture(timer, func(f workflow.Future) {})
+		selector.Select(ctx)
+		cancelTimer()
+	}
+}
+
+// abortDeployment cleans up the operations of an aborted deployment which have not run. The operations are
+// FAILED and their instances return to the deployment they ran before. Instances created by the deployment
+// are removed, while instances marked for deletion stay marked and are deleted by the next deployment.
+func (d *DeploymentWorkflow) abortDeployment(
+	ctx workflow.Context,
+	params RunDeploymentWorkflowParams,
+	remaining []pendingOperation,
+	previousDeploymentIDs map[string]string,
+) error {
+	workflow.GetLogger(ctx).Info("Cleaning up the operations of the aborted deployment", "Operations", len(remaining))
+
+	for _, op := range remaining {
+		var updateOperationStatusResponse mrds.UdpateOperationStatusResponse
+		err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateOperationStatus, mrds.UpdateOperationStatusRequest{
+			MetaInstanceID: op.instance.Metadata.Id,
+			OperationID:    op.operation.Id,
+			State:          mrdspb.OperationState_OperationState_FAILED,
+			Message:        fmt.Sprintf("Cancelled by the abort of deployment %s", params.Deployment.Id),
+		}).Get(ctx, &updateOperationStatusResponse)
+		if err != nil {
+			return err
+		}
+
+		if op.operation.Type == mrdspb.OperationType_OperationType_CREATE {
+			var deleteMetaInstanceResponse mrds.DeleteMetaInstanceResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.DeleteMetaInstance, &mrds.DeleteMetaInstanceRequest{
+				MetaInstanceID: op.instance.Metadata.Id,
+			}).Get(ctx, &deleteMetaInstanceResponse)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if previousDeploymentID := previousDeploymentIDs[op.instance.Metadata.Id]; previousDeploymentID != "" {
+			var updateDeploymentIDResponse mrds.UpdateDeploymentIDResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.UpdateDeploymentID, &mrds.UpdateDeploymentIDRequest{
+				MetaInstanceID: op.instance.Metadata.Id,
+				DeploymentID:   previousDeploymentID,
+			}).Get(ctx, &updateDeploymentIDResponse)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package workflows
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// instanceReadyTimeout is how long a deployment waits for the instances started by its operations to be ready.
+	instanceReadyTimeout = 10 * time.Minute
+	// instanceReadyPollInterval is how often the instances are checked while waiting.
+	instanceReadyPollInterval = 10 * time.Second
+)
+
+// isReady returns true if the runtime instance is RUNNING and passes the readiness probes of its applications.
+func isReady(runtimeInstance *mrdspb.RuntimeInstance) bool {
+	return runtimeInstance.Status.GetState() == mrdspb.RuntimeInstanceState_RuntimeState_RUNNING && runtimeInstance.Status.GetReady()
+}
+
+// isActiveReady returns true if the active runtime instance of the meta instance is ready.
+func isActiveReady(instance *mrdspb.MetaInstance) bool {
+	for _, runtimeInstance := range instance.RuntimeInstances {
+		if runtimeInstance.IsActive {
+			return isReady(runtimeInstance)
+		}
+	}
+	return false
+}
+
+// startsActiveInstance returns true if the operation leaves the meta instance with a newly started active
+// runtime instance, which the deployment waits for to be ready.
+func startsActiveInstance(op pendingOperation) bool {
+	switch op.operation.Type {
+	case mrdspb.OperationType_OperationType_CREATE,
+		mrdspb.OperationType_OperationType_RESTART,
+		mrdspb.OperationType_OperationType_RELOCATE:
+		return true
+	case mrdspb.OperationType_OperationType_UPDATE:
+		return !op.passiveUpdate
+	}
+	return false
+}
+
+// waitForReady waits until the active runtime instance of every operation which started one is ready. It
+// returns an error naming the instances which are not ready within the timeout.
+func (d *DeploymentWorkflow) waitForReady(ctx workflow.Context, operations []pendingOperation) error {
+	var started []pendingOperation
+	for _, op := range operations {
+		if startsActiveInstance(op) {
+			started = append(started, op)
+		}
+	}
+	if len(started) == 0 {
+		return nil
+	}
+
+	deadline := workflow.Now(ctx).Add(instanceReadyTimeout)
+	for {
+		var notReady []string
+		for _, op := range started {
+			var getMetaInstanceResponse mrdspb.GetMetaInstanceResponse
+			err := workflow.ExecuteActivity(ctx, d.metaInstanceActivities.GetMetaInstanceByID, &mrdspb.GetMetaInstanceByIDRequest{
+				Id: op.instance.Metadata.Id,
+			}).Get(ctx, &getMetaInstanceResponse)
+			if err != nil {
+				return err
+			}
+			if !isActiveReady(getMetaInstanceResponse.Record) {
+				notReady = append(notReady, getMetaInstanceResponse.Record.Name)
+			}
+		}
+		if len(notReady) == 0 {
+			return nil
+		}
+		if !workflow.Now(ctx).Before(deadline) {
+			return fmt.Errorf("%d of %d instances are not ready after %s: %s", len(notReady), len(started), instanceReadyTimeout, strings.Join(notReady, ", "))
+		}
+
+		workflow.GetLogger(ctx).Info("Waiting for instances to be ready", "NotReady", len(notReady))
+		err := workflow.Sleep(ctx, instanceReadyPollInterval)
+		if err != nil {
+			return err
+		}
+	}
+}
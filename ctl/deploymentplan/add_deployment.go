@@ -2,7 +2,9 @@ package deploymentplan
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/msanath/mrds/ctl/deploymentplan/printer"
 	"github.com/msanath/mrds/gen/api/mrdspb"
@@ -24,6 +26,24 @@ type deploymentCreateRequest struct {
 	DeploymentPlanName string               `yaml:"deployment_plan_name"`
 	PayloadCoordinates []payloadCoordinates `yaml:"payload_coordinates"`
 	InstanceCount      uint32               `yaml:"instance_count"`
+	RolloutStrategy    *rolloutStrategy     `yaml:"rollout_strategy"`
+	Canary             *canary              `yaml:"canary"`
+}
+
+type rolloutStrategy struct {
+	Type           string `yaml:"type"` // update_domain, rolling or blue_green
+	MaxUnavailable uint32 `yaml:"max_unavailable"`
+	MaxSurge       uint32 `yaml:"max_surge"`
+	BatchSize      uint32 `yaml:"batch_size"`
+	PauseSeconds   uint32 `yaml:"pause_seconds"`
+	HoldSeconds    uint32 `yaml:"hold_seconds"`
+}
+
+type canary struct {
+	InstanceCount         uint32 `yaml:"instance_count"`
+	Percentage            uint32 `yaml:"percentage"`
+	BakeSeconds           uint32 `yaml:"bake_seconds"`
+	MaxUnhealthyInstances uint32 `yaml:"max_unhealthy_instances"`
 }
 
 type payloadCoordinates struct {
@@ -85,11 +105,39 @@ func (o *addDeploymenOptions) Run(ctx context.Context) error {
 		})
 	}
 
+	var rolloutStrategyProto *mrdspb.RolloutStrategy
+	if req.RolloutStrategy != nil {
+		strategyType, ok := mrdspb.RolloutStrategyType_value["RolloutStrategyType_"+strings.ToUpper(req.RolloutStrategy.Type)]
+		if !ok {
+			return fmt.Errorf("unknown rollout strategy type %q", req.RolloutStrategy.Type)
+		}
+		rolloutStrategyProto = &mrdspb.RolloutStrategy{
+			Type:           mrdspb.RolloutStrategyType(strategyType),
+			MaxUnavailable: req.RolloutStrategy.MaxUnavailable,
+			MaxSurge:       req.RolloutStrategy.MaxSurge,
+			BatchSize:      req.RolloutStrategy.BatchSize,
+			PauseSeconds:   req.RolloutStrategy.PauseSeconds,
+			HoldSeconds:    req.RolloutStrategy.HoldSeconds,
+		}
+	}
+
+	var canaryProto *mrdspb.Canary
+	if req.Canary != nil {
+		canaryProto = &mrdspb.Canary{
+			InstanceCount:         req.Canary.InstanceCount,
+			Percentage:            req.Canary.Percentage,
+			BakeSeconds:           req.Canary.BakeSeconds,
+			MaxUnhealthyInstances: req.Canary.MaxUnhealthyInstances,
+		}
+	}
+
 	updateResp, err := o.deploymentPlanClient.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
 		Metadata:           getResp.Record.GetMetadata(),
 		DeploymentId:       req.DeploymentID,
 		PayloadCoordinates: payloadCoordinatesProto,
 		InstanceCount:      req.InstanceCount,
+		RolloutStrategy:    rolloutStrategyProto,
+		Canary:             canaryProto,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,121 @@
+package deploymentplan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/msanath/mrds/ctl/deploymentplan/printer"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// deploymentControlAction pauses, resumes or aborts a deployment.
+type deploymentControlAction string
+
+const (
+	deploymentControlActionPause  deploymentControlAction = "pause"
+	deploymentControlActionResume deploymentControlAction = "resume"
+	deploymentControlActionAbort  deploymentControlAction = "abort"
+)
+
+type controlDeploymentOptions struct {
+	action             deploymentControlAction
+	deploymentPlanName string
+	deploymentID       string
+
+	deploymentPlanClient mrdspb.DeploymentPlansClient
+	printer              *printer.Printer
+}
+
+func newPauseDeploymentCmd() *cobra.Command {
+	return newControlDeploymentCmd(deploymentControlActionPause, "Pause an in-progress deployment before its next batch")
+}
+
+func newResumeDeploymentCmd() *cobra.Command {
+	return newControlDeploymentCmd(deploymentControlActionResume, "Resume a paused deployment")
+}
+
+func newAbortDeploymentCmd() *cobra.Command {
+	return newControlDeploymentCmd(deploymentControlActionAbort, "Abort a deployment and clean up the operations which have not run")
+}
+
+func newControlDeploymentCmd(action deploymentControlAction, short string) *cobra.Command {
+	o := controlDeploymentOptions{action: action}
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s-deployment", action),
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			conn, err := grpc.Dial("localhost:12345", grpc.WithTransportCredentials(
+				insecure.NewCredentials(),
+			))
+			if err != nil {
+				return err
+			}
+
+			o.deploymentPlanClient = mrdspb.NewDeploymentPlansClient(conn)
+			o.printer = printer.NewPrinter()
+			o.deploymentPlanName = args[0]
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.deploymentID, "deployment-id", "D", "", "ID of the deployment")
+	cmd.MarkFlagRequired("deployment-id")
+	return cmd
+}
+
+func (o *controlDeploymentOptions) Run(ctx context.Context) error {
+	// Get deployment by name
+	getResp, err := o.deploymentPlanClient.GetByName(ctx, &mrdspb.GetDeploymentPlanByNameRequest{
+		Name: o.deploymentPlanName,
+	})
+	if err != nil {
+		return err
+	}
+
+	plan := getResp.Record
+	foundDeployment := false
+	for _, d := range plan.Deployments {
+		if d.Id == o.deploymentID {
+			foundDeployment = true
+		}
+	}
+	if !foundDeployment {
+		o.printer.PrintWarning("Deployment not found")
+		return nil
+	}
+	if o.action == deploymentControlActionAbort &&
+		!o.printer.SeekConfirmation("Are you sure you want to abort the deployment?") {
+		o.printer.PrintWarning("Operation canceled")
+		return nil
+	}
+
+	var updateResp *mrdspb.UpdateDeploymentPlanResponse
+	switch o.action {
+	case deploymentControlActionPause:
+		updateResp, err = o.deploymentPlanClient.PauseDeployment(ctx, &mrdspb.PauseDeploymentRequest{
+			Metadata:     plan.Metadata,
+			DeploymentId: o.deploymentID,
+		})
+	case deploymentControlActionResume:
+		updateResp, err = o.deploymentPlanClient.ResumeDeployment(ctx, &mrdspb.ResumeDeploymentRequest{
+			Metadata:     plan.Metadata,
+			DeploymentId: o.deploymentID,
+		})
+	case deploymentControlActionAbort:
+		updateResp, err = o.deploymentPlanClient.AbortDeployment(ctx, &mrdspb.AbortDeploymentRequest{
+			Metadata:     plan.Metadata,
+			DeploymentId: o.deploymentID,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	o.printer.PrintSuccess(fmt.Sprintf("Deployment %s requested", o.action))
+	o.printer.PrintDisplayDeploymentPlan(convertGRPCDeploymentPlanToDisplayDeploymentPlan(updateResp.Record))
+	return nil
+}
@@ -2,7 +2,9 @@ package deploymentplan
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/msanath/mrds/ctl/deploymentplan/printer"
 	"github.com/msanath/mrds/ctl/deploymentplan/types"
@@ -26,6 +28,7 @@ type planCreateRequest struct {
 	ServiceName                 string                     `yaml:"serviceName"`
 	MatchingComputeCapabilities []matchingComputeCapabilty `yaml:"matchingComputeCapabilities"`
 	Applications                []application              `yaml:"applications"`
+	SchedulerProfile            string                     `yaml:"schedulerProfile"`
 }
 
 type matchingComputeCapabilty struct {
@@ -39,6 +42,8 @@ type application struct {
 	Resources         ApplicationResources          `yaml:"resources"`
 	Ports             []ApplicationPort             `yaml:"ports"`
 	PersistentVolumes []ApplicationPersistentVolume `yaml:"persistentVolumes"`
+	LivenessProbe     *ApplicationProbe             `yaml:"livenessProbe"`
+	ReadinessProbe    *ApplicationProbe             `yaml:"readinessProbe"`
 }
 
 type ApplicationResources struct {
@@ -57,6 +62,18 @@ type ApplicationPersistentVolume struct {
 	MountPath    string `yaml:"mountPath"`
 }
 
+// ApplicationProbe is a health check of an application. Type is one of http, tcp or exec.
+type ApplicationProbe struct {
+	Type                string   `yaml:"type"`
+	Path                string   `yaml:"path"`
+	Port                uint32   `yaml:"port"`
+	Command             []string `yaml:"command"`
+	InitialDelaySeconds uint32   `yaml:"initialDelaySeconds"`
+	PeriodSeconds       uint32   `yaml:"periodSeconds"`
+	TimeoutSeconds      uint32   `yaml:"timeoutSeconds"`
+	FailureThreshold    uint32   `yaml:"failureThreshold"`
+}
+
 type deploymentPlanList struct {
 	Plans []planCreateRequest `yaml:"plans"`
 }
@@ -100,51 +117,17 @@ func (o *createDeploymentPlanOptions) Run(ctx context.Context) error {
 
 	createdPlans := make([]*mrdspb.DeploymentPlanRecord, 0, len(req.Plans))
 	for _, plan := range req.Plans {
-		computeCapabilities := make([]*mrdspb.MatchingComputeCapability, 0, len(plan.MatchingComputeCapabilities))
-		for _, cc := range plan.MatchingComputeCapabilities {
-			computeCapabilities = append(computeCapabilities, &mrdspb.MatchingComputeCapability{
-				CapabilityType:  cc.CapabilityType,
-				Comparator:      mrdspb.Comparator(mrdspb.Comparator_value[string(cc.Comparator)]),
-				CapabilityNames: cc.CapabilityNames,
-			})
-		}
-
-		applications := make([]*mrdspb.Application, 0, len(plan.Applications))
-		for _, app := range plan.Applications {
-			ports := make([]*mrdspb.ApplicationPort, 0, len(app.Ports))
-			for _, p := range app.Ports {
-				ports = append(ports, &mrdspb.ApplicationPort{
-					Protocol: p.Protocol,
-					Port:     p.Port,
-				})
-			}
-
-			persistentVolumes := make([]*mrdspb.ApplicationPersistentVolume, 0, len(app.PersistentVolumes))
-			for _, pv := range app.PersistentVolumes {
-				persistentVolumes = append(persistentVolumes, &mrdspb.ApplicationPersistentVolume{
-					StorageClass: pv.StorageClass,
-					Capacity:     pv.Capacity,
-					MountPath:    pv.MountPath,
-				})
-			}
-
-			applications = append(applications, &mrdspb.Application{
-				PayloadName: app.PayloadName,
-				Resources: &mrdspb.ApplicationResources{
-					Cores:  app.Resources.Cores,
-					Memory: app.Resources.Memory,
-				},
-				Ports:             ports,
-				PersistentVolumes: persistentVolumes,
-			})
+		applications, err := applicationsToGRPC(plan.Applications)
+		if err != nil {
+			return err
 		}
-
 		resp, err := o.deploymentPlansClient.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
 			Name:                        plan.Name,
 			Namespace:                   plan.Namespace,
 			ServiceName:                 plan.ServiceName,
-			MatchingComputeCapabilities: computeCapabilities,
+			MatchingComputeCapabilities: matchingComputeCapabilitiesToGRPC(plan.MatchingComputeCapabilities),
 			Applications:                applications,
+			SchedulerProfile:            plan.SchedulerProfile,
 		})
 		createdPlans = append(createdPlans, resp.Record)
 
@@ -162,3 +145,79 @@ func (o *createDeploymentPlanOptions) Run(ctx context.Context) error {
 
 	return nil
 }
+
+func matchingComputeCapabilitiesToGRPC(capabilities []matchingComputeCapabilty) []*mrdspb.MatchingComputeCapability {
+	computeCapabilities := make([]*mrdspb.MatchingComputeCapability, 0, len(capabilities))
+	for _, cc := range capabilities {
+		computeCapabilities = append(computeCapabilities, &mrdspb.MatchingComputeCapability{
+			CapabilityType:  cc.CapabilityType,
+			Comparator:      mrdspb.Comparator(mrdspb.Comparator_value[string(cc.Comparator)]),
+			CapabilityNames: cc.CapabilityNames,
+		})
+	}
+	return computeCapabilities
+}
+
+func applicationsToGRPC(apps []application) ([]*mrdspb.Application, error) {
+	applications := make([]*mrdspb.Application, 0, len(apps))
+	for _, app := range apps {
+		ports := make([]*mrdspb.ApplicationPort, 0, len(app.Ports))
+		for _, p := range app.Ports {
+			ports = append(ports, &mrdspb.ApplicationPort{
+				Protocol: p.Protocol,
+				Port:     p.Port,
+			})
+		}
+
+		persistentVolumes := make([]*mrdspb.ApplicationPersistentVolume, 0, len(app.PersistentVolumes))
+		for _, pv := range app.PersistentVolumes {
+			persistentVolumes = append(persistentVolumes, &mrdspb.ApplicationPersistentVolume{
+				StorageClass: pv.StorageClass,
+				Capacity:     pv.Capacity,
+				MountPath:    pv.MountPath,
+			})
+		}
+
+		livenessProbe, err := applicationProbeToGRPC(app.LivenessProbe)
+		if err != nil {
+			return nil, err
+		}
+		readinessProbe, err := applicationProbeToGRPC(app.ReadinessProbe)
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, &mrdspb.Application{
+			PayloadName: app.PayloadName,
+			Resources: &mrdspb.ApplicationResources{
+				Cores:  app.Resources.Cores,
+				Memory: app.Resources.Memory,
+			},
+			Ports:             ports,
+			PersistentVolumes: persistentVolumes,
+			LivenessProbe:     livenessProbe,
+			ReadinessProbe:    readinessProbe,
+		})
+	}
+	return applications, nil
+}
+
+func applicationProbeToGRPC(probe *ApplicationProbe) (*mrdspb.Probe, error) {
+	if probe == nil {
+		return nil, nil
+	}
+	probeType, ok := mrdspb.ProbeType_value["ProbeType_"+strings.ToUpper(probe.Type)]
+	if !ok {
+		return nil, fmt.Errorf("unknown probe type %q", probe.Type)
+	}
+	return &mrdspb.Probe{
+		Type:                mrdspb.ProbeType(probeType),
+		Path:                probe.Path,
+		Port:                probe.Port,
+		Command:             probe.Command,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}, nil
+}
@@ -3,6 +3,7 @@ package getter
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/msanath/mrds/ctl/deploymentplan/types"
 	"github.com/msanath/mrds/ctl/metainstance/getter"
@@ -93,6 +94,9 @@ func (g *Getter) ConvertGRPCDeploymentPlanToDisplayDeploymentPlan(ctx context.Co
 				State:   deployment.GetStatus().GetState().String(),
 				Message: deployment.GetStatus().GetMessage(),
 			},
+			RolloutStrategy: displayRolloutStrategy(deployment.GetRolloutStrategy()),
+			RolloutProgress: fmt.Sprintf("%d/%d", deployment.GetRolloutProgress().GetCompletedBatches(), deployment.GetRolloutProgress().GetTotalBatches()),
+			Canary:          displayCanary(deployment.GetCanary()),
 		}
 
 		// Convert PayloadCoordinates
@@ -206,3 +210,28 @@ func (g *Getter) ConvertGRPCDeploymentPlanToDisplayDeploymentPlan(ctx context.Co
 	displayDeploymentPlan.InstanceSummary.MetaInstances = displayMetaInstances
 	return displayDeploymentPlan, nil
 }
+
+// displayRolloutStrategy returns a one line summary of the rollout strategy of a deployment.
+func displayRolloutStrategy(strategy *mrdspb.RolloutStrategy) string {
+	switch strategy.GetType() {
+	case mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING:
+	case mrdspb.RolloutStrategyType_RolloutStrategyType_BLUE_GREEN:
+		return fmt.Sprintf("%s (hold: %ds)", strategy.GetType().String(), strategy.GetHoldSeconds())
+	default:
+		return strategy.GetType().String()
+	}
+	return fmt.Sprintf("%s (maxUnavailable: %d, maxSurge: %d, batchSize: %d, pause: %ds)",
+		strategy.GetType().String(), strategy.GetMaxUnavailable(), strategy.GetMaxSurge(), strategy.GetBatchSize(), strategy.GetPauseSeconds())
+}
+
+// displayCanary returns a one line summary of the canary phase of a deployment.
+func displayCanary(canary *mrdspb.Canary) string {
+	size := fmt.Sprintf("%d instances", canary.GetInstanceCount())
+	if canary.GetInstanceCount() == 0 {
+		if canary.GetPercentage() == 0 {
+			return ""
+		}
+		size = fmt.Sprintf("%d%%", canary.GetPercentage())
+	}
+	return fmt.Sprintf("%s (bake: %ds, maxUnhealthy: %d)", size, canary.GetBakeSeconds(), canary.GetMaxUnhealthyInstances())
+}
@@ -12,6 +12,9 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// listPageSize is the number of deployment plans fetched by each List request.
+const listPageSize = 100
+
 type deploymentPlanListOptions struct {
 	deploymentPlanClient mrdspb.DeploymentPlansClient
 	printer              *printer.Printer
@@ -39,13 +42,25 @@ func newDeploymentPlanListCmd() *cobra.Command {
 }
 
 func (o *deploymentPlanListOptions) Run(ctx context.Context) error {
-	resp, err := o.deploymentPlanClient.List(ctx, &mrdspb.ListDeploymentPlanRequest{})
-	if err != nil {
-		return err
+	var records []*mrdspb.DeploymentPlanRecord
+	pageToken := ""
+	for {
+		resp, err := o.deploymentPlanClient.List(ctx, &mrdspb.ListDeploymentPlanRequest{
+			Filters:   &mrdspb.DeploymentPlanListFilters{Limit: listPageSize},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		records = append(records, resp.Records...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
 	}
 
-	displayDeploymentPlans := make([]types.DisplayDeploymentPlan, 0, len(resp.Records))
-	for _, d := range resp.Records {
+	displayDeploymentPlans := make([]types.DisplayDeploymentPlan, 0, len(records))
+	for _, d := range records {
 		displayDeploymentPlans = append(displayDeploymentPlans, convertGRPCDeploymentPlanToDisplayDeploymentPlan(d))
 	}
 
@@ -2,6 +2,7 @@ package deploymentplan
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/msanath/mrds/ctl/deploymentplan/types"
 	"github.com/msanath/mrds/gen/api/mrdspb"
@@ -16,8 +17,13 @@ func NewDeploymentPlanCmd() *cobra.Command {
 	cmd.AddCommand(newDeploymentPlanCreateCmd())
 	cmd.AddCommand(newDeploymentPlanListCmd())
 	cmd.AddCommand(newDeploymentPlanShowCmd())
+	cmd.AddCommand(newUpdateSpecCmd())
 	cmd.AddCommand(newAddDeploymentCmd())
 	cmd.AddCommand(newCancelDeploymentCmd())
+	cmd.AddCommand(newPauseDeploymentCmd())
+	cmd.AddCommand(newResumeDeploymentCmd())
+	cmd.AddCommand(newAbortDeploymentCmd())
+	cmd.AddCommand(newRollbackCmd())
 	cmd.AddCommand(newApproveOperationCmd())
 	cmd.AddCommand(newOperateOption())
 
@@ -87,6 +93,9 @@ func convertGRPCDeploymentPlanToDisplayDeploymentPlan(d *mrdspb.DeploymentPlanRe
 				State:   deployment.GetStatus().GetState().String(),
 				Message: deployment.GetStatus().GetMessage(),
 			},
+			RolloutStrategy: displayRolloutStrategy(deployment.GetRolloutStrategy()),
+			RolloutProgress: fmt.Sprintf("%d/%d", deployment.GetRolloutProgress().GetCompletedBatches(), deployment.GetRolloutProgress().GetTotalBatches()),
+			Canary:          displayCanary(deployment.GetCanary()),
 		}
 
 		// Convert PayloadCoordinates
@@ -103,3 +112,28 @@ func convertGRPCDeploymentPlanToDisplayDeploymentPlan(d *mrdspb.DeploymentPlanRe
 
 	return displayDeploymentPlan
 }
+
+// displayRolloutStrategy returns a one line summary of the rollout strategy of a deployment.
+func displayRolloutStrategy(strategy *mrdspb.RolloutStrategy) string {
+	switch strategy.GetType() {
+	case mrdspb.RolloutStrategyType_RolloutStrategyType_ROLLING:
+	case mrdspb.RolloutStrategyType_RolloutStrategyType_BLUE_GREEN:
+		return fmt.Sprintf("%s (hold: %ds)", strategy.GetType().String(), strategy.GetHoldSeconds())
+	default:
+		return strategy.GetType().String()
+	}
+	return fmt.Sprintf("%s (maxUnavailable: %d, maxSurge: %d, batchSize: %d, pause: %ds)",
+		strategy.GetType().String(), strategy.GetMaxUnavailable(), strategy.GetMaxSurge(), strategy.GetBatchSize(), strategy.GetPauseSeconds())
+}
+
+// displayCanary returns a one line summary of the canary phase of a deployment.
+func displayCanary(canary *mrdspb.Canary) string {
+	size := fmt.Sprintf("%d instances", canary.GetInstanceCount())
+	if canary.GetInstanceCount() == 0 {
+		if canary.GetPercentage() == 0 {
+			return ""
+		}
+		size = fmt.Sprintf("%d%%", canary.GetPercentage())
+	}
+	return fmt.Sprintf("%s (bake: %ds, maxUnhealthy: %d)", size, canary.GetBakeSeconds(), canary.GetMaxUnhealthyInstances())
+}
@@ -93,7 +93,7 @@ func (p *Printer) PrintDisplayDeploymentPlan(plan types.DisplayDeploymentPlan) {
 	if len(plan.Deployments) == 0 {
 		p.PrintWarning("No deployments found")
 	} else {
-		tableHeaders := []string{"Deployment ID", "Instance Count", "State", "Message", "Payload Information"}
+		tableHeaders := []string{"Deployment ID", "Instance Count", "State", "Message", "Rollout Strategy", "Rollout Progress", "Canary", "Payload Information"}
 		rows := make([][]string, 0)
 		for _, deployment := range plan.Deployments {
 
@@ -108,6 +108,9 @@ func (p *Printer) PrintDisplayDeploymentPlan(plan types.DisplayDeploymentPlan) {
 					deployment.GetInstanceCount().Value(),
 					deployment.Status.GetState().Value(),
 					deployment.Status.GetMessage().Value(),
+					deployment.GetRolloutStrategy().Value(),
+					deployment.GetRolloutProgress().Value(),
+					deployment.GetCanary().Value(),
 					strings.Join(payloadInfo, "\n"),
 				},
 			)
@@ -0,0 +1,81 @@
+package deploymentplan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/msanath/mrds/ctl/deploymentplan/printer"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type rollbackOptions struct {
+	deploymentPlanName string
+	toDeploymentID     string
+	deploymentID       string
+
+	deploymentPlanClient mrdspb.DeploymentPlansClient
+	printer              *printer.Printer
+}
+
+func newRollbackCmd() *cobra.Command {
+	o := rollbackOptions{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back to an earlier deployment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			conn, err := grpc.Dial("localhost:12345", grpc.WithTransportCredentials(
+				insecure.NewCredentials(),
+			))
+			if err != nil {
+				return err
+			}
+
+			o.deploymentPlanClient = mrdspb.NewDeploymentPlansClient(conn)
+			o.printer = printer.NewPrinter()
+			o.deploymentPlanName = args[0]
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.toDeploymentID, "to", "", "ID of the deployment to roll back to")
+	cmd.Flags().StringVarP(&o.deploymentID, "deployment-id", "D", "", "ID of the deployment added by the rollback. Generated when not set")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func (o *rollbackOptions) Run(ctx context.Context) error {
+	// Get deployment by name
+	getResp, err := o.deploymentPlanClient.GetByName(ctx, &mrdspb.GetDeploymentPlanByNameRequest{
+		Name: o.deploymentPlanName,
+	})
+	if err != nil {
+		return err
+	}
+
+	plan := getResp.Record
+	if o.deploymentID == "" {
+		o.deploymentID = fmt.Sprintf("rollback-%s", uuid.New().String())
+	}
+	if !o.printer.SeekConfirmation(fmt.Sprintf("Are you sure you want to roll back to deployment %s?", o.toDeploymentID)) {
+		o.printer.PrintWarning("Operation canceled")
+		return nil
+	}
+
+	rollbackResp, err := o.deploymentPlanClient.Rollback(ctx, &mrdspb.RollbackDeploymentRequest{
+		Metadata:       plan.Metadata,
+		DeploymentId:   o.deploymentID,
+		ToDeploymentId: o.toDeploymentID,
+	})
+	if err != nil {
+		return err
+	}
+	o.printer.PrintSuccess(fmt.Sprintf("Deployment %s added to roll back to deployment %s", o.deploymentID, o.toDeploymentID))
+	o.printer.PrintDisplayDeploymentPlan(convertGRPCDeploymentPlanToDisplayDeploymentPlan(rollbackResp.Record))
+	return nil
+}
@@ -68,6 +68,9 @@ type DisplayDeployment struct {
 	Status             DisplayDeploymentStatus     `json:"status,omitempty"`
 	PayloadCoordinates []DisplayPayloadCoordinates `json:"payload_coordinates,omitempty"`
 	InstanceCount      int                         `json:"instance_count,omitempty" displayName:"Instance Count"`
+	RolloutStrategy    string                      `json:"rollout_strategy,omitempty" displayName:"Rollout Strategy"`
+	RolloutProgress    string                      `json:"rollout_progress,omitempty" displayName:"Rollout Progress"`
+	Canary             string                      `json:"canary,omitempty" displayName:"Canary"`
 }
 
 // DisplayDeploymentStatus represents the display version of DeploymentStatus
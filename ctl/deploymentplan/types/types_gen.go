@@ -677,3 +677,36 @@ func (n *DisplayPayloadCoordinates) GetCoordinates() printer.DisplayField {
 		},
 	}
 }
+
+func (n *DisplayDeployment) GetRolloutStrategy() printer.DisplayField {
+	return printer.DisplayField{
+		DisplayName: "Rollout Strategy",
+		ColumnTag:   "",
+		Value: func() string {
+			str := n.RolloutStrategy
+			return str
+		},
+	}
+}
+
+func (n *DisplayDeployment) GetRolloutProgress() printer.DisplayField {
+	return printer.DisplayField{
+		DisplayName: "Rollout Progress",
+		ColumnTag:   "",
+		Value: func() string {
+			str := n.RolloutProgress
+			return str
+		},
+	}
+}
+
+func (n *DisplayDeployment) GetCanary() printer.DisplayField {
+	return printer.DisplayField{
+		DisplayName: "Canary",
+		ColumnTag:   "",
+		Value: func() string {
+			str := n.Canary
+			return str
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package deploymentplan
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/msanath/mrds/ctl/deploymentplan/printer"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v3"
+)
+
+type updateSpecOptions struct {
+	deploymentPlanName string
+	manifestFilePath   string
+
+	deploymentPlanClient mrdspb.DeploymentPlansClient
+	printer              *printer.Printer
+}
+
+// planSpec is the manifest of a spec update. It has the same format as the spec of a plan in a create manifest.
+type planSpec struct {
+	MatchingComputeCapabilities []matchingComputeCapabilty `yaml:"matchingComputeCapabilities"`
+	Applications                []application              `yaml:"applications"`
+}
+
+func newUpdateSpecCmd() *cobra.Command {
+	o := updateSpecOptions{}
+	cmd := &cobra.Command{
+		Use:   "update-spec",
+		Short: "Update the applications and matching compute capabilities of a deployment plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			conn, err := grpc.Dial("localhost:12345", grpc.WithTransportCredentials(
+				insecure.NewCredentials(),
+			))
+			if err != nil {
+				return err
+			}
+
+			o.deploymentPlanClient = mrdspb.NewDeploymentPlansClient(conn)
+			o.printer = printer.NewPrinter()
+			o.deploymentPlanName = args[0]
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.manifestFilePath, "manifest", "m", "", "Path to the spec manifest file")
+	cmd.MarkFlagRequired("manifest")
+	return cmd
+}
+
+func (o *updateSpecOptions) Run(ctx context.Context) error {
+	yamlFile, err := os.Open(o.manifestFilePath)
+	if err != nil {
+		return err
+	}
+
+	spec := &planSpec{}
+	err = yaml.NewDecoder(yamlFile).Decode(spec)
+	if err != nil {
+		return err
+	}
+
+	applications, err := applicationsToGRPC(spec.Applications)
+	if err != nil {
+		return err
+	}
+
+	// Get deployment by name
+	getResp, err := o.deploymentPlanClient.GetByName(ctx, &mrdspb.GetDeploymentPlanByNameRequest{
+		Name: o.deploymentPlanName,
+	})
+	if err != nil {
+		return err
+	}
+
+	plan := getResp.Record
+	if !o.printer.SeekConfirmation(fmt.Sprintf("Are you sure you want to update the spec of deployment plan %s?", plan.Name)) {
+		o.printer.PrintWarning("Operation canceled")
+		return nil
+	}
+
+	updateResp, err := o.deploymentPlanClient.UpdateSpec(ctx, &mrdspb.UpdateDeploymentPlanSpecRequest{
+		Metadata:                    plan.Metadata,
+		MatchingComputeCapabilities: matchingComputeCapabilitiesToGRPC(spec.MatchingComputeCapabilities),
+		Applications:                applications,
+	})
+	if err != nil {
+		return err
+	}
+	o.printer.PrintSuccess(fmt.Sprintf("Spec of deployment plan %s updated. The next deployment rolls the instances onto it", plan.Name))
+	o.printer.PrintDisplayDeploymentPlan(convertGRPCDeploymentPlanToDisplayDeploymentPlan(updateResp.Record))
+	return nil
+}
@@ -69,6 +69,7 @@ func (g *Getter) GetDisplayMetaInstance(ctx context.Context, m *mrdspb.MetaInsta
 			Status: types.DisplayRuntimeInstanceStatus{
 				State:   instance.GetStatus().GetState().String(),
 				Message: instance.GetStatus().GetMessage(),
+				Ready:   instance.GetStatus().GetReady(),
 			},
 		})
 	}
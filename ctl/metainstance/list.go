@@ -13,6 +13,9 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// listPageSize is the number of meta instances fetched by each List request.
+const listPageSize = 100
+
 type metaInstanceListOptions struct {
 	client  mrdspb.MetaInstancesClient
 	printer *printer.Printer
@@ -43,14 +46,26 @@ func newMetaInstanceListCmd() *cobra.Command {
 
 func (o *metaInstanceListOptions) Run(ctx context.Context) error {
 	// Call gRPC service to list meta instances
-	resp, err := o.client.List(ctx, &mrdspb.ListMetaInstanceRequest{})
-	if err != nil {
-		return fmt.Errorf("failed to list meta instances: %w", err)
+	var records []*mrdspb.MetaInstance
+	pageToken := ""
+	for {
+		resp, err := o.client.List(ctx, &mrdspb.ListMetaInstanceRequest{
+			Limit:     listPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list meta instances: %w", err)
+		}
+		records = append(records, resp.Records...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
 	}
 
 	// Convert gRPC MetaInstance records to display format
-	displayMetaInstances := make([]types.DisplayMetaInstance, 0, len(resp.Records))
-	for _, record := range resp.Records {
+	displayMetaInstances := make([]types.DisplayMetaInstance, 0, len(records))
+	for _, record := range records {
 		displayRecord, err := o.getter.GetDisplayMetaInstance(ctx, record)
 		if err != nil {
 			return err
@@ -90,6 +90,7 @@ func (p *Printer) PrintDisplayMetaInstanceList(metaInstances []types.DisplayMeta
 					fmt.Sprintf("Is Active: %s", runtimeInstance.GetIsActive().Value()),
 					fmt.Sprintf("ID: %s", printer.CyanText(runtimeInstance.GetID().Value())),
 					fmt.Sprintf("State: %s", runtimeInstance.Status.GetState().Value()),
+					fmt.Sprintf("Ready: %s", runtimeInstance.Status.GetReady().Value()),
 					fmt.Sprintf("Message: %s", printer.YellowText(runtimeInstance.Status.GetMessage().Value())),
 				)
 				secondInstance = true
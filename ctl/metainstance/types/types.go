@@ -36,6 +36,7 @@ type DisplayRuntimeInstance struct {
 type DisplayRuntimeInstanceStatus struct {
 	State   string `json:"state,omitempty" displayName:"Runtime State" yellowTexts:"RuntimeState_STARTING,RuntimeState_UPDATING,RuntimeState_TERMINATING" greenTexts:"RuntimeState_RUNNING,RuntimeState_TERMINATED" redTexts:"RuntimeState_PENDING,RuntimeState_FAILED"`
 	Message string `json:"message,omitempty" displayName:"Status Message"`
+	Ready   bool   `json:"ready,omitempty" displayName:"Ready" redTexts:"false" greenTexts:"true"`
 }
 
 // DisplayOperation represents the display version of Operation.
@@ -251,6 +251,23 @@ func (n *DisplayRuntimeInstanceStatus) GetMessage() printer.DisplayField {
 	}
 }
 
+func (n *DisplayRuntimeInstanceStatus) GetReady() printer.DisplayField {
+	return printer.DisplayField{
+		DisplayName: "Ready",
+		ColumnTag:   "",
+		Value: func() string {
+			str := strconv.FormatBool(n.Ready)
+			if str == "false" {
+				return printer.RedText(str)
+			}
+			if str == "true" {
+				return printer.GreenText(str)
+			}
+			return str
+		},
+	}
+}
+
 func (n *DisplayOperation) GetID() printer.DisplayField {
 	return printer.DisplayField{
 		DisplayName: "Operation ID",
@@ -11,6 +11,9 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// listPageSize is the number of nodes fetched by each List request.
+const listPageSize = 100
+
 type nodeListOptions struct {
 	payloadNamesNotIn  []string
 	remainingCoresGTE  int
@@ -46,16 +49,27 @@ func newNodeListCmd() *cobra.Command {
 }
 
 func (o *nodeListOptions) Run(ctx context.Context) error {
-	resp, err := o.nodesClient.List(context.Background(), &mrdspb.ListNodeRequest{
-		PayloadNameNotIn:   o.payloadNamesNotIn,
-		RemainingCoresGte:  uint32(o.remainingCoresGTE),
-		RemainingMemoryGte: uint32(o.remainingMemoryGTE),
-	})
-	if err != nil {
-		return err
+	var records []*mrdspb.Node
+	pageToken := ""
+	for {
+		resp, err := o.nodesClient.List(ctx, &mrdspb.ListNodeRequest{
+			PayloadNameNotIn:   o.payloadNamesNotIn,
+			RemainingCoresGte:  uint32(o.remainingCoresGTE),
+			RemainingMemoryGte: uint32(o.remainingMemoryGTE),
+			Limit:              listPageSize,
+			PageToken:          pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		records = append(records, resp.Records...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
 	}
-	displayNodes := make([]types.DisplayNode, 0, len(resp.Records))
-	for _, n := range resp.Records {
+	displayNodes := make([]types.DisplayNode, 0, len(records))
+	for _, n := range records {
 		displayNodes = append(displayNodes, convertGRPCNodeToDisplayNode(n))
 	}
 
@@ -52,17 +52,22 @@ func convertGRPCNodeToDisplayNode(n *mrdspb.Node) types.DisplayNode {
 
 	for _, volume := range n.GetLocalVolumes() {
 		displayNode.LocalVolumes = append(displayNode.LocalVolumes, types.DisplayLocalVolume{
-			MountPath:       volume.GetMountPath(),
-			StorageClass:    volume.GetStorageClass(),
-			StorageCapacity: int(volume.GetStorageCapacity()),
+			MountPath:         volume.GetMountPath(),
+			StorageClass:      volume.GetStorageClass(),
+			StorageCapacity:   int(volume.GetStorageCapacity()),
+			RemainingCapacity: int(volume.GetRemainingCapacity()),
 		})
 	}
 
 	for _, disruption := range n.GetDisruptions() {
+		var startTime time.Time
+		if disruption.GetStartTime() != nil {
+			startTime = disruption.GetStartTime().AsTime()
+		}
 		displayNode.Disruptions = append(displayNode.Disruptions, types.DisplayDisruption{
 			ID:          disruption.GetId(),
 			ShouldEvict: disruption.GetShouldEvict(),
-			StartTime:   time.Now(), //  FIXME
+			StartTime:   startTime,
 			Status: types.DisplayDisruptionStatus{
 				State:   disruption.GetStatus().GetState().String(),
 				Message: disruption.GetStatus().GetMessage(),
@@ -55,11 +55,11 @@ func (p *Printer) PrintDisplayNode(node types.DisplayNode) {
 	if len(node.LocalVolumes) == 0 {
 		p.PrintWarning("No local volumes found")
 	} else {
-		tableHeaders := []string{"Mount Path", "Storage Class", "Storage Capacity"}
+		tableHeaders := []string{"Mount Path", "Storage Class", "Storage Capacity", "Remaining Capacity"}
 		rows := make([][]string, 0)
 		for _, volume := range node.LocalVolumes {
 			rows = append(rows,
-				[]string{volume.MountPath, volume.StorageClass, strconv.Itoa(volume.StorageCapacity)},
+				[]string{volume.MountPath, volume.StorageClass, strconv.Itoa(volume.StorageCapacity), strconv.Itoa(volume.RemainingCapacity)},
 			)
 		}
 		p.PrintTable(tableHeaders, rows)
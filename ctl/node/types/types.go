@@ -38,9 +38,10 @@ type DisplayResources struct {
 
 // DisplayLocalVolume represents each local volume attached to the Node
 type DisplayLocalVolume struct {
-	MountPath       string `json:"mount_path,omitempty" displayName:"Mount Path"`
-	StorageClass    string `json:"storage_class,omitempty" displayName:"Storage Class"`
-	StorageCapacity int    `json:"storage_capacity,omitempty" displayName:"Storage Capacity (GB)"`
+	MountPath         string `json:"mount_path,omitempty" displayName:"Mount Path"`
+	StorageClass      string `json:"storage_class,omitempty" displayName:"Storage Class"`
+	StorageCapacity   int    `json:"storage_capacity,omitempty" displayName:"Storage Capacity (GB)"`
+	RemainingCapacity int    `json:"remaining_capacity,omitempty" displayName:"Remaining Capacity (GB)"`
 }
 
 // DisplayDisruption represents the display version of Disruption in NodeRecord
@@ -126,6 +126,17 @@ func (n *DisplayLocalVolume) GetStorageCapacity() printer.DisplayField {
 	}
 }
 
+func (n *DisplayLocalVolume) GetRemainingCapacity() printer.DisplayField {
+	return printer.DisplayField{
+		DisplayName: "Remaining Capacity (GB)",
+		ColumnTag:   "",
+		Value: func() string {
+			str := strconv.Itoa(n.RemainingCapacity)
+			return str
+		},
+	}
+}
+
 func (n *DisplayMetadata) GetIsDeleted() printer.DisplayField {
 	return printer.DisplayField{
 		DisplayName: "Is Deleted",
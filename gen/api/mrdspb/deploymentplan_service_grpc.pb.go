@@ -19,14 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeploymentPlans_Create_FullMethodName                 = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Create"
-	DeploymentPlans_GetByID_FullMethodName                = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/GetByID"
-	DeploymentPlans_GetByName_FullMethodName              = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/GetByName"
-	DeploymentPlans_UpdateStatus_FullMethodName           = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateStatus"
-	DeploymentPlans_List_FullMethodName                   = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/List"
-	DeploymentPlans_Delete_FullMethodName                 = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Delete"
-	DeploymentPlans_AddDeployment_FullMethodName          = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/AddDeployment"
-	DeploymentPlans_UpdateDeploymentStatus_FullMethodName = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateDeploymentStatus"
+	DeploymentPlans_Create_FullMethodName                   = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Create"
+	DeploymentPlans_GetByID_FullMethodName                  = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/GetByID"
+	DeploymentPlans_GetByName_FullMethodName                = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/GetByName"
+	DeploymentPlans_UpdateStatus_FullMethodName             = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateStatus"
+	DeploymentPlans_List_FullMethodName                     = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/List"
+	DeploymentPlans_Delete_FullMethodName                   = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Delete"
+	DeploymentPlans_UpdateSpec_FullMethodName               = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateSpec"
+	DeploymentPlans_GetSpecHistory_FullMethodName           = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/GetSpecHistory"
+	DeploymentPlans_AddDeployment_FullMethodName            = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/AddDeployment"
+	DeploymentPlans_UpdateDeploymentStatus_FullMethodName   = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateDeploymentStatus"
+	DeploymentPlans_UpdateDeploymentProgress_FullMethodName = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/UpdateDeploymentProgress"
+	DeploymentPlans_Rollback_FullMethodName                 = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Rollback"
+	DeploymentPlans_PauseDeployment_FullMethodName          = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/PauseDeployment"
+	DeploymentPlans_ResumeDeployment_FullMethodName         = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/ResumeDeployment"
+	DeploymentPlans_AbortDeployment_FullMethodName          = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/AbortDeployment"
+	DeploymentPlans_Watch_FullMethodName                    = "/proto.mrds.ledger.deploymentplan.DeploymentPlans/Watch"
 )
 
 // DeploymentPlansClient is the client API for DeploymentPlans service.
@@ -45,10 +53,28 @@ type DeploymentPlansClient interface {
 	List(ctx context.Context, in *ListDeploymentPlanRequest, opts ...grpc.CallOption) (*ListDeploymentPlanResponse, error)
 	// Delete a DeploymentPlan by its metadata.
 	Delete(ctx context.Context, in *DeleteDeploymentPlanRequest, opts ...grpc.CallOption) (*DeleteDeploymentPlanResponse, error)
+	// Update the applications and matching compute capabilities of a DeploymentPlan. Applications may be added
+	// and removed. The replaced spec is kept in the history of the DeploymentPlan, and the next Deployment,
+	// which has the coordinates of the payloads of the new spec, rolls the instances onto it.
+	UpdateSpec(ctx context.Context, in *UpdateDeploymentPlanSpecRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Get the specs of a DeploymentPlan which were replaced by spec updates, oldest first.
+	GetSpecHistory(ctx context.Context, in *GetDeploymentPlanSpecHistoryRequest, opts ...grpc.CallOption) (*GetDeploymentPlanSpecHistoryResponse, error)
 	// Add a Deployment to an existing DeploymentPlan.
 	AddDeployment(ctx context.Context, in *AddDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
 	// Update the status of an existing Deployment.
 	UpdateDeploymentStatus(ctx context.Context, in *UpdateDeploymentStatusRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Update the rollout progress of an existing Deployment.
+	UpdateDeploymentProgress(ctx context.Context, in *UpdateDeploymentProgressRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Rollback adds a Deployment which runs the payload coordinates and instance count of an earlier Deployment.
+	Rollback(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Pause an in-progress Deployment. The rollout stops before its next batch.
+	PauseDeployment(ctx context.Context, in *PauseDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Resume a paused Deployment.
+	ResumeDeployment(ctx context.Context, in *ResumeDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Abort a Deployment. The operations which have not started are cleaned up before its next batch.
+	AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error)
+	// Watch streams the changes of the Deployment Plans.
+	Watch(ctx context.Context, in *WatchDeploymentPlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDeploymentPlanResponse], error)
 }
 
 type deploymentPlansClient struct {
@@ -119,6 +145,26 @@ func (c *deploymentPlansClient) Delete(ctx context.Context, in *DeleteDeployment
 	return out, nil
 }
 
+func (c *deploymentPlansClient) UpdateSpec(ctx context.Context, in *UpdateDeploymentPlanSpecRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_UpdateSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) GetSpecHistory(ctx context.Context, in *GetDeploymentPlanSpecHistoryRequest, opts ...grpc.CallOption) (*GetDeploymentPlanSpecHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeploymentPlanSpecHistoryResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_GetSpecHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deploymentPlansClient) AddDeployment(ctx context.Context, in *AddDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateDeploymentPlanResponse)
@@ -139,6 +185,75 @@ func (c *deploymentPlansClient) UpdateDeploymentStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *deploymentPlansClient) UpdateDeploymentProgress(ctx context.Context, in *UpdateDeploymentProgressRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_UpdateDeploymentProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) Rollback(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_Rollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) PauseDeployment(ctx context.Context, in *PauseDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_PauseDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) ResumeDeployment(ctx context.Context, in *ResumeDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_ResumeDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*UpdateDeploymentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDeploymentPlanResponse)
+	err := c.cc.Invoke(ctx, DeploymentPlans_AbortDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentPlansClient) Watch(ctx context.Context, in *WatchDeploymentPlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDeploymentPlanResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeploymentPlans_ServiceDesc.Streams[0], DeploymentPlans_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDeploymentPlanRequest, WatchDeploymentPlanResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeploymentPlans_WatchClient = grpc.ServerStreamingClient[WatchDeploymentPlanResponse]
+
 // DeploymentPlansServer is the server API for DeploymentPlans service.
 // All implementations must embed UnimplementedDeploymentPlansServer
 // for forward compatibility.
@@ -155,10 +270,28 @@ type DeploymentPlansServer interface {
 	List(context.Context, *ListDeploymentPlanRequest) (*ListDeploymentPlanResponse, error)
 	// Delete a DeploymentPlan by its metadata.
 	Delete(context.Context, *DeleteDeploymentPlanRequest) (*DeleteDeploymentPlanResponse, error)
+	// Update the applications and matching compute capabilities of a DeploymentPlan. Applications may be added
+	// and removed. The replaced spec is kept in the history of the DeploymentPlan, and the next Deployment,
+	// which has the coordinates of the payloads of the new spec, rolls the instances onto it.
+	UpdateSpec(context.Context, *UpdateDeploymentPlanSpecRequest) (*UpdateDeploymentPlanResponse, error)
+	// Get the specs of a DeploymentPlan which were replaced by spec updates, oldest first.
+	GetSpecHistory(context.Context, *GetDeploymentPlanSpecHistoryRequest) (*GetDeploymentPlanSpecHistoryResponse, error)
 	// Add a Deployment to an existing DeploymentPlan.
 	AddDeployment(context.Context, *AddDeploymentRequest) (*UpdateDeploymentPlanResponse, error)
 	// Update the status of an existing Deployment.
 	UpdateDeploymentStatus(context.Context, *UpdateDeploymentStatusRequest) (*UpdateDeploymentPlanResponse, error)
+	// Update the rollout progress of an existing Deployment.
+	UpdateDeploymentProgress(context.Context, *UpdateDeploymentProgressRequest) (*UpdateDeploymentPlanResponse, error)
+	// Rollback adds a Deployment which runs the payload coordinates and instance count of an earlier Deployment.
+	Rollback(context.Context, *RollbackDeploymentRequest) (*UpdateDeploymentPlanResponse, error)
+	// Pause an in-progress Deployment. The rollout stops before its next batch.
+	PauseDeployment(context.Context, *PauseDeploymentRequest) (*UpdateDeploymentPlanResponse, error)
+	// Resume a paused Deployment.
+	ResumeDeployment(context.Context, *ResumeDeploymentRequest) (*UpdateDeploymentPlanResponse, error)
+	// Abort a Deployment. The operations which have not started are cleaned up before its next batch.
+	AbortDeployment(context.Context, *AbortDeploymentRequest) (*UpdateDeploymentPlanResponse, error)
+	// Watch streams the changes of the Deployment Plans.
+	Watch(*WatchDeploymentPlanRequest, grpc.ServerStreamingServer[WatchDeploymentPlanResponse]) error
 	mustEmbedUnimplementedDeploymentPlansServer()
 }
 
@@ -187,12 +320,36 @@ func (UnimplementedDeploymentPlansServer) List(context.Context, *ListDeploymentP
 func (UnimplementedDeploymentPlansServer) Delete(context.Context, *DeleteDeploymentPlanRequest) (*DeleteDeploymentPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedDeploymentPlansServer) UpdateSpec(context.Context, *UpdateDeploymentPlanSpecRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpec not implemented")
+}
+func (UnimplementedDeploymentPlansServer) GetSpecHistory(context.Context, *GetDeploymentPlanSpecHistoryRequest) (*GetDeploymentPlanSpecHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpecHistory not implemented")
+}
 func (UnimplementedDeploymentPlansServer) AddDeployment(context.Context, *AddDeploymentRequest) (*UpdateDeploymentPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDeployment not implemented")
 }
 func (UnimplementedDeploymentPlansServer) UpdateDeploymentStatus(context.Context, *UpdateDeploymentStatusRequest) (*UpdateDeploymentPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeploymentStatus not implemented")
 }
+func (UnimplementedDeploymentPlansServer) UpdateDeploymentProgress(context.Context, *UpdateDeploymentProgressRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeploymentProgress not implemented")
+}
+func (UnimplementedDeploymentPlansServer) Rollback(context.Context, *RollbackDeploymentRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedDeploymentPlansServer) PauseDeployment(context.Context, *PauseDeploymentRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseDeployment not implemented")
+}
+func (UnimplementedDeploymentPlansServer) ResumeDeployment(context.Context, *ResumeDeploymentRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeDeployment not implemented")
+}
+func (UnimplementedDeploymentPlansServer) AbortDeployment(context.Context, *AbortDeploymentRequest) (*UpdateDeploymentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortDeployment not implemented")
+}
+func (UnimplementedDeploymentPlansServer) Watch(*WatchDeploymentPlanRequest, grpc.ServerStreamingServer[WatchDeploymentPlanResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedDeploymentPlansServer) mustEmbedUnimplementedDeploymentPlansServer() {}
 func (UnimplementedDeploymentPlansServer) testEmbeddedByValue()                         {}
 
@@ -322,6 +479,42 @@ func _DeploymentPlans_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeploymentPlans_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeploymentPlanSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).UpdateSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_UpdateSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).UpdateSpec(ctx, req.(*UpdateDeploymentPlanSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_GetSpecHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentPlanSpecHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).GetSpecHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_GetSpecHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).GetSpecHistory(ctx, req.(*GetDeploymentPlanSpecHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeploymentPlans_AddDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -358,6 +551,107 @@ func _DeploymentPlans_UpdateDeploymentStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeploymentPlans_UpdateDeploymentProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeploymentProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).UpdateDeploymentProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_UpdateDeploymentProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).UpdateDeploymentProgress(ctx, req.(*UpdateDeploymentProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).Rollback(ctx, req.(*RollbackDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_PauseDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).PauseDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_PauseDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).PauseDeployment(ctx, req.(*PauseDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_ResumeDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).ResumeDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_ResumeDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).ResumeDeployment(ctx, req.(*ResumeDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_AbortDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentPlansServer).AbortDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeploymentPlans_AbortDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentPlansServer).AbortDeployment(ctx, req.(*AbortDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentPlans_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeploymentPlanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeploymentPlansServer).Watch(m, &grpc.GenericServerStream[WatchDeploymentPlanRequest, WatchDeploymentPlanResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeploymentPlans_WatchServer = grpc.ServerStreamingServer[WatchDeploymentPlanResponse]
+
 // DeploymentPlans_ServiceDesc is the grpc.ServiceDesc for DeploymentPlans service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,6 +683,14 @@ var DeploymentPlans_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _DeploymentPlans_Delete_Handler,
 		},
+		{
+			MethodName: "UpdateSpec",
+			Handler:    _DeploymentPlans_UpdateSpec_Handler,
+		},
+		{
+			MethodName: "GetSpecHistory",
+			Handler:    _DeploymentPlans_GetSpecHistory_Handler,
+		},
 		{
 			MethodName: "AddDeployment",
 			Handler:    _DeploymentPlans_AddDeployment_Handler,
@@ -397,7 +699,33 @@ var DeploymentPlans_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDeploymentStatus",
 			Handler:    _DeploymentPlans_UpdateDeploymentStatus_Handler,
 		},
+		{
+			MethodName: "UpdateDeploymentProgress",
+			Handler:    _DeploymentPlans_UpdateDeploymentProgress_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _DeploymentPlans_Rollback_Handler,
+		},
+		{
+			MethodName: "PauseDeployment",
+			Handler:    _DeploymentPlans_PauseDeployment_Handler,
+		},
+		{
+			MethodName: "ResumeDeployment",
+			Handler:    _DeploymentPlans_ResumeDeployment_Handler,
+		},
+		{
+			MethodName: "AbortDeployment",
+			Handler:    _DeploymentPlans_AbortDeployment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _DeploymentPlans_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "deploymentplan_service.proto",
 }
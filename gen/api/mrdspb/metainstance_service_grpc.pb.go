@@ -19,20 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetaInstances_Create_FullMethodName                   = "/proto.mrds.ledger.metainstance.MetaInstances/Create"
-	MetaInstances_GetByID_FullMethodName                  = "/proto.mrds.ledger.metainstance.MetaInstances/GetByID"
-	MetaInstances_GetByName_FullMethodName                = "/proto.mrds.ledger.metainstance.MetaInstances/GetByName"
-	MetaInstances_UpdateStatus_FullMethodName             = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateStatus"
-	MetaInstances_UpdateDeploymentID_FullMethodName       = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateDeploymentID"
-	MetaInstances_List_FullMethodName                     = "/proto.mrds.ledger.metainstance.MetaInstances/List"
-	MetaInstances_Delete_FullMethodName                   = "/proto.mrds.ledger.metainstance.MetaInstances/Delete"
-	MetaInstances_AddRuntimeInstance_FullMethodName       = "/proto.mrds.ledger.metainstance.MetaInstances/AddRuntimeInstance"
-	MetaInstances_UpdateRuntimeStatus_FullMethodName      = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateRuntimeStatus"
-	MetaInstances_UpdateRuntimeActiveState_FullMethodName = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateRuntimeActiveState"
-	MetaInstances_RemoveRuntimeInstance_FullMethodName    = "/proto.mrds.ledger.metainstance.MetaInstances/RemoveRuntimeInstance"
-	MetaInstances_AddOperation_FullMethodName             = "/proto.mrds.ledger.metainstance.MetaInstances/AddOperation"
-	MetaInstances_UpdateOperationStatus_FullMethodName    = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateOperationStatus"
-	MetaInstances_RemoveOperation_FullMethodName          = "/proto.mrds.ledger.metainstance.MetaInstances/RemoveOperation"
+	MetaInstances_Create_FullMethodName                       = "/proto.mrds.ledger.metainstance.MetaInstances/Create"
+	MetaInstances_GetByID_FullMethodName                      = "/proto.mrds.ledger.metainstance.MetaInstances/GetByID"
+	MetaInstances_GetByName_FullMethodName                    = "/proto.mrds.ledger.metainstance.MetaInstances/GetByName"
+	MetaInstances_UpdateStatus_FullMethodName                 = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateStatus"
+	MetaInstances_UpdateDeploymentID_FullMethodName           = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateDeploymentID"
+	MetaInstances_List_FullMethodName                         = "/proto.mrds.ledger.metainstance.MetaInstances/List"
+	MetaInstances_Delete_FullMethodName                       = "/proto.mrds.ledger.metainstance.MetaInstances/Delete"
+	MetaInstances_AddRuntimeInstance_FullMethodName           = "/proto.mrds.ledger.metainstance.MetaInstances/AddRuntimeInstance"
+	MetaInstances_UpdateRuntimeStatus_FullMethodName          = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateRuntimeStatus"
+	MetaInstances_UpdateRuntimeActiveState_FullMethodName     = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateRuntimeActiveState"
+	MetaInstances_SwitchActiveRuntimeInstances_FullMethodName = "/proto.mrds.ledger.metainstance.MetaInstances/SwitchActiveRuntimeInstances"
+	MetaInstances_RemoveRuntimeInstance_FullMethodName        = "/proto.mrds.ledger.metainstance.MetaInstances/RemoveRuntimeInstance"
+	MetaInstances_AddOperation_FullMethodName                 = "/proto.mrds.ledger.metainstance.MetaInstances/AddOperation"
+	MetaInstances_UpdateOperationStatus_FullMethodName        = "/proto.mrds.ledger.metainstance.MetaInstances/UpdateOperationStatus"
+	MetaInstances_RemoveOperation_FullMethodName              = "/proto.mrds.ledger.metainstance.MetaInstances/RemoveOperation"
+	MetaInstances_Watch_FullMethodName                        = "/proto.mrds.ledger.metainstance.MetaInstances/Watch"
 )
 
 // MetaInstancesClient is the client API for MetaInstances service.
@@ -58,10 +60,15 @@ type MetaInstancesClient interface {
 	AddRuntimeInstance(ctx context.Context, in *AddRuntimeInstanceRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
 	UpdateRuntimeStatus(ctx context.Context, in *UpdateRuntimeStatusRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
 	UpdateRuntimeActiveState(ctx context.Context, in *UpdateRuntimeActiveStateRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
+	// Make the given RuntimeInstances active and the other RuntimeInstances of their MetaInstances passive,
+	// all in a single transaction.
+	SwitchActiveRuntimeInstances(ctx context.Context, in *SwitchActiveRuntimeInstancesRequest, opts ...grpc.CallOption) (*SwitchActiveRuntimeInstancesResponse, error)
 	RemoveRuntimeInstance(ctx context.Context, in *RemoveRuntimeInstanceRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
 	AddOperation(ctx context.Context, in *AddOperationRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
 	UpdateOperationStatus(ctx context.Context, in *UpdateOperationStatusRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
 	RemoveOperation(ctx context.Context, in *RemoveOperationRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error)
+	// Watch streams the changes of the MetaInstances.
+	Watch(ctx context.Context, in *WatchMetaInstanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMetaInstanceResponse], error)
 }
 
 type metaInstancesClient struct {
@@ -172,6 +179,16 @@ func (c *metaInstancesClient) UpdateRuntimeActiveState(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *metaInstancesClient) SwitchActiveRuntimeInstances(ctx context.Context, in *SwitchActiveRuntimeInstancesRequest, opts ...grpc.CallOption) (*SwitchActiveRuntimeInstancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwitchActiveRuntimeInstancesResponse)
+	err := c.cc.Invoke(ctx, MetaInstances_SwitchActiveRuntimeInstances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metaInstancesClient) RemoveRuntimeInstance(ctx context.Context, in *RemoveRuntimeInstanceRequest, opts ...grpc.CallOption) (*UpdateMetaInstanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateMetaInstanceResponse)
@@ -212,6 +229,25 @@ func (c *metaInstancesClient) RemoveOperation(ctx context.Context, in *RemoveOpe
 	return out, nil
 }
 
+func (c *metaInstancesClient) Watch(ctx context.Context, in *WatchMetaInstanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMetaInstanceResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MetaInstances_ServiceDesc.Streams[0], MetaInstances_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMetaInstanceRequest, WatchMetaInstanceResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetaInstances_WatchClient = grpc.ServerStreamingClient[WatchMetaInstanceResponse]
+
 // MetaInstancesServer is the server API for MetaInstances service.
 // All implementations must embed UnimplementedMetaInstancesServer
 // for forward compatibility.
@@ -235,10 +271,15 @@ type MetaInstancesServer interface {
 	AddRuntimeInstance(context.Context, *AddRuntimeInstanceRequest) (*UpdateMetaInstanceResponse, error)
 	UpdateRuntimeStatus(context.Context, *UpdateRuntimeStatusRequest) (*UpdateMetaInstanceResponse, error)
 	UpdateRuntimeActiveState(context.Context, *UpdateRuntimeActiveStateRequest) (*UpdateMetaInstanceResponse, error)
+	// Make the given RuntimeInstances active and the other RuntimeInstances of their MetaInstances passive,
+	// all in a single transaction.
+	SwitchActiveRuntimeInstances(context.Context, *SwitchActiveRuntimeInstancesRequest) (*SwitchActiveRuntimeInstancesResponse, error)
 	RemoveRuntimeInstance(context.Context, *RemoveRuntimeInstanceRequest) (*UpdateMetaInstanceResponse, error)
 	AddOperation(context.Context, *AddOperationRequest) (*UpdateMetaInstanceResponse, error)
 	UpdateOperationStatus(context.Context, *UpdateOperationStatusRequest) (*UpdateMetaInstanceResponse, error)
 	RemoveOperation(context.Context, *RemoveOperationRequest) (*UpdateMetaInstanceResponse, error)
+	// Watch streams the changes of the MetaInstances.
+	Watch(*WatchMetaInstanceRequest, grpc.ServerStreamingServer[WatchMetaInstanceResponse]) error
 	mustEmbedUnimplementedMetaInstancesServer()
 }
 
@@ -279,6 +320,9 @@ func (UnimplementedMetaInstancesServer) UpdateRuntimeStatus(context.Context, *Up
 func (UnimplementedMetaInstancesServer) UpdateRuntimeActiveState(context.Context, *UpdateRuntimeActiveStateRequest) (*UpdateMetaInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuntimeActiveState not implemented")
 }
+func (UnimplementedMetaInstancesServer) SwitchActiveRuntimeInstances(context.Context, *SwitchActiveRuntimeInstancesRequest) (*SwitchActiveRuntimeInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchActiveRuntimeInstances not implemented")
+}
 func (UnimplementedMetaInstancesServer) RemoveRuntimeInstance(context.Context, *RemoveRuntimeInstanceRequest) (*UpdateMetaInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRuntimeInstance not implemented")
 }
@@ -291,6 +335,9 @@ func (UnimplementedMetaInstancesServer) UpdateOperationStatus(context.Context, *
 func (UnimplementedMetaInstancesServer) RemoveOperation(context.Context, *RemoveOperationRequest) (*UpdateMetaInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveOperation not implemented")
 }
+func (UnimplementedMetaInstancesServer) Watch(*WatchMetaInstanceRequest, grpc.ServerStreamingServer[WatchMetaInstanceResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedMetaInstancesServer) mustEmbedUnimplementedMetaInstancesServer() {}
 func (UnimplementedMetaInstancesServer) testEmbeddedByValue()                       {}
 
@@ -492,6 +539,24 @@ func _MetaInstances_UpdateRuntimeActiveState_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetaInstances_SwitchActiveRuntimeInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchActiveRuntimeInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaInstancesServer).SwitchActiveRuntimeInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaInstances_SwitchActiveRuntimeInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaInstancesServer).SwitchActiveRuntimeInstances(ctx, req.(*SwitchActiveRuntimeInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MetaInstances_RemoveRuntimeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveRuntimeInstanceRequest)
 	if err := dec(in); err != nil {
@@ -564,6 +629,17 @@ func _MetaInstances_RemoveOperation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetaInstances_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMetaInstanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetaInstancesServer).Watch(m, &grpc.GenericServerStream[WatchMetaInstanceRequest, WatchMetaInstanceResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MetaInstances_WatchServer = grpc.ServerStreamingServer[WatchMetaInstanceResponse]
+
 // MetaInstances_ServiceDesc is the grpc.ServiceDesc for MetaInstances service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -611,6 +687,10 @@ var MetaInstances_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRuntimeActiveState",
 			Handler:    _MetaInstances_UpdateRuntimeActiveState_Handler,
 		},
+		{
+			MethodName: "SwitchActiveRuntimeInstances",
+			Handler:    _MetaInstances_SwitchActiveRuntimeInstances_Handler,
+		},
 		{
 			MethodName: "RemoveRuntimeInstance",
 			Handler:    _MetaInstances_RemoveRuntimeInstance_Handler,
@@ -628,6 +708,12 @@ var MetaInstances_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _MetaInstances_RemoveOperation_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _MetaInstances_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "metainstance_service.proto",
 }
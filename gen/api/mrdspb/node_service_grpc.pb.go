@@ -23,6 +23,7 @@ const (
 	Nodes_GetByID_FullMethodName                = "/proto.mrds.ledger.node.Nodes/GetByID"
 	Nodes_GetByName_FullMethodName              = "/proto.mrds.ledger.node.Nodes/GetByName"
 	Nodes_UpdateStatus_FullMethodName           = "/proto.mrds.ledger.node.Nodes/UpdateStatus"
+	Nodes_UpdateSpec_FullMethodName             = "/proto.mrds.ledger.node.Nodes/UpdateSpec"
 	Nodes_List_FullMethodName                   = "/proto.mrds.ledger.node.Nodes/List"
 	Nodes_Delete_FullMethodName                 = "/proto.mrds.ledger.node.Nodes/Delete"
 	Nodes_AddDisruption_FullMethodName          = "/proto.mrds.ledger.node.Nodes/AddDisruption"
@@ -30,6 +31,7 @@ const (
 	Nodes_RemoveDisruption_FullMethodName       = "/proto.mrds.ledger.node.Nodes/RemoveDisruption"
 	Nodes_AddCapability_FullMethodName          = "/proto.mrds.ledger.node.Nodes/AddCapability"
 	Nodes_RemoveCapability_FullMethodName       = "/proto.mrds.ledger.node.Nodes/RemoveCapability"
+	Nodes_Watch_FullMethodName                  = "/proto.mrds.ledger.node.Nodes/Watch"
 )
 
 // NodesClient is the client API for Nodes service.
@@ -46,6 +48,8 @@ type NodesClient interface {
 	GetByName(ctx context.Context, in *GetNodeByNameRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
 	// Update the state of an existing Node.
 	UpdateStatus(ctx context.Context, in *UpdateNodeStatusRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
+	// Update the update domain and the resources of an existing Node.
+	UpdateSpec(ctx context.Context, in *UpdateNodeSpecRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
 	// List Nodes that match the provided filters.
 	List(ctx context.Context, in *ListNodeRequest, opts ...grpc.CallOption) (*ListNodeResponse, error)
 	// Delete a Node by its metadata.
@@ -55,6 +59,8 @@ type NodesClient interface {
 	RemoveDisruption(ctx context.Context, in *RemoveDisruptionRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
 	AddCapability(ctx context.Context, in *AddCapabilityRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
 	RemoveCapability(ctx context.Context, in *RemoveCapabilityRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
+	// Watch streams the changes of the Nodes.
+	Watch(ctx context.Context, in *WatchNodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNodeResponse], error)
 }
 
 type nodesClient struct {
@@ -105,6 +111,16 @@ func (c *nodesClient) UpdateStatus(ctx context.Context, in *UpdateNodeStatusRequ
 	return out, nil
 }
 
+func (c *nodesClient) UpdateSpec(ctx context.Context, in *UpdateNodeSpecRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNodeResponse)
+	err := c.cc.Invoke(ctx, Nodes_UpdateSpec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodesClient) List(ctx context.Context, in *ListNodeRequest, opts ...grpc.CallOption) (*ListNodeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodeResponse)
@@ -175,6 +191,25 @@ func (c *nodesClient) RemoveCapability(ctx context.Context, in *RemoveCapability
 	return out, nil
 }
 
+func (c *nodesClient) Watch(ctx context.Context, in *WatchNodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchNodeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Nodes_ServiceDesc.Streams[0], Nodes_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNodeRequest, WatchNodeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Nodes_WatchClient = grpc.ServerStreamingClient[WatchNodeResponse]
+
 // NodesServer is the server API for Nodes service.
 // All implementations must embed UnimplementedNodesServer
 // for forward compatibility.
@@ -189,6 +224,8 @@ type NodesServer interface {
 	GetByName(context.Context, *GetNodeByNameRequest) (*GetNodeResponse, error)
 	// Update the state of an existing Node.
 	UpdateStatus(context.Context, *UpdateNodeStatusRequest) (*UpdateNodeResponse, error)
+	// Update the update domain and the resources of an existing Node.
+	UpdateSpec(context.Context, *UpdateNodeSpecRequest) (*UpdateNodeResponse, error)
 	// List Nodes that match the provided filters.
 	List(context.Context, *ListNodeRequest) (*ListNodeResponse, error)
 	// Delete a Node by its metadata.
@@ -198,6 +235,8 @@ type NodesServer interface {
 	RemoveDisruption(context.Context, *RemoveDisruptionRequest) (*UpdateNodeResponse, error)
 	AddCapability(context.Context, *AddCapabilityRequest) (*UpdateNodeResponse, error)
 	RemoveCapability(context.Context, *RemoveCapabilityRequest) (*UpdateNodeResponse, error)
+	// Watch streams the changes of the Nodes.
+	Watch(*WatchNodeRequest, grpc.ServerStreamingServer[WatchNodeResponse]) error
 	mustEmbedUnimplementedNodesServer()
 }
 
@@ -220,6 +259,9 @@ func (UnimplementedNodesServer) GetByName(context.Context, *GetNodeByNameRequest
 func (UnimplementedNodesServer) UpdateStatus(context.Context, *UpdateNodeStatusRequest) (*UpdateNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
 }
+func (UnimplementedNodesServer) UpdateSpec(context.Context, *UpdateNodeSpecRequest) (*UpdateNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpec not implemented")
+}
 func (UnimplementedNodesServer) List(context.Context, *ListNodeRequest) (*ListNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -241,6 +283,9 @@ func (UnimplementedNodesServer) AddCapability(context.Context, *AddCapabilityReq
 func (UnimplementedNodesServer) RemoveCapability(context.Context, *RemoveCapabilityRequest) (*UpdateNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCapability not implemented")
 }
+func (UnimplementedNodesServer) Watch(*WatchNodeRequest, grpc.ServerStreamingServer[WatchNodeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedNodesServer) mustEmbedUnimplementedNodesServer() {}
 func (UnimplementedNodesServer) testEmbeddedByValue()               {}
 
@@ -334,6 +379,24 @@ func _Nodes_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nodes_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodesServer).UpdateSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Nodes_UpdateSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodesServer).UpdateSpec(ctx, req.(*UpdateNodeSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nodes_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNodeRequest)
 	if err := dec(in); err != nil {
@@ -460,6 +523,17 @@ func _Nodes_RemoveCapability_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nodes_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodesServer).Watch(m, &grpc.GenericServerStream[WatchNodeRequest, WatchNodeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Nodes_WatchServer = grpc.ServerStreamingServer[WatchNodeResponse]
+
 // Nodes_ServiceDesc is the grpc.ServiceDesc for Nodes service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -483,6 +557,10 @@ var Nodes_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStatus",
 			Handler:    _Nodes_UpdateStatus_Handler,
 		},
+		{
+			MethodName: "UpdateSpec",
+			Handler:    _Nodes_UpdateSpec_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _Nodes_List_Handler,
@@ -512,6 +590,12 @@ var Nodes_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Nodes_RemoveCapability_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Nodes_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "node_service.proto",
 }
@@ -27,6 +27,8 @@ func clusterProtoToLedgerRecord(proto *mrdspb.Cluster) cluster.ClusterRecord {
 			State:   cluster.ClusterState(proto.Status.State.String()),
 			Message: proto.Status.Message,
 		},
+		RuntimeType:   proto.RuntimeType,
+		RuntimeConfig: proto.RuntimeConfig,
 	}
 }
 
@@ -41,6 +43,8 @@ func clusterLedgerRecordToProto(record cluster.ClusterRecord) *mrdspb.Cluster {
 			State:   mrdspb.ClusterState(mrdspb.ClusterState_value[record.Status.State.ToString()]),
 			Message: record.Status.Message,
 		},
+		RuntimeType:   record.RuntimeType,
+		RuntimeConfig: record.RuntimeConfig,
 	}
 }
 
@@ -55,7 +59,9 @@ func NewClusterService(ledger cluster.Ledger) *ClusterService {
 // Create creates a new Cluster
 func (s *ClusterService) Create(ctx context.Context, req *mrdspb.CreateClusterRequest) (*mrdspb.CreateClusterResponse, error) {
 	createResponse, err := s.ledger.Create(ctx, &cluster.CreateRequest{
-		Name: req.Name,
+		Name:          req.Name,
+		RuntimeType:   req.RuntimeType,
+		RuntimeConfig: req.RuntimeConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -135,7 +141,9 @@ func (s *ClusterService) List(ctx context.Context, req *mrdspb.ListClusterReques
 			VersionEq:  eq,
 			StateIn:    stateIn,
 			StateNotIn: stateNotIn,
+			Limit:      req.Limit,
 		},
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, err
@@ -146,7 +154,11 @@ func (s *ClusterService) List(ctx context.Context, req *mrdspb.ListClusterReques
 		records[i] = s.ledgerRecordToProto(record)
 	}
 
-	return &mrdspb.ListClusterResponse{Records: records}, nil
+	return &mrdspb.ListClusterResponse{
+		Records:       records,
+		NextPageToken: listResponse.NextPageToken,
+		TotalCount:    listResponse.TotalCount,
+	}, nil
 }
 
 // Delete deletes a Cluster
@@ -142,7 +142,9 @@ func (s *ComputeCapabilityService) List(ctx context.Context, req *mrdspb.ListCom
 			StateIn:    stateIn,
 			StateNotIn: stateNotIn,
 			TypeIn:     req.TypeIn,
+			Limit:      req.Limit,
 		},
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, err
@@ -153,7 +155,11 @@ func (s *ComputeCapabilityService) List(ctx context.Context, req *mrdspb.ListCom
 		records[i] = s.ledgerRecordToProto(record)
 	}
 
-	return &mrdspb.ListComputeCapabilityResponse{Records: records}, nil
+	return &mrdspb.ListComputeCapabilityResponse{
+		Records:       records,
+		NextPageToken: listResponse.NextPageToken,
+		TotalCount:    listResponse.TotalCount,
+	}, nil
 }
 
 // Delete deletes a ComputeCapability
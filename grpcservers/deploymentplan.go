@@ -6,11 +6,21 @@ import (
 	"github.com/msanath/mrds/gen/api/mrdspb"
 	"github.com/msanath/mrds/ledger/core"
 	"github.com/msanath/mrds/ledger/deploymentplan"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// DeploymentStateNotifier is notified when a Deployment is paused, resumed or aborted.
+type DeploymentStateNotifier interface {
+	NotifyDeploymentStateChanged(ctx context.Context, deploymentPlan *mrdspb.DeploymentPlanRecord, deployment *mrdspb.Deployment) error
+}
+
 type DeploymentPlanService struct {
 	ledger              deploymentplan.Ledger
 	ledgerRecordToProto func(record deploymentplan.DeploymentPlanRecord) *mrdspb.DeploymentPlanRecord
+	stateNotifier       DeploymentStateNotifier
 
 	mrdspb.UnimplementedDeploymentPlansServer
 }
@@ -31,6 +41,7 @@ func deploymentPlanLedgerRecordToProto(record deploymentplan.DeploymentPlanRecor
 		MatchingComputeCapabilities: deploymentPlanMatchingComputeCapabilitiesToProto(record.MatchingComputeCapabilities),
 		Deployments:                 deploymentPlanDeploymentsToProto(record.Deployments),
 		Applications:                deploymentPlanApplicationsToProto(record.Applications),
+		SchedulerProfile:            record.SchedulerProfile,
 	}
 }
 
@@ -57,6 +68,24 @@ func deploymentPlanDeploymentsToProto(deployments []deploymentplan.Deployment) [
 			},
 			PayloadCoordinates: deploymentPlanPayloadCoordinatesToProto(d.PayloadCoordinates),
 			InstanceCount:      d.InstanceCount,
+			RolloutStrategy: &mrdspb.RolloutStrategy{
+				Type:           mrdspb.RolloutStrategyType(mrdspb.RolloutStrategyType_value[string(d.RolloutStrategy.Type)]),
+				MaxUnavailable: d.RolloutStrategy.MaxUnavailable,
+				MaxSurge:       d.RolloutStrategy.MaxSurge,
+				BatchSize:      d.RolloutStrategy.BatchSize,
+				PauseSeconds:   d.RolloutStrategy.PauseSeconds,
+				HoldSeconds:    d.RolloutStrategy.HoldSeconds,
+			},
+			RolloutProgress: &mrdspb.RolloutProgress{
+				CompletedBatches: d.RolloutProgress.CompletedBatches,
+				TotalBatches:     d.RolloutProgress.TotalBatches,
+			},
+			Canary: &mrdspb.Canary{
+				InstanceCount:         d.Canary.InstanceCount,
+				Percentage:            d.Canary.Percentage,
+				BakeSeconds:           d.Canary.BakeSeconds,
+				MaxUnhealthyInstances: d.Canary.MaxUnhealthyInstances,
+			},
 		})
 	}
 	return protoDeployments
@@ -73,11 +102,29 @@ func deploymentPlanApplicationsToProto(applications []deploymentplan.Application
 			},
 			Ports:             applicationPortsToProto(a.Ports),
 			PersistentVolumes: applicationPersistentVolumesToProto(a.PersistentVolumes),
+			LivenessProbe:     applicationProbeToProto(a.LivenessProbe),
+			ReadinessProbe:    applicationProbeToProto(a.ReadinessProbe),
 		})
 	}
 	return protoApps
 }
 
+func applicationProbeToProto(probe *deploymentplan.Probe) *mrdspb.Probe {
+	if probe == nil {
+		return nil
+	}
+	return &mrdspb.Probe{
+		Type:                mrdspb.ProbeType(mrdspb.ProbeType_value[string(probe.Type)]),
+		Path:                probe.Path,
+		Port:                probe.Port,
+		Command:             probe.Command,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
+
 func applicationPortsToProto(ports []deploymentplan.ApplicationPort) []*mrdspb.ApplicationPort {
 	var protoPorts []*mrdspb.ApplicationPort
 	for _, p := range ports {
@@ -112,28 +159,43 @@ func deploymentPlanPayloadCoordinatesToProto(coords []deploymentplan.PayloadCoor
 	return protoCoords
 }
 
-func NewDeploymentPlanService(ledger deploymentplan.Ledger) *DeploymentPlanService {
-	return &DeploymentPlanService{
+type DeploymentPlanServiceOption func(*DeploymentPlanService)
+
+// WithDeploymentStateNotifier notifies the notifier whenever a Deployment is paused, resumed or aborted.
+func WithDeploymentStateNotifier(notifier DeploymentStateNotifier) DeploymentPlanServiceOption {
+	return func(s *DeploymentPlanService) {
+		s.stateNotifier = notifier
+	}
+}
+
+func NewDeploymentPlanService(ledger deploymentplan.Ledger, opts ...DeploymentPlanServiceOption) *DeploymentPlanService {
+	s := &DeploymentPlanService{
 		ledger:              ledger,
 		ledgerRecordToProto: deploymentPlanLedgerRecordToProto,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-// Create creates a new DeploymentPlan
-func (s *DeploymentPlanService) Create(ctx context.Context, req *mrdspb.CreateDeploymentPlanRequest) (*mrdspb.CreateDeploymentPlanResponse, error) {
-	// Convert MatchingComputeCapabilities from protobuf type to interface type
+// deploymentPlanMatchingComputeCapabilitiesFromProto converts MatchingComputeCapabilities from protobuf type to interface type
+func deploymentPlanMatchingComputeCapabilitiesFromProto(capabilities []*mrdspb.MatchingComputeCapability) []deploymentplan.MatchingComputeCapability {
 	var matchingComputeCapabilities []deploymentplan.MatchingComputeCapability
-	for _, cap := range req.MatchingComputeCapabilities {
+	for _, cap := range capabilities {
 		matchingComputeCapabilities = append(matchingComputeCapabilities, deploymentplan.MatchingComputeCapability{
 			CapabilityType:  cap.CapabilityType,
 			Comparator:      deploymentplan.ComparatorType(cap.Comparator.String()),
 			CapabilityNames: cap.CapabilityNames,
 		})
 	}
+	return matchingComputeCapabilities
+}
 
-	// Convert Applications from protobuf type to interface type
+// deploymentPlanApplicationsFromProto converts Applications from protobuf type to interface type
+func deploymentPlanApplicationsFromProto(protoApplications []*mrdspb.Application) []deploymentplan.Application {
 	var applications []deploymentplan.Application
-	for _, app := range req.Applications {
+	for _, app := range protoApplications {
 		// Convert Application resources
 		resources := deploymentplan.ApplicationResources{
 			Cores:  app.Resources.Cores,
@@ -165,16 +227,41 @@ func (s *DeploymentPlanService) Create(ctx context.Context, req *mrdspb.CreateDe
 			Resources:         resources,
 			Ports:             ports,
 			PersistentVolumes: persistentVolumes,
+			LivenessProbe:     applicationProbeFromProto(app.LivenessProbe),
+			ReadinessProbe:    applicationProbeFromProto(app.ReadinessProbe),
 		})
 	}
+	return applications
+}
 
+// applicationProbeFromProto converts a probe from protobuf type to interface type. A probe of type NONE is
+// no probe.
+func applicationProbeFromProto(probe *mrdspb.Probe) *deploymentplan.Probe {
+	if probe.GetType() == mrdspb.ProbeType_ProbeType_NONE {
+		return nil
+	}
+	return &deploymentplan.Probe{
+		Type:                deploymentplan.ProbeType(probe.Type.String()),
+		Path:                probe.Path,
+		Port:                probe.Port,
+		Command:             probe.Command,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
+
+// Create creates a new DeploymentPlan
+func (s *DeploymentPlanService) Create(ctx context.Context, req *mrdspb.CreateDeploymentPlanRequest) (*mrdspb.CreateDeploymentPlanResponse, error) {
 	// Construct the CreateRequest with converted fields
 	cr := &deploymentplan.CreateRequest{
 		Name:                        req.Name,
 		Namespace:                   req.Namespace,
 		ServiceName:                 req.ServiceName,
-		MatchingComputeCapabilities: matchingComputeCapabilities,
-		Applications:                applications,
+		MatchingComputeCapabilities: deploymentPlanMatchingComputeCapabilitiesFromProto(req.MatchingComputeCapabilities),
+		Applications:                deploymentPlanApplicationsFromProto(req.Applications),
+		SchedulerProfile:            req.SchedulerProfile,
 	}
 
 	// Call the ledger's Create function
@@ -256,6 +343,7 @@ func (s *DeploymentPlanService) List(ctx context.Context, req *mrdspb.ListDeploy
 			PayloadNameIn:      req.Filters.PayloadNameIn,
 			DeploymentPlanIDIn: req.Filters.DeploymentPlanIdIn,
 		},
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, err
@@ -268,7 +356,11 @@ func (s *DeploymentPlanService) List(ctx context.Context, req *mrdspb.ListDeploy
 	}
 
 	// Return the list response
-	return &mrdspb.ListDeploymentPlanResponse{Records: records}, nil
+	return &mrdspb.ListDeploymentPlanResponse{
+		Records:       records,
+		NextPageToken: listResponse.NextPageToken,
+		TotalCount:    listResponse.TotalCount,
+	}, nil
 }
 
 // Delete deletes a DeploymentPlan by metadata
@@ -302,6 +394,28 @@ func (s *DeploymentPlanService) AddDeployment(ctx context.Context, req *mrdspb.A
 		})
 	}
 
+	var rolloutStrategy deploymentplan.RolloutStrategy
+	if req.RolloutStrategy != nil {
+		rolloutStrategy = deploymentplan.RolloutStrategy{
+			Type:           deploymentplan.RolloutStrategyType(req.RolloutStrategy.Type.String()),
+			MaxUnavailable: req.RolloutStrategy.MaxUnavailable,
+			MaxSurge:       req.RolloutStrategy.MaxSurge,
+			BatchSize:      req.RolloutStrategy.BatchSize,
+			PauseSeconds:   req.RolloutStrategy.PauseSeconds,
+			HoldSeconds:    req.RolloutStrategy.HoldSeconds,
+		}
+	}
+
+	var canary deploymentplan.Canary
+	if req.Canary != nil {
+		canary = deploymentplan.Canary{
+			InstanceCount:         req.Canary.InstanceCount,
+			Percentage:            req.Canary.Percentage,
+			BakeSeconds:           req.Canary.BakeSeconds,
+			MaxUnhealthyInstances: req.Canary.MaxUnhealthyInstances,
+		}
+	}
+
 	// Build the AddDeploymentRequest with converted fields
 	addResponse, err := s.ledger.AddDeployment(ctx, &deploymentplan.AddDeploymentRequest{
 		Metadata: core.Metadata{
@@ -311,6 +425,8 @@ func (s *DeploymentPlanService) AddDeployment(ctx context.Context, req *mrdspb.A
 		DeploymentID:       req.DeploymentId,
 		PayloadCoordinates: payloadCoordinates,
 		InstanceCount:      req.InstanceCount,
+		RolloutStrategy:    rolloutStrategy,
+		Canary:             canary,
 	})
 	if err != nil {
 		return nil, err
@@ -320,6 +436,114 @@ func (s *DeploymentPlanService) AddDeployment(ctx context.Context, req *mrdspb.A
 	return &mrdspb.UpdateDeploymentPlanResponse{Record: s.ledgerRecordToProto(addResponse.Record)}, nil
 }
 
+// UpdateSpec updates the applications and matching compute capabilities of a DeploymentPlan
+func (s *DeploymentPlanService) UpdateSpec(ctx context.Context, req *mrdspb.UpdateDeploymentPlanSpecRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	updateResponse, err := s.ledger.UpdateSpec(ctx, &deploymentplan.UpdateSpecRequest{
+		Metadata: core.Metadata{
+			ID:      req.Metadata.Id,
+			Version: req.Metadata.Version,
+		},
+		Spec: deploymentplan.DeploymentPlanSpec{
+			MatchingComputeCapabilities: deploymentPlanMatchingComputeCapabilitiesFromProto(req.MatchingComputeCapabilities),
+			Applications:                deploymentPlanApplicationsFromProto(req.Applications),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mrdspb.UpdateDeploymentPlanResponse{Record: s.ledgerRecordToProto(updateResponse.Record)}, nil
+}
+
+// GetSpecHistory retrieves the specs of a DeploymentPlan replaced by spec updates
+func (s *DeploymentPlanService) GetSpecHistory(ctx context.Context, req *mrdspb.GetDeploymentPlanSpecHistoryRequest) (*mrdspb.GetDeploymentPlanSpecHistoryResponse, error) {
+	historyResponse, err := s.ledger.GetSpecHistory(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []*mrdspb.DeploymentPlanSpecRevision
+	for _, revision := range historyResponse.Revisions {
+		revisions = append(revisions, &mrdspb.DeploymentPlanSpecRevision{
+			Version:                     revision.Version,
+			MatchingComputeCapabilities: deploymentPlanMatchingComputeCapabilitiesToProto(revision.Spec.MatchingComputeCapabilities),
+			Applications:                deploymentPlanApplicationsToProto(revision.Spec.Applications),
+			ReplacedAt:                  timestamppb.New(revision.ReplacedAt),
+		})
+	}
+	return &mrdspb.GetDeploymentPlanSpecHistoryResponse{Revisions: revisions}, nil
+}
+
+// Rollback adds a Deployment which runs the payload coordinates and instance count of an earlier Deployment
+func (s *DeploymentPlanService) Rollback(ctx context.Context, req *mrdspb.RollbackDeploymentRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	rollbackResponse, err := s.ledger.Rollback(ctx, &deploymentplan.RollbackRequest{
+		Metadata: core.Metadata{
+			ID:      req.Metadata.Id,
+			Version: req.Metadata.Version,
+		},
+		DeploymentID:   req.DeploymentId,
+		ToDeploymentID: req.ToDeploymentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mrdspb.UpdateDeploymentPlanResponse{Record: s.ledgerRecordToProto(rollbackResponse.Record)}, nil
+}
+
+// PauseDeployment pauses an in-progress Deployment
+func (s *DeploymentPlanService) PauseDeployment(ctx context.Context, req *mrdspb.PauseDeploymentRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	return s.changeDeploymentState(ctx, req.Metadata, req.DeploymentId, deploymentplan.DeploymentStatus{
+		State:   deploymentplan.DeploymentStatePaused,
+		Message: "Deployment is paused",
+	})
+}
+
+// ResumeDeployment resumes a paused Deployment
+func (s *DeploymentPlanService) ResumeDeployment(ctx context.Context, req *mrdspb.ResumeDeploymentRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	return s.changeDeploymentState(ctx, req.Metadata, req.DeploymentId, deploymentplan.DeploymentStatus{
+		State:   deploymentplan.DeploymentStateInProgress,
+		Message: "Deployment is running",
+	})
+}
+
+// AbortDeployment aborts a Deployment
+func (s *DeploymentPlanService) AbortDeployment(ctx context.Context, req *mrdspb.AbortDeploymentRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	return s.changeDeploymentState(ctx, req.Metadata, req.DeploymentId, deploymentplan.DeploymentStatus{
+		State:   deploymentplan.DeploymentStateCancelled,
+		Message: "Deployment is aborted",
+	})
+}
+
+// changeDeploymentState records the new state of the Deployment and notifies the workflow running it.
+func (s *DeploymentPlanService) changeDeploymentState(ctx context.Context, metadata *mrdspb.Metadata, deploymentID string, status deploymentplan.DeploymentStatus) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	updateResponse, err := s.ledger.UpdateDeploymentStatus(ctx, &deploymentplan.UpdateDeploymentStatusRequest{
+		Metadata: core.Metadata{
+			ID:      metadata.Id,
+			Version: metadata.Version,
+		},
+		DeploymentID: deploymentID,
+		Status:       status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	record := s.ledgerRecordToProto(updateResponse.Record)
+
+	if s.stateNotifier != nil {
+		for _, deployment := range record.Deployments {
+			if deployment.Id != deploymentID {
+				continue
+			}
+			// The state is already recorded, so a failed notification does not fail the update. The deployment
+			// workflow checks the ledger before every batch.
+			err := s.stateNotifier.NotifyDeploymentStateChanged(ctx, record, deployment)
+			if err != nil {
+				ctxslog.FromContext(ctx).Error("failed to notify deployment state change", "deploymentPlan", record.Name, "deploymentID", deployment.Id, "error", err)
+			}
+		}
+	}
+	return &mrdspb.UpdateDeploymentPlanResponse{Record: record}, nil
+}
+
 // UpdateDeploymentStatus updates the status of an existing Deployment
 func (s *DeploymentPlanService) UpdateDeploymentStatus(ctx context.Context, req *mrdspb.UpdateDeploymentStatusRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
 	updateResponse, err := s.ledger.UpdateDeploymentStatus(ctx, &deploymentplan.UpdateDeploymentStatusRequest{
@@ -338,3 +562,51 @@ func (s *DeploymentPlanService) UpdateDeploymentStatus(ctx context.Context, req
 	}
 	return &mrdspb.UpdateDeploymentPlanResponse{Record: s.ledgerRecordToProto(updateResponse.Record)}, nil
 }
+
+// UpdateDeploymentProgress records the rollout progress of an existing Deployment
+func (s *DeploymentPlanService) UpdateDeploymentProgress(ctx context.Context, req *mrdspb.UpdateDeploymentProgressRequest) (*mrdspb.UpdateDeploymentPlanResponse, error) {
+	updateResponse, err := s.ledger.UpdateDeploymentProgress(ctx, &deploymentplan.UpdateDeploymentProgressRequest{
+		Metadata: core.Metadata{
+			ID:      req.Metadata.Id,
+			Version: req.Metadata.Version,
+		},
+		DeploymentID: req.DeploymentId,
+		Progress: deploymentplan.RolloutProgress{
+			CompletedBatches: req.Progress.CompletedBatches,
+			TotalBatches:     req.Progress.TotalBatches,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mrdspb.UpdateDeploymentPlanResponse{Record: s.ledgerRecordToProto(updateResponse.Record)}, nil
+}
+
+// Watch streams the changes of the DeploymentPlans
+func (s *DeploymentPlanService) Watch(req *mrdspb.WatchDeploymentPlanRequest, stream grpc.ServerStreamingServer[mrdspb.WatchDeploymentPlanResponse]) error {
+	w := &watcher[deploymentplan.DeploymentPlanRecord]{
+		lastChangeSequence: s.ledger.LastChangeSequence,
+		listChanges:        s.ledger.ListChanges,
+		list: func(ctx context.Context, ids []string) ([]deploymentplan.DeploymentPlanRecord, error) {
+			listResponse, err := s.ledger.List(ctx, &deploymentplan.ListRequest{
+				Filters: deploymentplan.DeploymentPlanListFilters{
+					IDIn:           ids,
+					IncludeDeleted: len(ids) > 0,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return listResponse.Records, nil
+		},
+		metadata: func(record deploymentplan.DeploymentPlanRecord) core.Metadata { return record.Metadata },
+		send: func(sequence uint64, changeType core.ChangeType, record deploymentplan.DeploymentPlanRecord) error {
+			return stream.Send(&mrdspb.WatchDeploymentPlanResponse{
+				Sequence: sequence,
+				Type:     changeTypeToProto(changeType),
+				Record:   s.ledgerRecordToProto(record),
+			})
+		},
+	}
+	return w.run(stream.Context(), req.AfterSequence)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/grpcservers"
 	testserver "github.com/msanath/mrds/test/server"
 
 	"github.com/stretchr/testify/require"
@@ -20,9 +21,10 @@ func TestDeploymentPlanServer(t *testing.T) {
 	ctx := context.Background()
 
 	req := &mrdspb.CreateDeploymentPlanRequest{
-		Name:        "test-deployment-plan",
-		Namespace:   "test-namespace",
-		ServiceName: "test-service",
+		Name:             "test-deployment-plan",
+		Namespace:        "test-namespace",
+		ServiceName:      "test-service",
+		SchedulerProfile: "bin-packing",
 		Applications: []*mrdspb.Application{
 			{
 				PayloadName: "test-payload",
@@ -54,12 +56,14 @@ func TestDeploymentPlanServer(t *testing.T) {
 	require.Equal(t, "test-namespace", resp.Record.Namespace)
 	require.Equal(t, "test-service", resp.Record.ServiceName)
 	require.Len(t, resp.Record.Applications, 1)
+	require.Equal(t, "bin-packing", resp.Record.SchedulerProfile)
 
 	// get by id
 	getResp, err := client.GetByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: resp.Record.Metadata.Id})
 	require.NoError(t, err)
 	require.NotNil(t, getResp)
 	require.Equal(t, "test-deployment-plan", getResp.Record.Name)
+	require.Equal(t, "bin-packing", getResp.Record.SchedulerProfile)
 
 	// get by name
 	getByNameResp, err := client.GetByName(ctx, &mrdspb.GetDeploymentPlanByNameRequest{Name: "test-deployment-plan"})
@@ -80,6 +84,33 @@ func TestDeploymentPlanServer(t *testing.T) {
 	require.Equal(t, "test-deployment-plan", updateResp.Record.Name)
 	require.Equal(t, mrdspb.DeploymentPlanState_DeploymentPlanState_INACTIVE, updateResp.Record.Status.State)
 
+	// update spec
+	updateResp, err = client.UpdateSpec(ctx, &mrdspb.UpdateDeploymentPlanSpecRequest{
+		Metadata: updateResp.Record.Metadata,
+		Applications: []*mrdspb.Application{
+			{
+				PayloadName: "test-payload",
+				Resources: &mrdspb.ApplicationResources{
+					Cores:  2,
+					Memory: 2048,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, updateResp)
+	require.Len(t, updateResp.Record.Applications, 1)
+	require.Equal(t, uint32(2), updateResp.Record.Applications[0].Resources.Cores)
+	require.Empty(t, updateResp.Record.Applications[0].Ports)
+
+	// get spec history
+	historyResp, err := client.GetSpecHistory(ctx, &mrdspb.GetDeploymentPlanSpecHistoryRequest{Id: resp.Record.Metadata.Id})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Revisions, 1)
+	require.Equal(t, uint32(1), historyResp.Revisions[0].Applications[0].Resources.Cores)
+	require.Len(t, historyResp.Revisions[0].Applications[0].Ports, 1)
+	require.NotNil(t, historyResp.Revisions[0].ReplacedAt)
+
 	// Add deployment
 	updateResp, err = client.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
 		Metadata:     updateResp.Record.Metadata,
@@ -131,3 +162,83 @@ func TestDeploymentPlanServer(t *testing.T) {
 	_, err = client.GetByName(ctx, &mrdspb.GetDeploymentPlanByNameRequest{Name: "test-deployment-plan"})
 	require.Error(t, err)
 }
+
+type fakeDeploymentStateNotifier struct {
+	states []mrdspb.DeploymentState
+}
+
+func (n *fakeDeploymentStateNotifier) NotifyDeploymentStateChanged(ctx context.Context, deploymentPlan *mrdspb.DeploymentPlanRecord, deployment *mrdspb.Deployment) error {
+	n.states = append(n.states, deployment.Status.State)
+	return nil
+}
+
+func TestDeploymentPlanServerPauseResumeAbort(t *testing.T) {
+	notifier := &fakeDeploymentStateNotifier{}
+	ts, err := testserver.NewTestServer(testserver.WithDeploymentPlanServiceOptions(grpcservers.WithDeploymentStateNotifier(notifier)))
+	require.NoError(t, err)
+	defer ts.Close()
+
+	client := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	ctx := context.Background()
+
+	planResp, err := client.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "test-deployment-plan",
+		Namespace:   "test-namespace",
+		ServiceName: "test-service",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "test-payload", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 200}},
+		},
+	})
+	require.NoError(t, err)
+	updateResp, err := client.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "test-payload", Coordinates: map[string]string{"key": "value"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+
+	// A deployment which has not started can not be paused.
+	_, err = client.PauseDeployment(ctx, &mrdspb.PauseDeploymentRequest{
+		Metadata:     updateResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+	})
+	require.Error(t, err)
+	require.Empty(t, notifier.states)
+
+	updateResp, err = client.UpdateDeploymentStatus(ctx, &mrdspb.UpdateDeploymentStatusRequest{
+		Metadata:     updateResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+		Status:       &mrdspb.DeploymentStatus{State: mrdspb.DeploymentState_DeploymentState_IN_PROGRESS},
+	})
+	require.NoError(t, err)
+
+	updateResp, err = client.PauseDeployment(ctx, &mrdspb.PauseDeploymentRequest{
+		Metadata:     updateResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_PAUSED, updateResp.Record.Deployments[0].Status.State)
+
+	updateResp, err = client.ResumeDeployment(ctx, &mrdspb.ResumeDeploymentRequest{
+		Metadata:     updateResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_IN_PROGRESS, updateResp.Record.Deployments[0].Status.State)
+
+	updateResp, err = client.AbortDeployment(ctx, &mrdspb.AbortDeploymentRequest{
+		Metadata:     updateResp.Record.Metadata,
+		DeploymentId: "test-deployment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_CANCELLED, updateResp.Record.Deployments[0].Status.State)
+
+	require.Equal(t, []mrdspb.DeploymentState{
+		mrdspb.DeploymentState_DeploymentState_PAUSED,
+		mrdspb.DeploymentState_DeploymentState_IN_PROGRESS,
+		mrdspb.DeploymentState_DeploymentState_CANCELLED,
+	}, notifier.states)
+}
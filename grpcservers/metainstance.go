@@ -2,15 +2,26 @@ package grpcservers
 
 import (
 	"context"
+	"time"
 
 	"github.com/msanath/mrds/gen/api/mrdspb"
 	"github.com/msanath/mrds/ledger/core"
 	"github.com/msanath/mrds/ledger/metainstance"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// OperationApprovalNotifier is notified when an operation of a MetaInstance is APPROVED.
+type OperationApprovalNotifier interface {
+	NotifyOperationApproved(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) error
+}
+
 type MetaInstanceService struct {
 	ledger              metainstance.Ledger
 	ledgerRecordToProto func(record metainstance.MetaInstanceRecord) *mrdspb.MetaInstance
+	approvalNotifier    OperationApprovalNotifier
 
 	mrdspb.UnimplementedMetaInstancesServer
 }
@@ -38,11 +49,20 @@ func metaInstanceLedgerRecordToProto(record metainstance.MetaInstanceRecord) *mr
 			Status: &mrdspb.RuntimeInstanceStatus{
 				State:   mrdspb.RuntimeInstanceState(mrdspb.RuntimeInstanceState_value[string(runtimeInstance.Status.State)]),
 				Message: runtimeInstance.Status.Message,
+				Ready:   runtimeInstance.Status.Ready,
+			},
+			Resources: &mrdspb.RuntimeInstanceResources{
+				Cores:  runtimeInstance.Resources.Cores,
+				Memory: runtimeInstance.Resources.Memory,
 			},
 		})
 	}
 
 	for _, operation := range record.Operations {
+		var createdAt *timestamppb.Timestamp
+		if !operation.CreatedAt.IsZero() {
+			createdAt = timestamppb.New(operation.CreatedAt)
+		}
 		metaInstance.Operations = append(metaInstance.Operations, &mrdspb.Operation{
 			Id:       operation.ID,
 			Type:     mrdspb.OperationType(mrdspb.OperationType_value[string(operation.Type)]),
@@ -51,17 +71,31 @@ func metaInstanceLedgerRecordToProto(record metainstance.MetaInstanceRecord) *mr
 				State:   mrdspb.OperationState(mrdspb.OperationState_value[string(operation.Status.State)]),
 				Message: operation.Status.Message,
 			},
+			CreatedAt: createdAt,
 		})
 	}
 
 	return metaInstance
 }
 
-func NewMetaInstanceService(ledger metainstance.Ledger) *MetaInstanceService {
-	return &MetaInstanceService{
+type MetaInstanceServiceOption func(*MetaInstanceService)
+
+// WithOperationApprovalNotifier notifies the notifier whenever an operation is APPROVED.
+func WithOperationApprovalNotifier(notifier OperationApprovalNotifier) MetaInstanceServiceOption {
+	return func(s *MetaInstanceService) {
+		s.approvalNotifier = notifier
+	}
+}
+
+func NewMetaInstanceService(ledger metainstance.Ledger, opts ...MetaInstanceServiceOption) *MetaInstanceService {
+	s := &MetaInstanceService{
 		ledger:              ledger,
 		ledgerRecordToProto: metaInstanceLedgerRecordToProto,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Create creates a new MetaInstance
@@ -159,7 +193,9 @@ func (s *MetaInstanceService) List(ctx context.Context, req *mrdspb.ListMetaInst
 			StateIn:            stateIn,
 			DeploymentIDIn:     req.DeploymentIdIn,
 			DeploymentPlanIDIn: req.DeploymentPlanIdIn,
+			Limit:              req.Limit,
 		},
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, err
@@ -170,7 +206,11 @@ func (s *MetaInstanceService) List(ctx context.Context, req *mrdspb.ListMetaInst
 		records[i] = s.ledgerRecordToProto(record)
 	}
 
-	return &mrdspb.ListMetaInstanceResponse{Records: records}, nil
+	return &mrdspb.ListMetaInstanceResponse{
+		Records:       records,
+		NextPageToken: listResponse.NextPageToken,
+		TotalCount:    listResponse.TotalCount,
+	}, nil
 }
 
 // Delete deletes a MetaInstance
@@ -201,6 +241,7 @@ func (s *MetaInstanceService) AddRuntimeInstance(ctx context.Context, req *mrdsp
 			Status: metainstance.RuntimeInstanceStatus{
 				State:   metainstance.RuntimeInstanceState(req.RuntimeInstance.Status.State.String()),
 				Message: req.RuntimeInstance.Status.Message,
+				Ready:   req.RuntimeInstance.Status.Ready,
 			},
 		},
 	})
@@ -221,6 +262,7 @@ func (s *MetaInstanceService) UpdateRuntimeStatus(ctx context.Context, req *mrds
 		Status: metainstance.RuntimeInstanceStatus{
 			State:   metainstance.RuntimeInstanceState(req.Status.State.String()),
 			Message: req.Status.Message,
+			Ready:   req.Status.Ready,
 		},
 	})
 	if err != nil {
@@ -244,6 +286,32 @@ func (s *MetaInstanceService) UpdateRuntimeActiveState(ctx context.Context, req
 	return &mrdspb.UpdateMetaInstanceResponse{Record: s.ledgerRecordToProto(updateRuntimeActiveStateResponse.Record)}, nil
 }
 
+// SwitchActiveRuntimeInstances switches the active runtime instances of MetaInstances in a single transaction
+func (s *MetaInstanceService) SwitchActiveRuntimeInstances(ctx context.Context, req *mrdspb.SwitchActiveRuntimeInstancesRequest) (*mrdspb.SwitchActiveRuntimeInstancesResponse, error) {
+	var activeRuntimeInstances []metainstance.ActiveRuntimeInstance
+	for _, active := range req.ActiveRuntimeInstances {
+		activeRuntimeInstances = append(activeRuntimeInstances, metainstance.ActiveRuntimeInstance{
+			Metadata: core.Metadata{
+				ID:      active.Metadata.Id,
+				Version: active.Metadata.Version,
+			},
+			RuntimeInstanceID: active.RuntimeInstanceId,
+		})
+	}
+	switchResponse, err := s.ledger.SwitchActiveRuntimeInstances(ctx, &metainstance.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstances: activeRuntimeInstances,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*mrdspb.MetaInstance
+	for _, record := range switchResponse.Records {
+		records = append(records, s.ledgerRecordToProto(record))
+	}
+	return &mrdspb.SwitchActiveRuntimeInstancesResponse{Records: records}, nil
+}
+
 // RemoveRuntimeInstance removes a runtime instance from a MetaInstance
 func (s *MetaInstanceService) RemoveRuntimeInstance(ctx context.Context, req *mrdspb.RemoveRuntimeInstanceRequest) (*mrdspb.UpdateMetaInstanceResponse, error) {
 	removeRuntimeResponse, err := s.ledger.RemoveRuntimeInstance(ctx, &metainstance.RemoveRuntimeInstanceRequest{
@@ -261,6 +329,10 @@ func (s *MetaInstanceService) RemoveRuntimeInstance(ctx context.Context, req *mr
 
 // AddOperation adds an operation to a MetaInstance
 func (s *MetaInstanceService) AddOperation(ctx context.Context, req *mrdspb.AddOperationRequest) (*mrdspb.UpdateMetaInstanceResponse, error) {
+	var createdAt time.Time
+	if req.Operation.CreatedAt != nil {
+		createdAt = req.Operation.CreatedAt.AsTime()
+	}
 	addOperationResponse, err := s.ledger.AddOperation(ctx, &metainstance.AddOperationRequest{
 		Metadata: core.Metadata{
 			ID:      req.Metadata.Id,
@@ -274,6 +346,7 @@ func (s *MetaInstanceService) AddOperation(ctx context.Context, req *mrdspb.AddO
 				State:   metainstance.OperationState(req.Operation.Status.State.String()),
 				Message: req.Operation.Status.Message,
 			},
+			CreatedAt: createdAt,
 		},
 	})
 	if err != nil {
@@ -298,7 +371,22 @@ func (s *MetaInstanceService) UpdateOperationStatus(ctx context.Context, req *mr
 	if err != nil {
 		return nil, err
 	}
-	return &mrdspb.UpdateMetaInstanceResponse{Record: s.ledgerRecordToProto(updateOperationStatusResponse.Record)}, nil
+	record := s.ledgerRecordToProto(updateOperationStatusResponse.Record)
+
+	if s.approvalNotifier != nil && req.Status.State == mrdspb.OperationState_OperationState_APPROVED {
+		for _, operation := range record.Operations {
+			if operation.Id != req.OperationId {
+				continue
+			}
+			// The status is already recorded, so a failed notification does not fail the update. The
+			// operation workflow checks the ledger before giving up on the approval.
+			err := s.approvalNotifier.NotifyOperationApproved(ctx, record, operation)
+			if err != nil {
+				ctxslog.FromContext(ctx).Error("failed to notify operation approval", "metaInstance", record.Name, "operationID", operation.Id, "error", err)
+			}
+		}
+	}
+	return &mrdspb.UpdateMetaInstanceResponse{Record: record}, nil
 }
 
 // RemoveOperation removes an operation from a MetaInstance
@@ -315,3 +403,32 @@ func (s *MetaInstanceService) RemoveOperation(ctx context.Context, req *mrdspb.R
 	}
 	return &mrdspb.UpdateMetaInstanceResponse{Record: s.ledgerRecordToProto(removeOperationResponse.Record)}, nil
 }
+
+// Watch streams the changes of the MetaInstances
+func (s *MetaInstanceService) Watch(req *mrdspb.WatchMetaInstanceRequest, stream grpc.ServerStreamingServer[mrdspb.WatchMetaInstanceResponse]) error {
+	w := &watcher[metainstance.MetaInstanceRecord]{
+		lastChangeSequence: s.ledger.LastChangeSequence,
+		listChanges:        s.ledger.ListChanges,
+		list: func(ctx context.Context, ids []string) ([]metainstance.MetaInstanceRecord, error) {
+			listResponse, err := s.ledger.List(ctx, &metainstance.ListRequest{
+				Filters: metainstance.MetaInstanceListFilters{
+					IDIn:           ids,
+					IncludeDeleted: len(ids) > 0,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return listResponse.Records, nil
+		},
+		metadata: func(record metainstance.MetaInstanceRecord) core.Metadata { return record.Metadata },
+		send: func(sequence uint64, changeType core.ChangeType, record metainstance.MetaInstanceRecord) error {
+			return stream.Send(&mrdspb.WatchMetaInstanceResponse{
+				Sequence: sequence,
+				Type:     changeTypeToProto(changeType),
+				Record:   s.ledgerRecordToProto(record),
+			})
+		},
+	}
+	return w.run(stream.Context(), req.AfterSequence)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/grpcservers"
 	testserver "github.com/msanath/mrds/test/server"
 
 	"github.com/stretchr/testify/require"
@@ -233,3 +234,176 @@ func TestMetaInstanceServer(t *testing.T) {
 	_, err = client.Delete(ctx, &mrdspb.DeleteMetaInstanceRequest{Metadata: updateResp.Record.Metadata})
 	require.NoError(t, err)
 }
+
+type fakeApprovalNotifier struct {
+	approved []string
+}
+
+func (n *fakeApprovalNotifier) NotifyOperationApproved(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) error {
+	n.approved = append(n.approved, metaInstance.Name+"/"+operation.Id)
+	return nil
+}
+
+func TestMetaInstanceServerApprovalNotifier(t *testing.T) {
+	notifier := &fakeApprovalNotifier{}
+	ts, err := testserver.NewTestServer(testserver.WithMetaInstanceServiceOptions(grpcservers.WithOperationApprovalNotifier(notifier)))
+	require.NoError(t, err)
+	defer ts.Close()
+
+	client := mrdspb.NewMetaInstancesClient(ts.Conn())
+	ctx := context.Background()
+
+	deploymentPlanClient := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	planResp, err := deploymentPlanClient.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "test-deployment-plan",
+		Namespace:   "test-namespace",
+		ServiceName: "test-service",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "test-payload", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 200}},
+		},
+	})
+	require.NoError(t, err)
+	deploymentResp, err := deploymentPlanClient.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: uuid.New().String(),
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "test-payload", Coordinates: map[string]string{"key": "value"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+
+	resp, err := client.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             "test-metaInstance",
+		DeploymentPlanId: planResp.Record.Metadata.Id,
+		DeploymentId:     deploymentResp.Record.Deployments[0].Id,
+	})
+	require.NoError(t, err)
+	updateResp, err := client.AddOperation(ctx, &mrdspb.AddOperationRequest{
+		Metadata: resp.Record.Metadata,
+		Operation: &mrdspb.Operation{
+			Id:       "test-operation",
+			Type:     mrdspb.OperationType_OperationType_CREATE,
+			IntentId: "test-intent",
+			Status:   &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_PENDING},
+		},
+	})
+	require.NoError(t, err)
+
+	// Only approvals are notified.
+	updateResp, err = client.UpdateOperationStatus(ctx, &mrdspb.UpdateOperationStatusRequest{
+		Metadata:    updateResp.Record.Metadata,
+		OperationId: "test-operation",
+		Status:      &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_PENDING_APPROVAL},
+	})
+	require.NoError(t, err)
+	require.Empty(t, notifier.approved)
+
+	_, err = client.UpdateOperationStatus(ctx, &mrdspb.UpdateOperationStatusRequest{
+		Metadata:    updateResp.Record.Metadata,
+		OperationId: "test-operation",
+		Status:      &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_APPROVED},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-metaInstance/test-operation"}, notifier.approved)
+}
+
+func TestMetaInstanceServerSwitchActiveRuntimeInstances(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	client := mrdspb.NewMetaInstancesClient(ts.Conn())
+	nodesClient := mrdspb.NewNodesClient(ts.Conn())
+	deploymentPlanClient := mrdspb.NewDeploymentPlansClient(ts.Conn())
+	ctx := context.Background()
+
+	planResp, err := deploymentPlanClient.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        "test-deployment-plan",
+		Namespace:   "test-namespace",
+		ServiceName: "test-service",
+		Applications: []*mrdspb.Application{
+			{PayloadName: "test-payload", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+	})
+	require.NoError(t, err)
+	_, err = deploymentPlanClient.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-1",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "test-payload", Coordinates: map[string]string{"image": "nginx:latest"}},
+		},
+		InstanceCount: 2,
+	})
+	require.NoError(t, err)
+
+	// Each meta instance has an active blue and a passive green runtime instance.
+	var metaInstances []*mrdspb.MetaInstance
+	for _, name := range []string{"instance-1", "instance-2"} {
+		createResp, err := client.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+			Name:             name,
+			DeploymentPlanId: planResp.Record.Metadata.Id,
+			DeploymentId:     "deployment-1",
+		})
+		require.NoError(t, err)
+		record := createResp.Record
+		for _, color := range []string{"blue", "green"} {
+			nodeResp, err := nodesClient.Create(ctx, &mrdspb.CreateNodeRequest{
+				Name:                    name + "-" + color,
+				UpdateDomain:            "ud-1",
+				TotalResources:          &mrdspb.Resources{Cores: 4, Memory: 4096},
+				SystemReservedResources: &mrdspb.Resources{},
+			})
+			require.NoError(t, err)
+			updateResp, err := client.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+				Metadata: record.Metadata,
+				RuntimeInstance: &mrdspb.RuntimeInstance{
+					Id:       name + "-" + color,
+					NodeId:   nodeResp.Record.Metadata.Id,
+					IsActive: color == "blue",
+					Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+				},
+			})
+			require.NoError(t, err)
+			record = updateResp.Record
+		}
+		metaInstances = append(metaInstances, record)
+	}
+
+	// A missing runtime instance fails the switch of all the meta instances.
+	_, err = client.SwitchActiveRuntimeInstances(ctx, &mrdspb.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstances: []*mrdspb.ActiveRuntimeInstance{
+			{Metadata: metaInstances[0].Metadata, RuntimeInstanceId: "instance-1-green"},
+			{Metadata: metaInstances[1].Metadata, RuntimeInstanceId: "instance-2-unknown"},
+		},
+	})
+	require.Error(t, err)
+
+	// A stale version fails the switch of all the meta instances.
+	staleMetadata := &mrdspb.Metadata{Id: metaInstances[1].Metadata.Id, Version: metaInstances[1].Metadata.Version - 1}
+	_, err = client.SwitchActiveRuntimeInstances(ctx, &mrdspb.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstances: []*mrdspb.ActiveRuntimeInstance{
+			{Metadata: metaInstances[0].Metadata, RuntimeInstanceId: "instance-1-green"},
+			{Metadata: staleMetadata, RuntimeInstanceId: "instance-2-green"},
+		},
+	})
+	require.Error(t, err)
+	getResp, err := client.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstances[0].Metadata.Id})
+	require.NoError(t, err)
+	require.Equal(t, metaInstances[0].Metadata.Version, getResp.Record.Metadata.Version)
+
+	switchResp, err := client.SwitchActiveRuntimeInstances(ctx, &mrdspb.SwitchActiveRuntimeInstancesRequest{
+		ActiveRuntimeInstances: []*mrdspb.ActiveRuntimeInstance{
+			{Metadata: metaInstances[0].Metadata, RuntimeInstanceId: "instance-1-green"},
+			{Metadata: metaInstances[1].Metadata, RuntimeInstanceId: "instance-2-green"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, switchResp.Records, 2)
+	for _, record := range switchResp.Records {
+		require.Len(t, record.RuntimeInstances, 2)
+		for _, runtimeInstance := range record.RuntimeInstances {
+			require.Equal(t, runtimeInstance.Id == record.Name+"-green", runtimeInstance.IsActive)
+		}
+	}
+}
@@ -3,10 +3,14 @@ package grpcservers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/msanath/mrds/gen/api/mrdspb"
 	"github.com/msanath/mrds/ledger/core"
 	"github.com/msanath/mrds/ledger/node"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type NodeService struct {
@@ -45,9 +49,14 @@ func nodeLedgerRecordToProto(record node.NodeRecord) *mrdspb.Node {
 	}
 
 	for _, disruption := range record.Disruptions {
+		var startTime *timestamppb.Timestamp
+		if disruption.StartTime.Unix() > 0 {
+			startTime = timestamppb.New(disruption.StartTime)
+		}
 		node.Disruptions = append(node.Disruptions, &mrdspb.NodeDisruption{
 			Id:          disruption.ID,
 			ShouldEvict: disruption.ShouldEvict,
+			StartTime:   startTime,
 			Status: &mrdspb.DisruptionStatus{
 				State:   mrdspb.DisruptionState(mrdspb.DisruptionState_value[string(disruption.Status.State)]),
 				Message: disruption.Status.Message,
@@ -57,9 +66,10 @@ func nodeLedgerRecordToProto(record node.NodeRecord) *mrdspb.Node {
 
 	for _, localVolume := range record.LocalVolumes {
 		node.LocalVolumes = append(node.LocalVolumes, &mrdspb.NodeLocalVolume{
-			MountPath:       localVolume.MountPath,
-			StorageClass:    localVolume.StorageClass,
-			StorageCapacity: localVolume.StorageCapacity,
+			MountPath:         localVolume.MountPath,
+			StorageClass:      localVolume.StorageClass,
+			StorageCapacity:   localVolume.StorageCapacity,
+			RemainingCapacity: localVolume.RemainingCapacity,
 		})
 	}
 
@@ -145,6 +155,32 @@ func (s *NodeService) UpdateStatus(ctx context.Context, req *mrdspb.UpdateNodeSt
 	return &mrdspb.UpdateNodeResponse{Record: s.ledgerRecordToProto(updateResponse.Record)}, nil
 }
 
+// UpdateSpec updates the update domain and the resources of an existing Node
+func (s *NodeService) UpdateSpec(ctx context.Context, req *mrdspb.UpdateNodeSpecRequest) (*mrdspb.UpdateNodeResponse, error) {
+	if req.TotalResources == nil || req.SystemReservedResources == nil {
+		return nil, fmt.Errorf("TotalResources and SystemReservedResources are required")
+	}
+	updateResponse, err := s.ledger.UpdateSpec(ctx, &node.UpdateSpecRequest{
+		Metadata: core.Metadata{
+			ID:      req.Metadata.Id,
+			Version: req.Metadata.Version,
+		},
+		UpdateDomain: req.UpdateDomain,
+		TotalResources: node.Resources{
+			Cores:  req.TotalResources.Cores,
+			Memory: req.TotalResources.Memory,
+		},
+		SystemReservedResources: node.Resources{
+			Cores:  req.SystemReservedResources.Cores,
+			Memory: req.SystemReservedResources.Memory,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mrdspb.UpdateNodeResponse{Record: s.ledgerRecordToProto(updateResponse.Record)}, nil
+}
+
 // List returns a list of Nodes that match the provided filters
 func (s *NodeService) List(ctx context.Context, req *mrdspb.ListNodeRequest) (*mrdspb.ListNodeResponse, error) {
 	if req == nil {
@@ -187,6 +223,20 @@ func (s *NodeService) List(ctx context.Context, req *mrdspb.ListNodeRequest) (*m
 		stateNotIn[i] = node.NodeState(state.String())
 	}
 
+	portsNotInUse := make([]node.Port, len(req.PortsNotInUse))
+	for i, port := range req.PortsNotInUse {
+		portsNotInUse[i] = node.Port{
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		}
+	}
+
+	var noDisruptionStartingBefore *time.Time
+	if req.NoDisruptionStartingBefore != nil {
+		t := req.NoDisruptionStartingBefore.AsTime()
+		noDisruptionStartingBefore = &t
+	}
+
 	listResponse, err := s.ledger.List(ctx, &node.ListRequest{
 		Filters: node.NodeListFilters{
 			IDIn:               req.IdIn,
@@ -204,7 +254,13 @@ func (s *NodeService) List(ctx context.Context, req *mrdspb.ListNodeRequest) (*m
 			UpdateDomainIn:     req.UpdateDomainIn,
 			PayloadNameIn:      req.PayloadNameIn,
 			PayloadNameNotIn:   req.PayloadNameNotIn,
+			PortsNotInUse:      portsNotInUse,
+
+			NoActiveDisruption:         req.NoActiveDisruption,
+			NoDisruptionStartingBefore: noDisruptionStartingBefore,
+			Limit:                      req.Limit,
 		},
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, err
@@ -215,7 +271,11 @@ func (s *NodeService) List(ctx context.Context, req *mrdspb.ListNodeRequest) (*m
 		records[i] = s.ledgerRecordToProto(record)
 	}
 
-	return &mrdspb.ListNodeResponse{Records: records}, nil
+	return &mrdspb.ListNodeResponse{
+		Records:       records,
+		NextPageToken: listResponse.NextPageToken,
+		TotalCount:    listResponse.TotalCount,
+	}, nil
 }
 
 // Delete deletes a Node
@@ -234,6 +294,10 @@ func (s *NodeService) Delete(ctx context.Context, req *mrdspb.DeleteNodeRequest)
 
 // AddDisruption adds a disruption to a Node
 func (s *NodeService) AddDisruption(ctx context.Context, req *mrdspb.AddDisruptionRequest) (*mrdspb.UpdateNodeResponse, error) {
+	var startTime time.Time
+	if req.Disruption.StartTime != nil {
+		startTime = req.Disruption.StartTime.AsTime()
+	}
 	addDisruptionResponse, err := s.ledger.AddDisruption(ctx, &node.AddDisruptionRequest{
 		Metadata: core.Metadata{
 			ID:      req.Metadata.Id,
@@ -242,6 +306,7 @@ func (s *NodeService) AddDisruption(ctx context.Context, req *mrdspb.AddDisrupti
 		Disruption: node.Disruption{
 			ID:          req.Disruption.Id,
 			ShouldEvict: req.Disruption.ShouldEvict,
+			StartTime:   startTime,
 			Status: node.DisruptionStatus{
 				State:   node.DisruptionState(req.Disruption.Status.State.String()),
 				Message: req.Disruption.Status.Message,
@@ -314,3 +379,32 @@ func (s *NodeService) RemoveCapability(ctx context.Context, req *mrdspb.RemoveCa
 	}
 	return &mrdspb.UpdateNodeResponse{Record: s.ledgerRecordToProto(removeCapabilityResponse.Record)}, nil
 }
+
+// Watch streams the changes of the Nodes
+func (s *NodeService) Watch(req *mrdspb.WatchNodeRequest, stream grpc.ServerStreamingServer[mrdspb.WatchNodeResponse]) error {
+	w := &watcher[node.NodeRecord]{
+		lastChangeSequence: s.ledger.LastChangeSequence,
+		listChanges:        s.ledger.ListChanges,
+		list: func(ctx context.Context, ids []string) ([]node.NodeRecord, error) {
+			listResponse, err := s.ledger.List(ctx, &node.ListRequest{
+				Filters: node.NodeListFilters{
+					IDIn:           ids,
+					IncludeDeleted: len(ids) > 0,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return listResponse.Records, nil
+		},
+		metadata: func(record node.NodeRecord) core.Metadata { return record.Metadata },
+		send: func(sequence uint64, changeType core.ChangeType, record node.NodeRecord) error {
+			return stream.Send(&mrdspb.WatchNodeResponse{
+				Sequence: sequence,
+				Type:     changeTypeToProto(changeType),
+				Record:   s.ledgerRecordToProto(record),
+			})
+		},
+	}
+	return w.run(stream.Context(), req.AfterSequence)
+}
@@ -0,0 +1,112 @@
+package grpcservers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/ledger/core"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// watchPollInterval is how often a watch polls the change log once it caught up.
+	watchPollInterval = time.Second
+	// watchBatchSize is the maximum number of changes a watch reads from the change log at once.
+	watchBatchSize = 100
+)
+
+// watcher streams the changes of a kind of resource, where R is its ledger record.
+type watcher[R any] struct {
+	lastChangeSequence func(ctx context.Context) (uint64, error)
+	listChanges        func(ctx context.Context, req *core.ListChangesRequest) (*core.ListChangesResponse, error)
+	// list returns the records with the given IDs including the deleted ones, or all the current records
+	// when no IDs are given.
+	list     func(ctx context.Context, ids []string) ([]R, error)
+	metadata func(record R) core.Metadata
+	send     func(sequence uint64, changeType core.ChangeType, record R) error
+}
+
+// run sends the changes after the given sequence until the context is cancelled. When the sequence is 0,
+// the current records are sent first as CREATE changes with sequence 0. When changes after the sequence were
+// pruned from the change log, run fails with the OutOfRange code, and the watch must be started again from 0.
+//
+// A change is sent with the record it produced. When the record changed again before it was read, the
+// change is skipped since the later change follows it.
+func (w *watcher[R]) run(ctx context.Context, afterSequence uint64) error {
+	if afterSequence == 0 {
+		// The sequence is read before the records so that no change made while listing them is missed.
+		sequence, err := w.lastChangeSequence(ctx)
+		if err != nil {
+			return err
+		}
+		records, err := w.list(ctx, nil)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			err := w.send(0, core.ChangeTypeCreate, record)
+			if err != nil {
+				return err
+			}
+		}
+		afterSequence = sequence
+	}
+
+	for {
+		changesResp, err := w.listChanges(ctx, &core.ListChangesRequest{
+			AfterSequence: afterSequence,
+			Limit:         watchBatchSize,
+		})
+		if err != nil {
+			if errors.Is(err, core.ErrChangesPruned) {
+				return status.Error(codes.OutOfRange, err.Error())
+			}
+			return err
+		}
+		if len(changesResp.Changes) == 0 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(watchPollInterval):
+			}
+			continue
+		}
+
+		var ids []string
+		seen := make(map[string]bool)
+		for _, change := range changesResp.Changes {
+			if !seen[change.RecordID] {
+				seen[change.RecordID] = true
+				ids = append(ids, change.RecordID)
+			}
+		}
+		records, err := w.list(ctx, ids)
+		if err != nil {
+			return err
+		}
+		recordsByID := make(map[string]R, len(records))
+		for _, record := range records {
+			recordsByID[w.metadata(record).ID] = record
+		}
+
+		for _, change := range changesResp.Changes {
+			afterSequence = change.Sequence
+			record, ok := recordsByID[change.RecordID]
+			if !ok || w.metadata(record).Version != change.Version {
+				continue
+			}
+			err := w.send(change.Sequence, change.Type, record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func changeTypeToProto(changeType core.ChangeType) mrdspb.ChangeType {
+	return mrdspb.ChangeType(mrdspb.ChangeType_value[string(changeType)])
+}
@@ -0,0 +1,82 @@
+package grpcservers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeWatch(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Close()
+
+	client := mrdspb.NewNodesClient(ts.Conn())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createNode := func(name string) *mrdspb.Node {
+		resp, err := client.Create(ctx, &mrdspb.CreateNodeRequest{
+			Name:                    name,
+			UpdateDomain:            "test-domain",
+			TotalResources:          &mrdspb.Resources{Cores: 64, Memory: 512},
+			SystemReservedResources: &mrdspb.Resources{Cores: 4, Memory: 32},
+		})
+		require.NoError(t, err)
+		return resp.Record
+	}
+	node1 := createNode("node-1")
+
+	// The stream starts with the current nodes.
+	stream, err := client.Watch(ctx, &mrdspb.WatchNodeRequest{})
+	require.NoError(t, err)
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), event.Sequence)
+	require.Equal(t, mrdspb.ChangeType_ChangeType_CREATE, event.Type)
+	require.Equal(t, node1.Metadata.Id, event.Record.Metadata.Id)
+
+	// Followed by the changes made after them.
+	node2 := createNode("node-2")
+	created, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.ChangeType_ChangeType_CREATE, created.Type)
+	require.Equal(t, node2.Metadata.Id, created.Record.Metadata.Id)
+
+	updateResp, err := client.UpdateStatus(ctx, &mrdspb.UpdateNodeStatusRequest{
+		Metadata:  node1.Metadata,
+		Status:    &mrdspb.NodeStatus{State: mrdspb.NodeState_NodeState_ALLOCATING},
+		ClusterId: "test-cluster",
+	})
+	require.NoError(t, err)
+	_, err = client.Delete(ctx, &mrdspb.DeleteNodeRequest{Metadata: node2.Metadata})
+	require.NoError(t, err)
+
+	events := []*mrdspb.WatchNodeResponse{created}
+	for len(events) < 3 {
+		event, err := stream.Recv()
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.Equal(t, mrdspb.ChangeType_ChangeType_UPDATE, events[1].Type)
+	require.Equal(t, updateResp.Record.Metadata.Version, events[1].Record.Metadata.Version)
+	require.Equal(t, mrdspb.NodeState_NodeState_ALLOCATING, events[1].Record.Status.State)
+	require.Equal(t, mrdspb.ChangeType_ChangeType_DELETE, events[2].Type)
+	require.Equal(t, node2.Metadata.Id, events[2].Record.Metadata.Id)
+	require.Less(t, events[0].Sequence, events[1].Sequence)
+	require.Less(t, events[1].Sequence, events[2].Sequence)
+
+	// A watch resumed after a sequence only sees the later changes.
+	resumed, err := client.Watch(ctx, &mrdspb.WatchNodeRequest{AfterSequence: events[0].Sequence})
+	require.NoError(t, err)
+	for _, expect := range events[1:] {
+		event, err := resumed.Recv()
+		require.NoError(t, err)
+		require.Equal(t, expect.Sequence, event.Sequence)
+		require.Equal(t, expect.Type, event.Type)
+	}
+}
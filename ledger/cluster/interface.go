@@ -11,6 +11,9 @@ type ClusterRecord struct {
 	Metadata core.Metadata // Metadata is the metadata that identifies the Cluster. It is a combination of the Cluster's name and version.
 	Name     string        // Name is the name of the Cluster.
 	Status   ClusterStatus // Status is the status of the Cluster.
+
+	RuntimeType   string            // RuntimeType selects the runtime of the Cluster. The default runtime is used when empty.
+	RuntimeConfig map[string]string // RuntimeConfig is the connection config of the runtime.
 }
 
 // ClusterState is the state of a Cluster.
@@ -65,7 +68,9 @@ type Ledger interface {
 
 // CreateRequest represents the Cluster creation request.
 type CreateRequest struct {
-	Name string
+	Name          string
+	RuntimeType   string
+	RuntimeConfig map[string]string
 }
 
 // CreateResponse represents the response after creating a new Cluster.
@@ -91,7 +96,8 @@ type UpdateResponse struct {
 
 // ListRequest represents the request to list Clusters with filters.
 type ListRequest struct {
-	Filters ClusterListFilters
+	Filters   ClusterListFilters
+	PageToken string // PageToken is the NextPageToken of the previous page, empty for the first page.
 }
 
 // ClusterFilters contains filters for querying the Cluster table.
@@ -103,7 +109,7 @@ type ClusterListFilters struct {
 	VersionEq  *uint64  // Equal condition
 
 	IncludeDeleted bool   // IncludeDeleted indicates whether to include soft-deleted records in the result.
-	Limit          uint32 // Limit is the maximum number of records to return.
+	Limit          uint32 // Limit is the maximum number of records in a page. All records are returned when 0.
 
 	StateIn    []ClusterState
 	StateNotIn []ClusterState
@@ -111,7 +117,9 @@ type ClusterListFilters struct {
 
 // ListResponse represents the response to a list request.
 type ListResponse struct {
-	Records []ClusterRecord
+	Records       []ClusterRecord // Records are ordered by name and ID.
+	NextPageToken string          // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64          // TotalCount is the number of records matching the filters across all pages.
 }
 
 type DeleteRequest struct {
@@ -22,7 +22,7 @@ type Repository interface {
 	GetByName(context.Context, string) (ClusterRecord, error)
 	UpdateStatus(context.Context, core.Metadata, ClusterStatus) error
 	Delete(context.Context, core.Metadata) error
-	List(context.Context, ClusterListFilters) ([]ClusterRecord, error)
+	List(ctx context.Context, filters ClusterListFilters, pageToken string) ([]ClusterRecord, core.PageInfo, error)
 }
 
 // NewLedger creates a new Ledger instance.
@@ -50,6 +50,8 @@ func (l *ledger) Create(ctx context.Context, req *CreateRequest) (*CreateRespons
 			State:   ClusterStatePending,
 			Message: "",
 		},
+		RuntimeType:   req.RuntimeType,
+		RuntimeConfig: req.RuntimeConfig,
 	}
 
 	err := l.repo.Insert(ctx, rec)
@@ -167,13 +169,15 @@ func (l *ledger) UpdateStatus(ctx context.Context, req *UpdateStateRequest) (*Up
 
 // List returns a list of Clusters that match the provided filters.
 func (l *ledger) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	records, err := l.repo.List(ctx, req.Filters)
+	records, page, err := l.repo.List(ctx, req.Filters, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ListResponse{
-		Records: records,
+		Records:       records,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -96,7 +96,8 @@ type UpdateResponse struct {
 
 // ListRequest represents the request to list ComputeCapabilitys with filters.
 type ListRequest struct {
-	Filters ComputeCapabilityListFilters
+	Filters   ComputeCapabilityListFilters
+	PageToken string // PageToken is the NextPageToken of the previous page, empty for the first page.
 }
 
 // ComputeCapabilityFilters contains filters for querying the ComputeCapability table.
@@ -108,7 +109,7 @@ type ComputeCapabilityListFilters struct {
 	VersionEq  *uint64  // Equal condition
 
 	IncludeDeleted bool   // IncludeDeleted indicates whether to include soft-deleted records in the result.
-	Limit          uint32 // Limit is the maximum number of records to return.
+	Limit          uint32 // Limit is the maximum number of records in a page. All records are returned when 0.
 
 	StateIn    []ComputeCapabilityState
 	StateNotIn []ComputeCapabilityState
@@ -117,7 +118,9 @@ type ComputeCapabilityListFilters struct {
 
 // ListResponse represents the response to a list request.
 type ListResponse struct {
-	Records []ComputeCapabilityRecord
+	Records       []ComputeCapabilityRecord // Records are ordered by name and ID.
+	NextPageToken string                    // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64                    // TotalCount is the number of records matching the filters across all pages.
 }
 
 type DeleteRequest struct {
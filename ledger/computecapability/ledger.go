@@ -22,7 +22,7 @@ type Repository interface {
 	GetByName(context.Context, string) (ComputeCapabilityRecord, error)
 	UpdateState(context.Context, core.Metadata, ComputeCapabilityStatus) error
 	Delete(context.Context, core.Metadata) error
-	List(context.Context, ComputeCapabilityListFilters) ([]ComputeCapabilityRecord, error)
+	List(ctx context.Context, filters ComputeCapabilityListFilters, pageToken string) ([]ComputeCapabilityRecord, core.PageInfo, error)
 }
 
 // NewLedger creates a new Ledger instance.
@@ -169,13 +169,15 @@ func (l *ledger) UpdateStatus(ctx context.Context, req *UpdateStateRequest) (*Up
 
 // List returns a list of ComputeCapabilitys that match the provided filters.
 func (l *ledger) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	records, err := l.repo.List(ctx, req.Filters)
+	records, page, err := l.repo.List(ctx, req.Filters, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ListResponse{
-		Records: records,
+		Records:       records,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -0,0 +1,35 @@
+package core
+
+import "errors"
+
+// ErrChangesPruned is returned when changes after the requested sequence were pruned from the change log.
+// The records must be listed again to resume from their current state.
+var ErrChangesPruned = errors.New("changes after the sequence were pruned from the change log")
+
+// ChangeType is the type of a change of a resource.
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "ChangeType_CREATE"
+	ChangeTypeUpdate ChangeType = "ChangeType_UPDATE"
+	ChangeTypeDelete ChangeType = "ChangeType_DELETE"
+)
+
+// Change is an entry of the change log of the resources managed by the API server.
+type Change struct {
+	Sequence uint64     // Sequence orders the changes of all resources, in the order they were committed.
+	RecordID string     // RecordID is the ID of the changed resource.
+	Version  uint64     // Version is the version of the resource after the change.
+	Type     ChangeType // Type is the type of the change.
+}
+
+// ListChangesRequest represents the request to list the changes of a kind of resource.
+type ListChangesRequest struct {
+	AfterSequence uint64 // AfterSequence selects the changes with a greater sequence.
+	Limit         uint32 // Limit is the maximum number of changes to return.
+}
+
+// ListChangesResponse represents the response to a list changes request.
+type ListChangesResponse struct {
+	Changes []Change // Changes are ordered by their sequence.
+}
@@ -0,0 +1,7 @@
+package core
+
+// PageInfo describes a page of the records returned by a list.
+type PageInfo struct {
+	NextPageToken string // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64 // TotalCount is the number of records matching the filters across all pages.
+}
@@ -2,6 +2,7 @@ package deploymentplan
 
 import (
 	"context"
+	"time"
 
 	"github.com/msanath/mrds/ledger/core"
 )
@@ -16,6 +17,7 @@ type DeploymentPlanRecord struct {
 	ServiceName                 string                      // ServiceName is the name of the service associated with the Deployment. Certs will be issued for this service.
 	MatchingComputeCapabilities []MatchingComputeCapability // MatchingCapabilities is a list of capabilities that the Deployment requires.
 	Applications                []Application               // Applications is a list of applications that the Deployment requires.
+	SchedulerProfile            string                      // SchedulerProfile is the name of the scheduler profile used to place instances. Empty means the default.
 
 	Deployments []Deployment // Deployment is an instantiation of the DeploymentPlan.
 }
@@ -34,6 +36,19 @@ const (
 	DeploymentPlanStateInactive DeploymentPlanState = "DeploymentPlanState_INACTIVE"
 )
 
+// DeploymentPlanSpec is the part of a DeploymentPlan which can be updated after it is created.
+type DeploymentPlanSpec struct {
+	MatchingComputeCapabilities []MatchingComputeCapability // MatchingCapabilities is a list of capabilities that the Deployment requires.
+	Applications                []Application               // Applications is a list of applications that the Deployment requires.
+}
+
+// SpecRevision is a spec of a DeploymentPlan which was replaced by a spec update.
+type SpecRevision struct {
+	Version    uint64             // Version is the version of the DeploymentPlan when the spec was replaced.
+	Spec       DeploymentPlanSpec // Spec is the replaced spec.
+	ReplacedAt time.Time          // ReplacedAt is the time at which the spec was replaced.
+}
+
 type MatchingComputeCapability struct {
 	CapabilityType  string
 	Comparator      ComparatorType
@@ -54,6 +69,8 @@ type Application struct {
 	Resources         ApplicationResources
 	Ports             []ApplicationPort
 	PersistentVolumes []ApplicationPersistentVolume
+	LivenessProbe     *Probe // LivenessProbe restarts the application when it fails. Not checked when nil.
+	ReadinessProbe    *Probe // ReadinessProbe marks the instance as ready when it passes. Ready once running when nil.
 }
 
 type ApplicationResources struct {
@@ -72,11 +89,67 @@ type ApplicationPersistentVolume struct {
 	MountPath    string
 }
 
+// Probe is a health check of an application, which runtimes translate into their native mechanism.
+type Probe struct {
+	Type                ProbeType // Type is the kind of check.
+	Path                string    // Path is the path requested by an HTTP probe.
+	Port                uint32    // Port is the port checked by an HTTP or TCP probe.
+	Command             []string  // Command is the command run by an EXEC probe.
+	InitialDelaySeconds uint32    // InitialDelaySeconds is the time after the start before the first check.
+	PeriodSeconds       uint32    // PeriodSeconds is the time between consecutive checks.
+	TimeoutSeconds      uint32    // TimeoutSeconds is the time after which a check fails.
+	FailureThreshold    uint32    // FailureThreshold is the number of consecutive failed checks which fail the probe.
+}
+
+type ProbeType string
+
+const (
+	ProbeTypeHTTP ProbeType = "ProbeType_HTTP"
+	ProbeTypeTCP  ProbeType = "ProbeType_TCP"
+	ProbeTypeExec ProbeType = "ProbeType_EXEC"
+)
+
 type Deployment struct {
 	ID                 string               // ID is the ID of the DeploymentPlan.
 	Status             DeploymentStatus     // Status is the status of the Deployment.
 	PayloadCoordinates []PayloadCoordinates // PayloadCoordinates is a list of coordinates for the payloads that the Deployment requires.
 	InstanceCount      uint32               // InstanceCount is the number of instances of the Deployment.
+	RolloutStrategy    RolloutStrategy      // RolloutStrategy is how the Deployment is rolled out to the instances.
+	RolloutProgress    RolloutProgress      // RolloutProgress is the progress of the rollout.
+	Canary             Canary               // Canary is the canary phase of the rollout.
+}
+
+// Canary defines the instances updated first to verify a Deployment.
+type Canary struct {
+	InstanceCount         uint32 // InstanceCount is the number of instances in the canary.
+	Percentage            uint32 // Percentage is the percentage of the updated instances in the canary.
+	BakeSeconds           uint32 // BakeSeconds is the time the canaries run before they are analyzed.
+	MaxUnhealthyInstances uint32 // MaxUnhealthyInstances is the number of canaries which may be unhealthy.
+}
+
+// RolloutStrategyType is the type of a rollout.
+type RolloutStrategyType string
+
+const (
+	RolloutStrategyTypeUpdateDomain RolloutStrategyType = "RolloutStrategyType_UPDATE_DOMAIN"
+	RolloutStrategyTypeRolling      RolloutStrategyType = "RolloutStrategyType_ROLLING"
+	RolloutStrategyTypeBlueGreen    RolloutStrategyType = "RolloutStrategyType_BLUE_GREEN"
+)
+
+// RolloutStrategy defines how the operations of a Deployment are batched and executed.
+type RolloutStrategy struct {
+	Type           RolloutStrategyType // Type is the type of the rollout.
+	MaxUnavailable uint32              // MaxUnavailable is the maximum number of running instances disrupted in a batch.
+	MaxSurge       uint32              // MaxSurge is the maximum number of instances created in a batch.
+	BatchSize      uint32              // BatchSize is the maximum number of operations in a batch.
+	PauseSeconds   uint32              // PauseSeconds is the pause between consecutive batches.
+	HoldSeconds    uint32              // HoldSeconds is how long the previous instances are kept after a blue-green switch.
+}
+
+// RolloutProgress tracks the batches of a Deployment which have been executed.
+type RolloutProgress struct {
+	CompletedBatches uint32 // CompletedBatches is the number of batches which have completed.
+	TotalBatches     uint32 // TotalBatches is the number of batches in the rollout.
 }
 
 // DeploymentState is the state of a Deployment.
@@ -116,9 +189,22 @@ type Ledger interface {
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Delete soft-deletes a Deployment.
 	Delete(context.Context, *DeleteRequest) error
+	// UpdateSpec replaces the applications and matching capabilities of a DeploymentPlan. Applications may be
+	// added and removed. The replaced spec is kept in the spec history.
+	UpdateSpec(context.Context, *UpdateSpecRequest) (*UpdateResponse, error)
+	// GetSpecHistory returns the specs of a DeploymentPlan replaced by spec updates, oldest first.
+	GetSpecHistory(context.Context, string) (*GetSpecHistoryResponse, error)
 
 	AddDeployment(context.Context, *AddDeploymentRequest) (*UpdateResponse, error)
 	UpdateDeploymentStatus(context.Context, *UpdateDeploymentStatusRequest) (*UpdateResponse, error)
+	UpdateDeploymentProgress(context.Context, *UpdateDeploymentProgressRequest) (*UpdateResponse, error)
+	// Rollback adds a Deployment with the payload coordinates and instance count of an earlier completed Deployment.
+	Rollback(context.Context, *RollbackRequest) (*UpdateResponse, error)
+
+	// ListChanges returns the changes of the Deployment Plans recorded in the change log, in the order of their sequence.
+	ListChanges(context.Context, *core.ListChangesRequest) (*core.ListChangesResponse, error)
+	// LastChangeSequence returns the sequence of the last change recorded in the change log, or 0 when there is none.
+	LastChangeSequence(context.Context) (uint64, error)
 }
 
 // CreateRequest represents the Deployment creation request.
@@ -128,6 +214,7 @@ type CreateRequest struct {
 	ServiceName                 string                      // ServiceName is the name of the service associated with the Deployment. Certs will be issued for this service.
 	MatchingComputeCapabilities []MatchingComputeCapability // MatchingCapabilities is a list of capabilities that the Deployment requires.
 	Applications                []Application               // Applications is a list of applications that the Deployment requires.
+	SchedulerProfile            string                      // SchedulerProfile is the name of the scheduler profile used to place instances. Empty means the default.
 }
 
 // CreateResponse represents the response after creating a new Deployment.
@@ -153,7 +240,8 @@ type UpdateResponse struct {
 
 // ListRequest represents the request to list Deployments with filters.
 type ListRequest struct {
-	Filters DeploymentPlanListFilters
+	Filters   DeploymentPlanListFilters
+	PageToken string // PageToken is the NextPageToken of the previous page, empty for the first page.
 }
 
 // DeploymentFilters contains filters for querying the Deployment table.
@@ -170,7 +258,7 @@ type DeploymentPlanListFilters struct {
 	DeploymentPlanStatusIn []DeploymentState
 
 	IncludeDeleted bool   // IncludeDeleted indicates whether to include soft-deleted records in the result.
-	Limit          uint32 // Limit is the maximum number of records to return.
+	Limit          uint32 // Limit is the maximum number of records in a page. All records are returned when 0.
 
 	StateIn    []DeploymentPlanState
 	StateNotIn []DeploymentPlanState
@@ -178,18 +266,31 @@ type DeploymentPlanListFilters struct {
 
 // ListResponse represents the response to a list request.
 type ListResponse struct {
-	Records []DeploymentPlanRecord
+	Records       []DeploymentPlanRecord // Records are ordered by name and ID.
+	NextPageToken string                 // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64                 // TotalCount is the number of records matching the filters across all pages.
 }
 
 type DeleteRequest struct {
 	Metadata core.Metadata
 }
 
+type UpdateSpecRequest struct {
+	Metadata core.Metadata
+	Spec     DeploymentPlanSpec
+}
+
+type GetSpecHistoryResponse struct {
+	Revisions []SpecRevision
+}
+
 type AddDeploymentRequest struct {
 	Metadata           core.Metadata
 	DeploymentID       string
 	PayloadCoordinates []PayloadCoordinates
 	InstanceCount      uint32
+	RolloutStrategy    RolloutStrategy
+	Canary             Canary
 }
 
 type UpdateDeploymentStatusRequest struct {
@@ -197,3 +298,15 @@ type UpdateDeploymentStatusRequest struct {
 	DeploymentID string
 	Status       DeploymentStatus
 }
+
+type UpdateDeploymentProgressRequest struct {
+	Metadata     core.Metadata
+	DeploymentID string
+	Progress     RolloutProgress
+}
+
+type RollbackRequest struct {
+	Metadata       core.Metadata
+	DeploymentID   string // DeploymentID is the ID of the deployment added by the rollback.
+	ToDeploymentID string // ToDeploymentID is the ID of the earlier deployment to roll back to.
+}
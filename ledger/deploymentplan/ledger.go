@@ -3,6 +3,8 @@ package deploymentplan
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/msanath/mrds/ledger/core"
 	ledgererrors "github.com/msanath/mrds/ledger/errors"
@@ -12,7 +14,8 @@ import (
 
 // ledger implements the Ledger interface.
 type ledger struct {
-	repo Repository
+	repo              Repository
+	schedulerProfiles []string
 }
 
 // Repository provides the methods that the storage layer must implement to support the ledger.
@@ -22,15 +25,35 @@ type Repository interface {
 	GetByName(context.Context, string) (DeploymentPlanRecord, error)
 	UpdateStatus(context.Context, core.Metadata, DeploymentPlanStatus) error
 	Delete(context.Context, core.Metadata) error
-	List(context.Context, DeploymentPlanListFilters) ([]DeploymentPlanRecord, error)
+	List(ctx context.Context, filters DeploymentPlanListFilters, pageToken string) ([]DeploymentPlanRecord, core.PageInfo, error)
+	UpdateSpec(context.Context, core.Metadata, DeploymentPlanSpec) error
+	ListSpecHistory(context.Context, string) ([]SpecRevision, error)
 
 	InsertDeployment(context.Context, core.Metadata, Deployment) error
 	UpdateDeploymentStatus(ctx context.Context, metadata core.Metadata, deploymentID string, status DeploymentStatus) error
+	UpdateDeploymentProgress(ctx context.Context, metadata core.Metadata, deploymentID string, progress RolloutProgress) error
+
+	ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error)
+	LastChangeSequence(ctx context.Context) (uint64, error)
+}
+
+type LedgerOption func(*ledger)
+
+// WithSchedulerProfiles restricts the scheduler profiles which the DeploymentPlans may select to the given
+// names. Any profile is accepted when it is not set.
+func WithSchedulerProfiles(names ...string) LedgerOption {
+	return func(l *ledger) {
+		l.schedulerProfiles = names
+	}
 }
 
 // NewLedger creates a new Ledger instance.
-func NewLedger(repo Repository) Ledger {
-	return &ledger{repo: repo}
+func NewLedger(repo Repository, opts ...LedgerOption) Ledger {
+	l := &ledger{repo: repo}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
 }
 
 // Create creates a new DeploymentPlan.
@@ -54,19 +77,15 @@ func (l *ledger) Create(ctx context.Context, req *CreateRequest) (*CreateRespons
 			"ServiceName is required",
 		)
 	}
-	if len(req.Applications) == 0 {
+	if req.SchedulerProfile != "" && l.schedulerProfiles != nil && !slices.Contains(l.schedulerProfiles, req.SchedulerProfile) {
 		return nil, ledgererrors.NewLedgerError(
 			ledgererrors.ErrRequestInvalid,
-			"Applications are required",
+			fmt.Sprintf("Unknown scheduler profile %s, must be one of %v", req.SchedulerProfile, l.schedulerProfiles),
 		)
 	}
-	for _, app := range req.Applications {
-		if app.PayloadName == "" {
-			return nil, ledgererrors.NewLedgerError(
-				ledgererrors.ErrRequestInvalid,
-				"PayloadName is required",
-			)
-		}
+	err := validateApplications(req.Applications)
+	if err != nil {
+		return nil, err
 	}
 
 	rec := DeploymentPlanRecord{
@@ -79,13 +98,14 @@ func (l *ledger) Create(ctx context.Context, req *CreateRequest) (*CreateRespons
 		ServiceName:                 req.ServiceName,
 		MatchingComputeCapabilities: req.MatchingComputeCapabilities,
 		Applications:                req.Applications,
+		SchedulerProfile:            req.SchedulerProfile,
 		Status: DeploymentPlanStatus{
 			State:   DeploymentPlanStateActive,
 			Message: "",
 		},
 	}
 
-	err := l.repo.Insert(ctx, rec)
+	err = l.repo.Insert(ctx, rec)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +115,59 @@ func (l *ledger) Create(ctx context.Context, req *CreateRequest) (*CreateRespons
 	}, nil
 }
 
+func validateApplications(applications []Application) error {
+	if len(applications) == 0 {
+		return ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"Applications are required",
+		)
+	}
+	for _, app := range applications {
+		if app.PayloadName == "" {
+			return ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				"PayloadName is required",
+			)
+		}
+		for _, probe := range []*Probe{app.LivenessProbe, app.ReadinessProbe} {
+			err := validateProbe(app.PayloadName, probe)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateProbe checks that a probe has what its type needs to run.
+func validateProbe(payloadName string, probe *Probe) error {
+	if probe == nil {
+		return nil
+	}
+	switch probe.Type {
+	case ProbeTypeHTTP, ProbeTypeTCP:
+		if probe.Port == 0 {
+			return ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("Port is required for the %s probe of payload %s", probe.Type, payloadName),
+			)
+		}
+	case ProbeTypeExec:
+		if len(probe.Command) == 0 {
+			return ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("Command is required for the %s probe of payload %s", probe.Type, payloadName),
+			)
+		}
+	default:
+		return ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Invalid probe type %s for payload %s", probe.Type, payloadName),
+		)
+	}
+	return nil
+}
+
 // GetByID retrieves a DeploymentPlan by its ID.
 func (l *ledger) GetByID(ctx context.Context, id string) (*GetResponse, error) {
 	// validate the request
@@ -199,13 +272,15 @@ func (l *ledger) UpdateStatus(ctx context.Context, req *UpdateStatusRequest) (*U
 
 // List returns a list of DeploymentPlans that match the provided filters.
 func (l *ledger) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	records, err := l.repo.List(ctx, req.Filters)
+	records, page, err := l.repo.List(ctx, req.Filters, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ListResponse{
-		Records: records,
+		Records:       records,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -213,6 +288,90 @@ func (l *ledger) Delete(ctx context.Context, req *DeleteRequest) error {
 	return l.repo.Delete(ctx, req.Metadata)
 }
 
+// UpdateSpec replaces the spec of a DeploymentPlan. The instances keep running the previous spec until the
+// next Deployment rolls them onto the new one.
+func (l *ledger) UpdateSpec(ctx context.Context, req *UpdateSpecRequest) (*UpdateResponse, error) {
+	if req.Metadata.ID == "" {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"ID missing. ID is required to update the spec",
+		)
+	}
+	err := validateApplications(req.Spec.Applications)
+	if err != nil {
+		return nil, err
+	}
+	existingPlan, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A running deployment places instances with the spec it started with.
+	for _, deployment := range existingPlan.Deployments {
+		if deployment.Status.State == DeploymentStateInProgress || deployment.Status.State == DeploymentStatePaused {
+			return nil, ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("Cannot update the spec while deployment %s is in state %s", deployment.ID, deployment.Status.State),
+			)
+		}
+	}
+
+	// Applications may be added and removed. The next deployment must have the coordinates of the payloads of
+	// the new spec, and the instances are reallocated for it.
+	payloadNames := make(map[string]bool)
+	for _, app := range req.Spec.Applications {
+		if payloadNames[app.PayloadName] {
+			return nil, ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("Payload %s has more than one application", app.PayloadName),
+			)
+		}
+		payloadNames[app.PayloadName] = true
+	}
+
+	err = l.repo.UpdateSpec(ctx, req.Metadata, req.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the record again to return the updated record
+	record, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateResponse{
+		Record: record,
+	}, nil
+}
+
+// GetSpecHistory returns the specs of a DeploymentPlan replaced by spec updates.
+func (l *ledger) GetSpecHistory(ctx context.Context, id string) (*GetSpecHistoryResponse, error) {
+	if id == "" {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"ID missing. ID is required to fetch the spec history",
+		)
+	}
+	// Fail for an unknown deployment plan rather than returning an empty history.
+	_, err := l.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := l.repo.ListSpecHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Version < revisions[j].Version
+	})
+
+	return &GetSpecHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
 func (l *ledger) AddDeployment(ctx context.Context, req *AddDeploymentRequest) (*UpdateResponse, error) {
 	if req.DeploymentID == "" {
 		return nil, ledgererrors.NewLedgerError(
@@ -225,13 +384,13 @@ func (l *ledger) AddDeployment(ctx context.Context, req *AddDeploymentRequest) (
 		return nil, err
 	}
 
-	// If there is an existing deployment InProgress, then we cannot add a new deployment
-	// ??? Why can't we add a new deployment when there is an existing deployment in progress?
+	// A paused deployment still holds its instances and resumes where it stopped, so a new deployment
+	// cannot roll out alongside it any more than alongside one in progress.
 	for _, deployment := range existingPlan.Deployments {
-		if deployment.Status.State == DeploymentStateInProgress {
+		if deployment.Status.State == DeploymentStateInProgress || deployment.Status.State == DeploymentStatePaused {
 			return nil, ledgererrors.NewLedgerError(
 				ledgererrors.ErrRequestInvalid,
-				fmt.Sprintf("Cannot add deployment when there is an existing deployment in progress. InProgress DeploymentID: %s", deployment.ID),
+				fmt.Sprintf("Cannot add deployment while deployment %s is in state %s", deployment.ID, deployment.Status.State),
 			)
 		}
 	}
@@ -263,10 +422,38 @@ func (l *ledger) AddDeployment(ctx context.Context, req *AddDeploymentRequest) (
 		}
 	}
 
+	rolloutStrategy := req.RolloutStrategy
+	switch rolloutStrategy.Type {
+	case "":
+		rolloutStrategy.Type = RolloutStrategyTypeUpdateDomain
+	case RolloutStrategyTypeUpdateDomain, RolloutStrategyTypeRolling, RolloutStrategyTypeBlueGreen:
+	default:
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Unknown rollout strategy %s", rolloutStrategy.Type),
+		)
+	}
+	// A rolling strategy without limits could not run any operation, and 0 is not taken as a default so that
+	// a limit can be set to 0 explicitly.
+	if rolloutStrategy.Type == RolloutStrategyTypeRolling && rolloutStrategy.MaxUnavailable == 0 && rolloutStrategy.MaxSurge == 0 {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"Rolling strategy requires a max unavailable or a max surge",
+		)
+	}
+	if req.Canary.Percentage > 100 {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Canary percentage %d exceeds 100", req.Canary.Percentage),
+		)
+	}
+
 	err = l.repo.InsertDeployment(ctx, req.Metadata, Deployment{
 		ID:                 req.DeploymentID,
 		PayloadCoordinates: req.PayloadCoordinates,
 		InstanceCount:      req.InstanceCount,
+		RolloutStrategy:    rolloutStrategy,
+		Canary:             req.Canary,
 		Status: DeploymentStatus{
 			State:   DeploymentStatePending,
 			Message: "",
@@ -287,10 +474,51 @@ func (l *ledger) AddDeployment(ctx context.Context, req *AddDeploymentRequest) (
 	}, nil
 }
 
+func (l *ledger) Rollback(ctx context.Context, req *RollbackRequest) (*UpdateResponse, error) {
+	if req.ToDeploymentID == "" {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"ToDeploymentID is required",
+		)
+	}
+	existingPlan, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *Deployment
+	for i, deployment := range existingPlan.Deployments {
+		if deployment.ID == req.ToDeploymentID {
+			target = &existingPlan.Deployments[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Deployment %s not found", req.ToDeploymentID),
+		)
+	}
+	// Only a deployment which was fully rolled out is known to be good.
+	if target.Status.State != DeploymentStateCompleted {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Cannot roll back to deployment %s in state %s", target.ID, target.Status.State),
+		)
+	}
+
+	return l.AddDeployment(ctx, &AddDeploymentRequest{
+		Metadata:           req.Metadata,
+		DeploymentID:       req.DeploymentID,
+		PayloadCoordinates: target.PayloadCoordinates,
+		InstanceCount:      target.InstanceCount,
+	})
+}
+
 var validDeploymentStateTransitions = map[DeploymentState][]DeploymentState{
 	DeploymentStatePending:    {DeploymentStateInProgress, DeploymentStateCancelled},
 	DeploymentStateInProgress: {DeploymentStateCancelled, DeploymentStateFailed, DeploymentStatePaused, DeploymentStateCompleted},
-	DeploymentStatePaused:     {DeploymentStateInProgress, DeploymentStateCancelled},
+	DeploymentStatePaused:     {DeploymentStateInProgress, DeploymentStateCancelled, DeploymentStateFailed},
 }
 
 func (l *ledger) UpdateDeploymentStatus(ctx context.Context, req *UpdateDeploymentStatusRequest) (*UpdateResponse, error) {
@@ -350,3 +578,70 @@ func (l *ledger) UpdateDeploymentStatus(ctx context.Context, req *UpdateDeployme
 		Record: record,
 	}, nil
 }
+
+func (l *ledger) UpdateDeploymentProgress(ctx context.Context, req *UpdateDeploymentProgressRequest) (*UpdateResponse, error) {
+	existingPlan, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment *Deployment
+	for i, d := range existingPlan.Deployments {
+		if d.ID == req.DeploymentID {
+			deployment = &existingPlan.Deployments[i]
+			break
+		}
+	}
+	if deployment == nil {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Deployment with ID %s not found", req.DeploymentID),
+		)
+	}
+	// A batch which was running when the deployment was paused or aborted still completes, so its progress
+	// is recorded.
+	if deployment.Status.State != DeploymentStateInProgress &&
+		deployment.Status.State != DeploymentStatePaused &&
+		deployment.Status.State != DeploymentStateCancelled {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Cannot update the progress of deployment %s in state %s", req.DeploymentID, deployment.Status.State),
+		)
+	}
+	if req.Progress.CompletedBatches > req.Progress.TotalBatches {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Completed batches %d exceed the total batches %d", req.Progress.CompletedBatches, req.Progress.TotalBatches),
+		)
+	}
+
+	err = l.repo.UpdateDeploymentProgress(ctx, req.Metadata, req.DeploymentID, req.Progress)
+	if err != nil {
+		return nil, err
+	}
+	// Get the record again to return the updated record
+	record, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateResponse{
+		Record: record,
+	}, nil
+}
+
+// ListChanges returns the changes of the Deployment Plans recorded in the change log.
+func (l *ledger) ListChanges(ctx context.Context, req *core.ListChangesRequest) (*core.ListChangesResponse, error) {
+	changes, err := l.repo.ListChanges(ctx, req.AfterSequence, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &core.ListChangesResponse{
+		Changes: changes,
+	}, nil
+}
+
+// LastChangeSequence returns the sequence of the last change recorded in the change log.
+func (l *ledger) LastChangeSequence(ctx context.Context) (uint64, error) {
+	return l.repo.LastChangeSequence(ctx)
+}
@@ -81,6 +81,48 @@ func TestLedgerCreate(t *testing.T) {
 		require.Equal(t, "test-capability-name", resp.Record.MatchingComputeCapabilities[0].CapabilityNames[0])
 	})
 
+	t.Run("Create InvalidProbe Failure", func(t *testing.T) {
+		storage := test.TestSQLStorage(t)
+		l := deploymentplan.NewLedger(storage.DeploymentPlan)
+
+		req := &deploymentplan.CreateRequest{
+			Name:        "test-deploymentplan",
+			Namespace:   "test-namespace",
+			ServiceName: "test-service",
+			Applications: []deploymentplan.Application{
+				{
+					PayloadName:    "test-payload",
+					ReadinessProbe: &deploymentplan.Probe{Type: deploymentplan.ProbeTypeHTTP, Path: "/healthz"},
+				},
+			},
+		}
+		resp, err := l.Create(context.Background(), req)
+
+		require.Error(t, err)
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.ErrorContains(t, err, "Port is required")
+		require.Nil(t, resp)
+	})
+
+	t.Run("Create UnknownSchedulerProfile Failure", func(t *testing.T) {
+		storage := test.TestSQLStorage(t)
+		l := deploymentplan.NewLedger(storage.DeploymentPlan, deploymentplan.WithSchedulerProfiles("default", "bin-packing"))
+
+		req := deplomentRequest()
+		req.SchedulerProfile = "unknown"
+		resp, err := l.Create(context.Background(), req)
+
+		require.Error(t, err)
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.ErrorContains(t, err, "Unknown scheduler profile unknown")
+		require.Nil(t, resp)
+
+		req.SchedulerProfile = "bin-packing"
+		resp, err = l.Create(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "bin-packing", resp.Record.SchedulerProfile)
+	})
+
 	t.Run("Create EmptyName Failure", func(t *testing.T) {
 		storage := test.TestSQLStorage(t)
 		l := deploymentplan.NewLedger(storage.DeploymentPlan)
@@ -270,6 +312,146 @@ func TestLedgerDelete(t *testing.T) {
 	require.Equal(t, ledgererrors.ErrRecordNotFound, err.(ledgererrors.LedgerError).Code)
 }
 
+func TestLedgerUpdateSpec(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	l := deploymentplan.NewLedger(storage.DeploymentPlan)
+
+	// First, create the DeploymentPlan
+	req := deplomentRequest()
+	createResp, err := l.Create(context.Background(), req)
+	require.NoError(t, err)
+	updatedRecord := createResp.Record
+
+	t.Run("UpdateSpec Success", func(t *testing.T) {
+		resp, err := l.UpdateSpec(context.Background(), &deploymentplan.UpdateSpecRequest{
+			Metadata: updatedRecord.Metadata,
+			Spec: deploymentplan.DeploymentPlanSpec{
+				Applications: []deploymentplan.Application{
+					{
+						PayloadName: "test-payload",
+						Resources: deploymentplan.ApplicationResources{
+							Cores:  2,
+							Memory: 512,
+						},
+						Ports: []deploymentplan.ApplicationPort{
+							{Protocol: "TCP", Port: 8080},
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, updatedRecord.Metadata.Version+1, resp.Record.Metadata.Version)
+		require.Len(t, resp.Record.Applications, 1)
+		require.Equal(t, uint32(2), resp.Record.Applications[0].Resources.Cores)
+		require.Equal(t, uint32(512), resp.Record.Applications[0].Resources.Memory)
+		require.Len(t, resp.Record.Applications[0].Ports, 1)
+		require.Empty(t, resp.Record.MatchingComputeCapabilities)
+
+		historyResp, err := l.GetSpecHistory(context.Background(), updatedRecord.Metadata.ID)
+		require.NoError(t, err)
+		require.Len(t, historyResp.Revisions, 1)
+		require.Equal(t, updatedRecord.Metadata.Version, historyResp.Revisions[0].Version)
+		require.Equal(t, uint32(1), historyResp.Revisions[0].Spec.Applications[0].Resources.Cores)
+		require.Len(t, historyResp.Revisions[0].Spec.MatchingComputeCapabilities, 1)
+		updatedRecord = resp.Record
+	})
+
+	t.Run("UpdateSpec VersionMismatch Failure", func(t *testing.T) {
+		_, err := l.UpdateSpec(context.Background(), &deploymentplan.UpdateSpecRequest{
+			Metadata: createResp.Record.Metadata,
+			Spec:     deploymentplan.DeploymentPlanSpec{Applications: updatedRecord.Applications},
+		})
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRecordInsertConflict, err.(ledgererrors.LedgerError).Code)
+	})
+
+	t.Run("UpdateSpec AddAndRemoveApplications Success", func(t *testing.T) {
+		payloadNames := func(record deploymentplan.DeploymentPlanRecord) []string {
+			var names []string
+			for _, app := range record.Applications {
+				names = append(names, app.PayloadName)
+			}
+			return names
+		}
+		for _, names := range [][]string{
+			{"test-payload", "other-payload"}, // other-payload is added.
+			{"other-payload"},                 // test-payload is removed.
+			{"test-payload"},                  // test-payload is added back and other-payload is removed.
+		} {
+			var applications []deploymentplan.Application
+			for _, name := range names {
+				applications = append(applications, deploymentplan.Application{
+					PayloadName: name,
+					Resources:   deploymentplan.ApplicationResources{Cores: 1},
+				})
+			}
+			resp, err := l.UpdateSpec(context.Background(), &deploymentplan.UpdateSpecRequest{
+				Metadata: updatedRecord.Metadata,
+				Spec:     deploymentplan.DeploymentPlanSpec{Applications: applications},
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, names, payloadNames(resp.Record))
+			updatedRecord = resp.Record
+		}
+	})
+
+	t.Run("UpdateSpec DuplicatePayload Failure", func(t *testing.T) {
+		_, err := l.UpdateSpec(context.Background(), &deploymentplan.UpdateSpecRequest{
+			Metadata: updatedRecord.Metadata,
+			Spec: deploymentplan.DeploymentPlanSpec{
+				Applications: []deploymentplan.Application{
+					{PayloadName: "test-payload"},
+					{PayloadName: "test-payload"},
+				},
+			},
+		})
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+	})
+
+	t.Run("UpdateSpec DeploymentInProgress Failure", func(t *testing.T) {
+		addResp, err := l.AddDeployment(context.Background(), &deploymentplan.AddDeploymentRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-1",
+			PayloadCoordinates: []deploymentplan.PayloadCoordinates{
+				{
+					PayloadName: "test-payload",
+					Coordinates: map[string]string{"key1": "value1"},
+				},
+			},
+			InstanceCount: 1,
+		})
+		require.NoError(t, err)
+		statusResp, err := l.UpdateDeploymentStatus(context.Background(), &deploymentplan.UpdateDeploymentStatusRequest{
+			Metadata:     addResp.Record.Metadata,
+			DeploymentID: "test-deployment-1",
+			Status: deploymentplan.DeploymentStatus{
+				State: deploymentplan.DeploymentStateInProgress,
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = l.UpdateSpec(context.Background(), &deploymentplan.UpdateSpecRequest{
+			Metadata: statusResp.Record.Metadata,
+			Spec:     deploymentplan.DeploymentPlanSpec{Applications: updatedRecord.Applications},
+		})
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+	})
+
+	t.Run("GetSpecHistory NotFound Failure", func(t *testing.T) {
+		_, err := l.GetSpecHistory(context.Background(), "unknown-id")
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRecordNotFound, err.(ledgererrors.LedgerError).Code)
+	})
+}
+
 func TestDeployment(t *testing.T) {
 	storage := test.TestSQLStorage(t)
 	l := deploymentplan.NewLedger(storage.DeploymentPlan)
@@ -301,6 +483,7 @@ func TestDeployment(t *testing.T) {
 		require.NotNil(t, resp)
 		require.Equal(t, len(resp.Record.Deployments), 1)
 		require.Equal(t, "test-deployment-1", resp.Record.Deployments[0].ID)
+		require.Equal(t, deploymentplan.RolloutStrategyTypeUpdateDomain, resp.Record.Deployments[0].RolloutStrategy.Type)
 		updatedRecord = resp.Record
 	})
 
@@ -322,6 +505,36 @@ func TestDeployment(t *testing.T) {
 		updatedRecord = resp.Record
 	})
 
+	t.Run("UpdateDeploymentProgress Success", func(t *testing.T) {
+		updateReq := &deploymentplan.UpdateDeploymentProgressRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-1",
+			Progress:     deploymentplan.RolloutProgress{CompletedBatches: 1, TotalBatches: 3},
+		}
+
+		resp, err := l.UpdateDeploymentProgress(context.Background(), updateReq)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, deploymentplan.RolloutProgress{CompletedBatches: 1, TotalBatches: 3}, resp.Record.Deployments[0].RolloutProgress)
+		updatedRecord = resp.Record
+	})
+
+	t.Run("UpdateDeploymentProgress CompletedBeyondTotal Failure", func(t *testing.T) {
+		updateReq := &deploymentplan.UpdateDeploymentProgressRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-1",
+			Progress:     deploymentplan.RolloutProgress{CompletedBatches: 4, TotalBatches: 3},
+		}
+
+		resp, err := l.UpdateDeploymentProgress(context.Background(), updateReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
 	t.Run("AddDeployment MissingPayloadCoordinates Failure", func(t *testing.T) {
 		addDeploymentReq := &deploymentplan.AddDeploymentRequest{
 			Metadata:     updatedRecord.Metadata,
@@ -341,6 +554,52 @@ func TestDeployment(t *testing.T) {
 		require.Nil(t, resp)
 	})
 
+	t.Run("AddDeployment UnknownRolloutStrategy Failure", func(t *testing.T) {
+		addDeploymentReq := &deploymentplan.AddDeploymentRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-5",
+			PayloadCoordinates: []deploymentplan.PayloadCoordinates{
+				{
+					PayloadName: "test-payload",
+					Coordinates: map[string]string{
+						"key1": "value1",
+					},
+				},
+			},
+			InstanceCount:   3,
+			RolloutStrategy: deploymentplan.RolloutStrategy{Type: "unknown"},
+		}
+		resp, err := l.AddDeployment(context.Background(), addDeploymentReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
+	t.Run("AddDeployment RollingWithoutLimits Failure", func(t *testing.T) {
+		addDeploymentReq := &deploymentplan.AddDeploymentRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-5",
+			PayloadCoordinates: []deploymentplan.PayloadCoordinates{
+				{
+					PayloadName: "test-payload",
+					Coordinates: map[string]string{
+						"key1": "value1",
+					},
+				},
+			},
+			InstanceCount:   3,
+			RolloutStrategy: deploymentplan.RolloutStrategy{Type: deploymentplan.RolloutStrategyTypeRolling},
+		}
+		resp, err := l.AddDeployment(context.Background(), addDeploymentReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
 	t.Run("AddDeployment MissingPayloadName Failure", func(t *testing.T) {
 		addDeploymentReq := &deploymentplan.AddDeploymentRequest{
 			Metadata:     updatedRecord.Metadata,
@@ -424,4 +683,102 @@ func TestDeployment(t *testing.T) {
 		require.Equal(t, "test-deployment-5", resp.Record.Deployments[1].ID)
 		updatedRecord = resp.Record
 	})
+
+	t.Run("Rollback Success", func(t *testing.T) {
+		rollbackReq := &deploymentplan.RollbackRequest{
+			Metadata:       updatedRecord.Metadata,
+			DeploymentID:   "test-deployment-6",
+			ToDeploymentID: "test-deployment-1",
+		}
+		resp, err := l.Rollback(context.Background(), rollbackReq)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Record.Deployments, 3)
+		require.Equal(t, "test-deployment-6", resp.Record.Deployments[2].ID)
+		require.Equal(t, resp.Record.Deployments[0].PayloadCoordinates, resp.Record.Deployments[2].PayloadCoordinates)
+		require.Equal(t, resp.Record.Deployments[0].InstanceCount, resp.Record.Deployments[2].InstanceCount)
+		require.Equal(t, deploymentplan.DeploymentStatePending, resp.Record.Deployments[2].Status.State)
+		updatedRecord = resp.Record
+	})
+
+	t.Run("Rollback UnknownDeployment Failure", func(t *testing.T) {
+		rollbackReq := &deploymentplan.RollbackRequest{
+			Metadata:       updatedRecord.Metadata,
+			DeploymentID:   "test-deployment-7",
+			ToDeploymentID: "unknown-deployment",
+		}
+		resp, err := l.Rollback(context.Background(), rollbackReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
+	t.Run("Rollback NotCompletedDeployment Failure", func(t *testing.T) {
+		rollbackReq := &deploymentplan.RollbackRequest{
+			Metadata:       updatedRecord.Metadata,
+			DeploymentID:   "test-deployment-7",
+			ToDeploymentID: "test-deployment-5",
+		}
+		resp, err := l.Rollback(context.Background(), rollbackReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
+	t.Run("UpdateDeploymentStatus to Paused Success", func(t *testing.T) {
+		for _, state := range []deploymentplan.DeploymentState{
+			deploymentplan.DeploymentStateInProgress,
+			deploymentplan.DeploymentStatePaused,
+		} {
+			resp, err := l.UpdateDeploymentStatus(context.Background(), &deploymentplan.UpdateDeploymentStatusRequest{
+				Metadata:     updatedRecord.Metadata,
+				DeploymentID: "test-deployment-6",
+				Status:       deploymentplan.DeploymentStatus{State: state},
+			})
+			require.NoError(t, err)
+			updatedRecord = resp.Record
+		}
+		require.Equal(t, deploymentplan.DeploymentStatePaused, updatedRecord.Deployments[2].Status.State)
+	})
+
+	t.Run("Adding new deployment when there is an existing deployment paused Failure", func(t *testing.T) {
+		addDeploymentReq := &deploymentplan.AddDeploymentRequest{
+			Metadata:     updatedRecord.Metadata,
+			DeploymentID: "test-deployment-7",
+			PayloadCoordinates: []deploymentplan.PayloadCoordinates{
+				{
+					PayloadName: "test-payload",
+					Coordinates: map[string]string{
+						"key1": "value1",
+					},
+				},
+			},
+			InstanceCount: 3,
+		}
+		resp, err := l.AddDeployment(context.Background(), addDeploymentReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+
+	t.Run("Rollback when there is an existing deployment paused Failure", func(t *testing.T) {
+		rollbackReq := &deploymentplan.RollbackRequest{
+			Metadata:       updatedRecord.Metadata,
+			DeploymentID:   "test-deployment-7",
+			ToDeploymentID: "test-deployment-1",
+		}
+		resp, err := l.Rollback(context.Background(), rollbackReq)
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
 }
@@ -2,6 +2,7 @@ package metainstance
 
 import (
 	"context"
+	"time"
 
 	"github.com/msanath/mrds/ledger/core"
 )
@@ -34,15 +35,23 @@ type MetaInstanceStatus struct {
 }
 
 type RuntimeInstance struct {
-	ID       string
-	NodeID   string
-	IsActive bool
-	Status   RuntimeInstanceStatus
+	ID        string
+	NodeID    string
+	IsActive  bool
+	Status    RuntimeInstanceStatus
+	Resources RuntimeInstanceResources // Resources are reserved by the storage from the spec of the deployment plan.
+}
+
+// RuntimeInstanceResources are the resources reserved on the node for a runtime instance.
+type RuntimeInstanceResources struct {
+	Cores  uint32
+	Memory uint32
 }
 
 type RuntimeInstanceStatus struct {
 	State   RuntimeInstanceState
 	Message string
+	Ready   bool // Ready is true when the RuntimeInstance is RUNNING and passes the readiness probes of its applications.
 }
 
 type RuntimeInstanceState string
@@ -58,10 +67,11 @@ const (
 )
 
 type Operation struct {
-	ID       string        // The unique ID of the operation.
-	Type     OperationType // The type of operation.
-	IntentID string        // The ID of the intent that triggered this operation.
-	Status   OperationStatus
+	ID        string        // The unique ID of the operation.
+	Type      OperationType // The type of operation.
+	IntentID  string        // The ID of the intent that triggered this operation.
+	Status    OperationStatus
+	CreatedAt time.Time // The time at which the operation was added. Set by the ledger when zero.
 }
 
 type OperationStatus struct {
@@ -113,11 +123,19 @@ type Ledger interface {
 	AddRuntimeInstance(context.Context, *AddRuntimeInstanceRequest) (*UpdateResponse, error)
 	UpdateRuntimeStatus(context.Context, *UpdateRuntimeStatusRequest) (*UpdateResponse, error)
 	UpdateRuntimeActiveState(context.Context, *UpdateRuntimeActiveStateRequest) (*UpdateResponse, error)
+	// SwitchActiveRuntimeInstances makes the given runtime instances active and the other runtime instances of
+	// their MetaInstances passive, atomically across all the MetaInstances.
+	SwitchActiveRuntimeInstances(context.Context, *SwitchActiveRuntimeInstancesRequest) (*SwitchActiveRuntimeInstancesResponse, error)
 	RemoveRuntimeInstance(context.Context, *RemoveRuntimeInstanceRequest) (*UpdateResponse, error)
 
 	AddOperation(context.Context, *AddOperationRequest) (*UpdateResponse, error)
 	UpdateOperationStatus(context.Context, *UpdateOperationStatusRequest) (*UpdateResponse, error)
 	RemoveOperation(context.Context, *RemoveOperationRequest) (*UpdateResponse, error)
+
+	// ListChanges returns the changes of the MetaInstances recorded in the change log, in the order of their sequence.
+	ListChanges(context.Context, *core.ListChangesRequest) (*core.ListChangesResponse, error)
+	// LastChangeSequence returns the sequence of the last change recorded in the change log, or 0 when there is none.
+	LastChangeSequence(context.Context) (uint64, error)
 }
 
 // CreateRequest represents the MetaInstance creation request.
@@ -155,7 +173,8 @@ type UpdateResponse struct {
 
 // ListRequest represents the request to list MetaInstances with filters.
 type ListRequest struct {
-	Filters MetaInstanceListFilters
+	Filters   MetaInstanceListFilters
+	PageToken string // PageToken is the NextPageToken of the previous page, empty for the first page.
 }
 
 // MetaInstanceFilters contains filters for querying the MetaInstance table.
@@ -169,7 +188,7 @@ type MetaInstanceListFilters struct {
 	DeploymentPlanIDIn []string // IN condition
 
 	IncludeDeleted bool   // IncludeDeleted indicates whether to include soft-deleted records in the result.
-	Limit          uint32 // Limit is the maximum number of records to return.
+	Limit          uint32 // Limit is the maximum number of records in a page. All records are returned when 0.
 
 	StateIn    []MetaInstanceState
 	StateNotIn []MetaInstanceState
@@ -177,7 +196,9 @@ type MetaInstanceListFilters struct {
 
 // ListResponse represents the response to a list request.
 type ListResponse struct {
-	Records []MetaInstanceRecord
+	Records       []MetaInstanceRecord // Records are ordered by name and ID.
+	NextPageToken string               // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64               // TotalCount is the number of records matching the filters across all pages.
 }
 
 type DeleteRequest struct {
@@ -201,6 +222,26 @@ type UpdateRuntimeActiveStateRequest struct {
 	IsActive          bool
 }
 
+// ActiveRuntimeInstance is the runtime instance to make active in a MetaInstance.
+type ActiveRuntimeInstance struct {
+	Metadata          core.Metadata
+	RuntimeInstanceID string
+}
+
+type SwitchActiveRuntimeInstancesRequest struct {
+	ActiveRuntimeInstances []ActiveRuntimeInstance
+}
+
+type SwitchActiveRuntimeInstancesResponse struct {
+	Records []MetaInstanceRecord
+}
+
+// RuntimeActiveStates are the active states of the runtime instances of a MetaInstance, by runtime instance ID.
+type RuntimeActiveStates struct {
+	Metadata     core.Metadata
+	ActiveStates map[string]bool
+}
+
 type RemoveRuntimeInstanceRequest struct {
 	Metadata          core.Metadata
 	RuntimeInstanceID string
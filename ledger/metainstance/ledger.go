@@ -3,6 +3,7 @@ package metainstance
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/msanath/mrds/ledger/core"
 	ledgererrors "github.com/msanath/mrds/ledger/errors"
@@ -23,7 +24,7 @@ type Repository interface {
 	UpdateStatus(context.Context, core.Metadata, MetaInstanceStatus) error
 	UpdateDeploymentID(context.Context, core.Metadata, string) error
 	Delete(context.Context, core.Metadata) error
-	List(context.Context, MetaInstanceListFilters) ([]MetaInstanceRecord, error)
+	List(ctx context.Context, filters MetaInstanceListFilters, pageToken string) ([]MetaInstanceRecord, core.PageInfo, error)
 
 	InsertOperation(ctx context.Context, metadata core.Metadata, operation Operation) error
 	UpdateOperationStatus(ctx context.Context, metadata core.Metadata, operationID string, status OperationStatus) error
@@ -32,7 +33,11 @@ type Repository interface {
 	InsertRuntimeInstance(ctx context.Context, metadata core.Metadata, instance RuntimeInstance) error
 	UpdateRuntimeInstanceStatus(ctx context.Context, metadata core.Metadata, instanceID string, status RuntimeInstanceStatus) error
 	UpdateRuntimeActiveState(ctx context.Context, metadata core.Metadata, instanceID string, active bool) error
+	UpdateRuntimeActiveStates(ctx context.Context, states []RuntimeActiveStates) error
 	DeleteRuntimeInstance(ctx context.Context, metadata core.Metadata, instanceID string) error
+
+	ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error)
+	LastChangeSequence(ctx context.Context) (uint64, error)
 }
 
 // NewLedger creates a new Ledger instance.
@@ -216,13 +221,15 @@ func (l *ledger) UpdateDeploymentID(ctx context.Context, req *UpdateDeploymentID
 
 // List returns a list of MetaInstances that match the provided filters.
 func (l *ledger) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	records, err := l.metaInstanceRepo.List(ctx, req.Filters)
+	records, page, err := l.metaInstanceRepo.List(ctx, req.Filters, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ListResponse{
-		Records: records,
+		Records:       records,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -232,7 +239,11 @@ func (l *ledger) Delete(ctx context.Context, req *DeleteRequest) error {
 
 // AddRuntimeInstance adds a runtime instance to the MetaInstance.
 func (l *ledger) AddRuntimeInstance(ctx context.Context, req *AddRuntimeInstanceRequest) (*UpdateResponse, error) {
-	err := l.metaInstanceRepo.InsertRuntimeInstance(ctx, req.Metadata, req.RuntimeInstance)
+	runtimeInstance := req.RuntimeInstance
+	if runtimeInstance.Status.State != RuntimeStateRunning {
+		runtimeInstance.Status.Ready = false
+	}
+	err := l.metaInstanceRepo.InsertRuntimeInstance(ctx, req.Metadata, runtimeInstance)
 	if err != nil {
 		return nil, err
 	}
@@ -247,9 +258,14 @@ func (l *ledger) AddRuntimeInstance(ctx context.Context, req *AddRuntimeInstance
 	}, nil
 }
 
-// UpdateRuntimeStatus updates the state and message of a runtime instance in the MetaInstance.
+// UpdateRuntimeStatus updates the state and message of a runtime instance in the MetaInstance. A runtime
+// instance which is not RUNNING is never ready.
 func (l *ledger) UpdateRuntimeStatus(ctx context.Context, req *UpdateRuntimeStatusRequest) (*UpdateResponse, error) {
-	err := l.metaInstanceRepo.UpdateRuntimeInstanceStatus(ctx, req.Metadata, req.RuntimeInstanceID, req.Status)
+	status := req.Status
+	if status.State != RuntimeStateRunning {
+		status.Ready = false
+	}
+	err := l.metaInstanceRepo.UpdateRuntimeInstanceStatus(ctx, req.Metadata, req.RuntimeInstanceID, status)
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +297,65 @@ func (l *ledger) UpdateRuntimeActiveState(ctx context.Context, req *UpdateRuntim
 	}, nil
 }
 
+// SwitchActiveRuntimeInstances switches the active runtime instances of the MetaInstances in one update.
+func (l *ledger) SwitchActiveRuntimeInstances(ctx context.Context, req *SwitchActiveRuntimeInstancesRequest) (*SwitchActiveRuntimeInstancesResponse, error) {
+	if len(req.ActiveRuntimeInstances) == 0 {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"At least one active runtime instance is required",
+		)
+	}
+
+	var states []RuntimeActiveStates
+	seen := make(map[string]bool)
+	for _, active := range req.ActiveRuntimeInstances {
+		if seen[active.Metadata.ID] {
+			return nil, ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("MetaInstance %s can only have one active runtime instance", active.Metadata.ID),
+			)
+		}
+		seen[active.Metadata.ID] = true
+
+		record, err := l.metaInstanceRepo.GetByID(ctx, active.Metadata.ID)
+		if err != nil {
+			return nil, err
+		}
+		activeStates := make(map[string]bool)
+		for _, runtimeInstance := range record.RuntimeInstances {
+			activeStates[runtimeInstance.ID] = runtimeInstance.ID == active.RuntimeInstanceID
+		}
+		if _, ok := activeStates[active.RuntimeInstanceID]; !ok {
+			return nil, ledgererrors.NewLedgerError(
+				ledgererrors.ErrRequestInvalid,
+				fmt.Sprintf("Runtime instance %s not found in MetaInstance %s", active.RuntimeInstanceID, active.Metadata.ID),
+			)
+		}
+		states = append(states, RuntimeActiveStates{
+			Metadata:     active.Metadata,
+			ActiveStates: activeStates,
+		})
+	}
+
+	err := l.metaInstanceRepo.UpdateRuntimeActiveStates(ctx, states)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []MetaInstanceRecord
+	for _, active := range req.ActiveRuntimeInstances {
+		record, err := l.metaInstanceRepo.GetByID(ctx, active.Metadata.ID)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return &SwitchActiveRuntimeInstancesResponse{
+		Records: records,
+	}, nil
+}
+
 // RemoveRuntimeInstance removes a runtime instance from the MetaInstance.
 func (l *ledger) RemoveRuntimeInstance(ctx context.Context, req *RemoveRuntimeInstanceRequest) (*UpdateResponse, error) {
 	err := l.metaInstanceRepo.DeleteRuntimeInstance(ctx, req.Metadata, req.RuntimeInstanceID)
@@ -300,6 +375,9 @@ func (l *ledger) RemoveRuntimeInstance(ctx context.Context, req *RemoveRuntimeIn
 
 // AddOperation adds an operation to the MetaInstance.
 func (l *ledger) AddOperation(ctx context.Context, req *AddOperationRequest) (*UpdateResponse, error) {
+	if req.Operation.CreatedAt.IsZero() {
+		req.Operation.CreatedAt = time.Now()
+	}
 	err := l.metaInstanceRepo.InsertOperation(ctx, req.Metadata, req.Operation)
 	if err != nil {
 		return nil, err
@@ -358,3 +436,19 @@ func (l *ledger) RemoveOperation(ctx context.Context, req *RemoveOperationReques
 		Record: record,
 	}, nil
 }
+
+// ListChanges returns the changes of the MetaInstances recorded in the change log.
+func (l *ledger) ListChanges(ctx context.Context, req *core.ListChangesRequest) (*core.ListChangesResponse, error) {
+	changes, err := l.metaInstanceRepo.ListChanges(ctx, req.AfterSequence, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &core.ListChangesResponse{
+		Changes: changes,
+	}, nil
+}
+
+// LastChangeSequence returns the sequence of the last change recorded in the change log.
+func (l *ledger) LastChangeSequence(ctx context.Context) (uint64, error) {
+	return l.metaInstanceRepo.LastChangeSequence(ctx)
+}
@@ -3,6 +3,7 @@ package metainstance_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/msanath/mrds/ledger/deploymentplan"
 	ledgererrors "github.com/msanath/mrds/ledger/errors"
@@ -243,6 +244,23 @@ func TestOperationsLedger(t *testing.T) {
 		lastUpdatedRecord = resp.Record
 	})
 
+	t.Run("Update RuntimeInstance Ready Success", func(t *testing.T) {
+		resp, err := l.UpdateRuntimeStatus(context.Background(), &metainstance.UpdateRuntimeStatusRequest{
+			Metadata:          lastUpdatedRecord.Metadata,
+			RuntimeInstanceID: "test-runtime-instance",
+			Status: metainstance.RuntimeInstanceStatus{
+				State:   metainstance.RuntimeStateRunning,
+				Message: "Runtime instance is ready",
+				Ready:   true,
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Record.RuntimeInstances, 1)
+		require.True(t, resp.Record.RuntimeInstances[0].Status.Ready)
+		lastUpdatedRecord = resp.Record
+	})
+
 	t.Run("Update RuntimeInstance Status Success", func(t *testing.T) {
 		resp, err := l.UpdateRuntimeStatus(context.Background(), &metainstance.UpdateRuntimeStatusRequest{
 			Metadata:          lastUpdatedRecord.Metadata,
@@ -250,6 +268,7 @@ func TestOperationsLedger(t *testing.T) {
 			Status: metainstance.RuntimeInstanceStatus{
 				State:   metainstance.RuntimeStateTerminated,
 				Message: "Runtime instance is terminated",
+				Ready:   true,
 			},
 		})
 		require.NoError(t, err)
@@ -259,6 +278,7 @@ func TestOperationsLedger(t *testing.T) {
 		require.Len(t, resp.Record.RuntimeInstances, 1)
 		require.Equal(t, "test-runtime-instance", resp.Record.RuntimeInstances[0].ID)
 		require.Equal(t, metainstance.RuntimeStateTerminated, resp.Record.RuntimeInstances[0].Status.State)
+		require.False(t, resp.Record.RuntimeInstances[0].Status.Ready, "a runtime instance which is not running is not ready")
 		lastUpdatedRecord = resp.Record
 	})
 
@@ -313,6 +333,7 @@ func TestOperationsLedger(t *testing.T) {
 		require.NotNil(t, resp)
 		require.Len(t, resp.Record.Operations, 1)
 		require.Equal(t, "test-operation", resp.Record.Operations[0].ID)
+		require.WithinDuration(t, time.Now(), resp.Record.Operations[0].CreatedAt, time.Minute)
 		lastUpdatedRecord = resp.Record
 	})
 
@@ -47,9 +47,16 @@ type NodeStatus struct {
 }
 
 type LocalVolume struct {
-	MountPath       string
-	StorageClass    string
-	StorageCapacity uint32
+	MountPath         string
+	StorageClass      string
+	StorageCapacity   uint32
+	RemainingCapacity uint32 // RemainingCapacity is the capacity not consumed by the persistent volumes of runtime instances.
+}
+
+// Port is a host port on a Node.
+type Port struct {
+	Protocol string
+	Port     uint32
 }
 
 type Disruption struct {
@@ -83,6 +90,8 @@ type Ledger interface {
 	GetByName(context.Context, string) (*GetResponse, error)
 	// UpdateStatus updates the state and message of an existing Node.
 	UpdateStatus(context.Context, *UpdateStatusRequest) (*UpdateResponse, error)
+	// UpdateSpec updates the update domain and the resources of an existing Node.
+	UpdateSpec(context.Context, *UpdateSpecRequest) (*UpdateResponse, error)
 	// List returns a list of Node that match the provided filters.
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Delete deletes a Node.
@@ -94,6 +103,11 @@ type Ledger interface {
 
 	AddCapability(context.Context, *AddCapabilityRequest) (*UpdateResponse, error)
 	RemoveCapability(context.Context, *RemoveCapabilityRequest) (*UpdateResponse, error)
+
+	// ListChanges returns the changes of the Nodes recorded in the change log, in the order of their sequence.
+	ListChanges(context.Context, *core.ListChangesRequest) (*core.ListChangesResponse, error)
+	// LastChangeSequence returns the sequence of the last change recorded in the change log, or 0 when there is none.
+	LastChangeSequence(context.Context) (uint64, error)
 }
 
 // CreateRequest represents the Node creation request.
@@ -118,6 +132,14 @@ type UpdateStatusRequest struct {
 	ClusterID string
 }
 
+// UpdateSpecRequest represents the request to update the update domain and the resources of a Node.
+type UpdateSpecRequest struct {
+	Metadata                core.Metadata
+	UpdateDomain            string    // UpdateDomain is the update domain of the Node.
+	TotalResources          Resources // TotalResources is the total resources available on the Node.
+	SystemReservedResources Resources // SystemReservedResources is the resources reserved for system use.
+}
+
 // GetResponse represents the response for fetching a Node.
 type GetResponse struct {
 	Record NodeRecord
@@ -130,7 +152,8 @@ type UpdateResponse struct {
 
 // ListRequest represents the request to list Nodes with filters.
 type ListRequest struct {
-	Filters NodeListFilters
+	Filters   NodeListFilters
+	PageToken string // PageToken is the NextPageToken of the previous page, empty for the first page.
 }
 
 // NodeFilters contains filters for querying the Node table.
@@ -142,7 +165,7 @@ type NodeListFilters struct {
 	VersionEq  *uint64  // Equal condition
 
 	IncludeDeleted bool   // IncludeDeleted indicates whether to include soft-deleted records in the result.
-	Limit          uint32 // Limit is the maximum number of records to return.
+	Limit          uint32 // Limit is the maximum number of records in a page. All records are returned when 0.
 
 	StateIn            []NodeState
 	StateNotIn         []NodeState
@@ -154,11 +177,17 @@ type NodeListFilters struct {
 	UpdateDomainIn     []string
 	PayloadNameIn      []string
 	PayloadNameNotIn   []string
+	PortsNotInUse      []Port // PortsNotInUse selects the Nodes on which none of the ports are allocated.
+
+	NoActiveDisruption         bool       // NoActiveDisruption selects the Nodes without a disruption that is not yet completed.
+	NoDisruptionStartingBefore *time.Time // NoDisruptionStartingBefore selects the Nodes without a disruption, not yet completed, starting before this time.
 }
 
 // ListResponse represents the response to a list request.
 type ListResponse struct {
-	Records []NodeRecord
+	Records       []NodeRecord // Records are ordered by name and ID.
+	NextPageToken string       // NextPageToken selects the next page, and is empty on the last page.
+	TotalCount    uint64       // TotalCount is the number of records matching the filters across all pages.
 }
 
 type DeleteRequest struct {
@@ -21,8 +21,11 @@ type Repository interface {
 	GetByID(context.Context, string) (NodeRecord, error)
 	GetByName(context.Context, string) (NodeRecord, error)
 	UpdateStatus(context.Context, core.Metadata, NodeStatus, string) error
+	// UpdateSpec writes the update domain and the total, system reserved and remaining resources of the
+	// record, whose metadata is the version being updated.
+	UpdateSpec(context.Context, NodeRecord) error
 	Delete(context.Context, core.Metadata) error
-	List(context.Context, NodeListFilters) ([]NodeRecord, error)
+	List(ctx context.Context, filters NodeListFilters, pageToken string) ([]NodeRecord, core.PageInfo, error)
 
 	InsertDisruption(context.Context, core.Metadata, Disruption) error
 	DeleteDisruption(ctx context.Context, metadata core.Metadata, disruptionID string) error
@@ -30,6 +33,9 @@ type Repository interface {
 
 	InsertCapability(ctx context.Context, metadata core.Metadata, capabilityID string) error
 	DeleteCapability(ctx context.Context, metadata core.Metadata, capabilityID string) error
+
+	ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error)
+	LastChangeSequence(ctx context.Context) (uint64, error)
 }
 
 // NewLedger creates a new Ledger instance.
@@ -83,7 +89,10 @@ func (l *ledger) Create(ctx context.Context, req *CreateRequest) (*CreateRespons
 			Memory: req.TotalResources.Memory - req.SystemReservedResources.Memory,
 		},
 		CapabilityIDs: req.CapabilityIDs,
-		LocalVolumes:  req.LocalVolumes,
+	}
+	for _, lv := range req.LocalVolumes {
+		lv.RemainingCapacity = lv.StorageCapacity
+		rec.LocalVolumes = append(rec.LocalVolumes, lv)
 	}
 
 	err := l.repo.Insert(ctx, rec)
@@ -233,15 +242,86 @@ func (l *ledger) UpdateStatus(ctx context.Context, req *UpdateStatusRequest) (*U
 	}, nil
 }
 
+// UpdateSpec updates the update domain and the resources of an existing Node. The resources allocated to
+// the runtime instances on the Node stay allocated, so the resources left for them cannot shrink below
+// the allocated resources.
+func (l *ledger) UpdateSpec(ctx context.Context, req *UpdateSpecRequest) (*UpdateResponse, error) {
+	// validate the request
+	if req.Metadata.ID == "" {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"ID missing. ID is required to update the spec",
+		)
+	}
+	if req.TotalResources.Cores == 0 || req.TotalResources.Memory == 0 {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"TotalResources must have non-zero values for Cores and Memory",
+		)
+	}
+	if req.SystemReservedResources.Cores > req.TotalResources.Cores || req.SystemReservedResources.Memory > req.TotalResources.Memory {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"SystemReservedResources cannot be greater than TotalResources",
+		)
+	}
+	if req.UpdateDomain == "" {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			"UpdateDomain is required",
+		)
+	}
+
+	record, err := l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocatedCores := record.TotalResources.Cores - record.SystemReservedResources.Cores - record.RemainingResources.Cores
+	allocatedMemory := record.TotalResources.Memory - record.SystemReservedResources.Memory - record.RemainingResources.Memory
+	availableCores := req.TotalResources.Cores - req.SystemReservedResources.Cores
+	availableMemory := req.TotalResources.Memory - req.SystemReservedResources.Memory
+	if availableCores < allocatedCores || availableMemory < allocatedMemory {
+		return nil, ledgererrors.NewLedgerError(
+			ledgererrors.ErrRequestInvalid,
+			fmt.Sprintf("Resources cannot be less than the %d cores and %d memory allocated on the Node", allocatedCores, allocatedMemory),
+		)
+	}
+
+	record.Metadata = req.Metadata
+	record.UpdateDomain = req.UpdateDomain
+	record.TotalResources = req.TotalResources
+	record.SystemReservedResources = req.SystemReservedResources
+	record.RemainingResources = Resources{
+		Cores:  availableCores - allocatedCores,
+		Memory: availableMemory - allocatedMemory,
+	}
+	err = l.repo.UpdateSpec(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err = l.repo.GetByID(ctx, req.Metadata.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateResponse{
+		Record: record,
+	}, nil
+}
+
 // List returns a list of Nodes that match the provided filters.
 func (l *ledger) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
-	records, err := l.repo.List(ctx, req.Filters)
+	records, page, err := l.repo.List(ctx, req.Filters, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	return &ListResponse{
-		Records: records,
+		Records:       records,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
@@ -483,3 +563,19 @@ func (l *ledger) RemoveCapability(ctx context.Context, req *RemoveCapabilityRequ
 		Record: record,
 	}, nil
 }
+
+// ListChanges returns the changes of the Nodes recorded in the change log.
+func (l *ledger) ListChanges(ctx context.Context, req *core.ListChangesRequest) (*core.ListChangesResponse, error) {
+	changes, err := l.repo.ListChanges(ctx, req.AfterSequence, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &core.ListChangesResponse{
+		Changes: changes,
+	}, nil
+}
+
+// LastChangeSequence returns the sequence of the last change recorded in the change log.
+func (l *ledger) LastChangeSequence(ctx context.Context) (uint64, error) {
+	return l.repo.LastChangeSequence(ctx)
+}
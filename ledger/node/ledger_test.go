@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/msanath/mrds/ledger/core"
 	ledgererrors "github.com/msanath/mrds/ledger/errors"
 	"github.com/msanath/mrds/ledger/node"
 	"github.com/msanath/mrds/pkg/sqlstorage/test"
@@ -296,6 +297,116 @@ func TestLedgerUpdateStatus(t *testing.T) {
 	})
 }
 
+func TestLedgerUpdateSpec(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	l := node.NewLedger(storage.Node)
+
+	// The node has 8 cores and 64 memory allocated to runtime instances.
+	err := storage.Node.Insert(context.Background(), node.NodeRecord{
+		Metadata:     core.Metadata{ID: "test-node-id", Version: 1},
+		Name:         "test-node",
+		UpdateDomain: "test-domain",
+		Status: node.NodeStatus{
+			State: node.NodeStateUnallocated,
+		},
+		TotalResources:          node.Resources{Cores: 64, Memory: 512},
+		SystemReservedResources: node.Resources{Cores: 4, Memory: 32},
+		RemainingResources:      node.Resources{Cores: 52, Memory: 416},
+	})
+	require.NoError(t, err)
+	getResp, err := l.GetByID(context.Background(), "test-node-id")
+	require.NoError(t, err)
+
+	lastUpdatedRecord := getResp.Record
+	t.Run("UpdateSpec Success", func(t *testing.T) {
+		resp, err := l.UpdateSpec(context.Background(), &node.UpdateSpecRequest{
+			Metadata:                lastUpdatedRecord.Metadata,
+			UpdateDomain:            "new-domain",
+			TotalResources:          node.Resources{Cores: 32, Memory: 256},
+			SystemReservedResources: node.Resources{Cores: 2, Memory: 16},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, "new-domain", resp.Record.UpdateDomain)
+		require.Equal(t, node.Resources{Cores: 32, Memory: 256}, resp.Record.TotalResources)
+		require.Equal(t, node.Resources{Cores: 2, Memory: 16}, resp.Record.SystemReservedResources)
+		require.Equal(t, node.Resources{Cores: 22, Memory: 176}, resp.Record.RemainingResources)
+		lastUpdatedRecord = resp.Record
+	})
+
+	t.Run("UpdateSpec Failures", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  *node.UpdateSpecRequest
+		}{
+			{
+				name: "Missing ID",
+				req: &node.UpdateSpecRequest{
+					UpdateDomain:   "new-domain",
+					TotalResources: node.Resources{Cores: 32, Memory: 256},
+				},
+			},
+			{
+				name: "Zero total resources",
+				req: &node.UpdateSpecRequest{
+					Metadata:     lastUpdatedRecord.Metadata,
+					UpdateDomain: "new-domain",
+				},
+			},
+			{
+				name: "Reserved greater than total",
+				req: &node.UpdateSpecRequest{
+					Metadata:                lastUpdatedRecord.Metadata,
+					UpdateDomain:            "new-domain",
+					TotalResources:          node.Resources{Cores: 32, Memory: 256},
+					SystemReservedResources: node.Resources{Cores: 64, Memory: 16},
+				},
+			},
+			{
+				name: "Missing update domain",
+				req: &node.UpdateSpecRequest{
+					Metadata:       lastUpdatedRecord.Metadata,
+					TotalResources: node.Resources{Cores: 32, Memory: 256},
+				},
+			},
+			{
+				name: "Less than allocated",
+				req: &node.UpdateSpecRequest{
+					Metadata:                lastUpdatedRecord.Metadata,
+					UpdateDomain:            "new-domain",
+					TotalResources:          node.Resources{Cores: 8, Memory: 256},
+					SystemReservedResources: node.Resources{Cores: 2, Memory: 16},
+				},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				resp, err := l.UpdateSpec(context.Background(), tc.req)
+
+				require.Error(t, err)
+				require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+				require.Equal(t, ledgererrors.ErrRequestInvalid, err.(ledgererrors.LedgerError).Code)
+				require.Nil(t, resp)
+			})
+		}
+	})
+
+	t.Run("Update conflict Failure", func(t *testing.T) {
+		resp, err := l.UpdateSpec(context.Background(), &node.UpdateSpecRequest{
+			Metadata:                getResp.Record.Metadata, // Using an older version.
+			UpdateDomain:            "new-domain",
+			TotalResources:          node.Resources{Cores: 32, Memory: 256},
+			SystemReservedResources: node.Resources{Cores: 2, Memory: 16},
+		})
+
+		require.Error(t, err)
+		require.ErrorAs(t, err, &ledgererrors.LedgerError{}, "error should be of type LedgerError")
+		require.Equal(t, ledgererrors.ErrRecordInsertConflict, err.(ledgererrors.LedgerError).Code)
+		require.Nil(t, resp)
+	})
+}
+
 func TestLedgerList(t *testing.T) {
 	storage := test.TestSQLStorage(t)
 	l := node.NewLedger(storage.Node)
@@ -0,0 +1,408 @@
+// This file implements a runtime which only simulates the instances, meant to test the scheduling and rollout
+// logic at scale without any infrastructure.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+)
+
+// RuntimeType is the runtime type of the clusters run by the fake runtime.
+const RuntimeType = "fake"
+
+// Keys of the runtime config of a fake cluster. Each one sets the field of the Profile with the same name.
+// Latencies are durations such as "500ms", rates are fractions between 0 and 1.
+const (
+	ConfigStartLatency     = "start-latency"
+	ConfigStopLatency      = "stop-latency"
+	ConfigLatencyJitter    = "latency-jitter"
+	ConfigStartFailureRate = "start-failure-rate"
+	ConfigStopFailureRate  = "stop-failure-rate"
+	ConfigStuckRate        = "stuck-rate"
+	ConfigNodeCrashRate    = "node-crash-rate"
+	ConfigSeed             = "seed"
+)
+
+// FailureType is the type of the application errors returned for the simulated failures. They are not
+// retryable, so that the workflows see them instead of retrying until the failure is not drawn.
+const FailureType = "FakeRuntimeFailure"
+
+// Profile configures the behaviour simulated by the fake runtime.
+type Profile struct {
+	// StartLatency is how long an instance takes to start.
+	StartLatency time.Duration
+	// StopLatency is how long an instance takes to stop.
+	StopLatency time.Duration
+	// LatencyJitter is the upper bound of a random duration added to every latency.
+	LatencyJitter time.Duration
+	// StartFailureRate is the fraction of starts which fail the instance.
+	StartFailureRate float64
+	// StopFailureRate is the fraction of stops which fail, leaving the instance running.
+	StopFailureRate float64
+	// StuckRate is the fraction of starts whose instance stays STARTING and never becomes ready.
+	StuckRate float64
+	// NodeCrashRate is the fraction of starts after which the node of the instance crashes.
+	NodeCrashRate float64
+	// Seed seeds the random draws, so that a sequence of calls makes the same draws each time.
+	Seed int64
+}
+
+// ParseProfile returns the profile set by the runtime config of a fake cluster. Unset keys are zero, so an
+// empty config starts and stops every instance immediately.
+func ParseProfile(config map[string]string) (Profile, error) {
+	var profile Profile
+	durations := map[string]*time.Duration{
+		ConfigStartLatency:  &profile.StartLatency,
+		ConfigStopLatency:   &profile.StopLatency,
+		ConfigLatencyJitter: &profile.LatencyJitter,
+	}
+	for key, field := range durations {
+		value, ok := config[key]
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return Profile{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		if d < 0 {
+			return Profile{}, fmt.Errorf("invalid %s: %s is negative", key, value)
+		}
+		*field = d
+	}
+
+	rates := map[string]*float64{
+		ConfigStartFailureRate: &profile.StartFailureRate,
+		ConfigStopFailureRate:  &profile.StopFailureRate,
+		ConfigStuckRate:        &profile.StuckRate,
+		ConfigNodeCrashRate:    &profile.NodeCrashRate,
+	}
+	for key, field := range rates {
+		value, ok := config[key]
+		if !ok {
+			continue
+		}
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Profile{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		if rate < 0 || rate > 1 {
+			return Profile{}, fmt.Errorf("invalid %s: %s is not between 0 and 1", key, value)
+		}
+		*field = rate
+	}
+
+	if value, ok := config[ConfigSeed]; ok {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return Profile{}, fmt.Errorf("invalid %s: %w", ConfigSeed, err)
+		}
+		profile.Seed = seed
+	}
+	return profile, nil
+}
+
+// FakeRuntime records the statuses of the runtime instances in the ledger as a runtime would, without running
+// anything. The faults of its profile are drawn on every start and stop, and nodes can also be crashed by hand
+// with CrashNode.
+//
+// A crashed node fails the instances running on it. They are only reported FAILED by GetInstanceStatus, so
+// the ledger learns of the crash through the reconciler, as it would with a real runtime.
+type FakeRuntime struct {
+	metaInstancesClient mrdspb.MetaInstancesClient
+
+	mu        sync.Mutex
+	profile   Profile
+	rand      *rand.Rand
+	instances map[string]*instance // keyed by runtime instance ID
+	crashed   map[string]bool      // keyed by node ID
+}
+
+type instance struct {
+	nodeID string
+	status *mrdspb.RuntimeInstanceStatus
+}
+
+// faults are the faults drawn for a start or a stop.
+type faults struct {
+	latency   time.Duration
+	fail      bool
+	stuck     bool
+	crashNode bool
+}
+
+var _ runtime.Reconfigurable = &FakeRuntime{}
+
+func NewFakeRuntime(metaInstancesClient mrdspb.MetaInstancesClient, profile Profile) *FakeRuntime {
+	return &FakeRuntime{
+		metaInstancesClient: metaInstancesClient,
+		profile:             profile,
+		rand:                rand.New(rand.NewSource(profile.Seed)),
+		instances:           make(map[string]*instance),
+		crashed:             make(map[string]bool),
+	}
+}
+
+// NewFactory returns the factory of the fake runtimes of the clusters, which simulate the profile set by the
+// runtime config.
+func NewFactory(metaInstancesClient mrdspb.MetaInstancesClient) runtime.Factory {
+	return func(config map[string]string) (runtime.RuntimeActivities, error) {
+		profile, err := ParseProfile(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewFakeRuntime(metaInstancesClient, profile), nil
+	}
+}
+
+// Reconfigure simulates the profile set by the runtime config from now on, keeping the instances and the
+// crashed nodes. The faults are drawn from the seed of the new profile.
+func (f *FakeRuntime) Reconfigure(config map[string]string) error {
+	profile, err := ParseProfile(config)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.profile = profile
+	f.rand = rand.New(rand.NewSource(profile.Seed))
+	return nil
+}
+
+func (f *FakeRuntime) Register(w worker.Registry) {
+	w.RegisterActivity(f.StartInstance)
+	w.RegisterActivity(f.StopInstance)
+	w.RegisterActivity(f.GetInstanceStatus)
+}
+
+// StartInstance records the runtime instance as STARTING, and as RUNNING and ready once the start latency
+// elapsed. A stuck instance stays STARTING, and a failed one is recorded FAILED with an error.
+func (f *FakeRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StartInstance", "request", req)
+
+	metaInstance, nodeID, err := f.getRuntimeInstance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	drawn := f.draw(true)
+
+	starting := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_STARTING}
+	f.setInstance(req.RuntimeInstanceID, nodeID, starting)
+	metaInstance, err = f.updateStatus(ctx, metaInstance, req.RuntimeInstanceID, starting)
+	if err != nil {
+		return nil, err
+	}
+	if drawn.stuck {
+		logger.Info("Instance is stuck starting", "runtimeInstance", req.RuntimeInstanceID)
+		return &runtime.RuntimeActivityResponse{MetaInstance: metaInstance}, nil
+	}
+
+	err = sleep(ctx, drawn.latency)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, Ready: true}
+	if f.isCrashed(nodeID) {
+		status = &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			Message: fmt.Sprintf("Node %s crashed", nodeID),
+		}
+	} else if drawn.fail {
+		status = &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			Message: "Simulated start failure",
+		}
+	}
+	f.setInstance(req.RuntimeInstanceID, nodeID, status)
+	metaInstance, err = f.updateStatus(ctx, metaInstance, req.RuntimeInstanceID, status)
+	if err != nil {
+		return nil, err
+	}
+	if status.State == mrdspb.RuntimeInstanceState_RuntimeState_FAILED {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("failed to start runtime instance %s: %s", req.RuntimeInstanceID, status.Message), FailureType, nil)
+	}
+
+	if drawn.crashNode {
+		logger.Info("Crashing node", "node", nodeID)
+		f.CrashNode(nodeID)
+	}
+	return &runtime.RuntimeActivityResponse{MetaInstance: metaInstance}, nil
+}
+
+// StopInstance records the runtime instance as TERMINATED once the stop latency elapsed. A failed stop
+// returns an error and leaves the instance as it was.
+func (f *FakeRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StopInstance", "request", req)
+
+	metaInstance, _, err := f.getRuntimeInstance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	drawn := f.draw(false)
+
+	err = sleep(ctx, drawn.latency)
+	if err != nil {
+		return nil, err
+	}
+	if drawn.fail {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("failed to stop runtime instance %s: Simulated stop failure", req.RuntimeInstanceID), FailureType, nil)
+	}
+
+	f.mu.Lock()
+	delete(f.instances, req.RuntimeInstanceID)
+	f.mu.Unlock()
+	metaInstance, err = f.updateStatus(ctx, metaInstance, req.RuntimeInstanceID, &mrdspb.RuntimeInstanceStatus{
+		State:   mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED,
+		Message: "Instance stopped",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RuntimeActivityResponse{MetaInstance: metaInstance}, nil
+}
+
+func (f *FakeRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	inst, ok := f.instances[req.RuntimeInstanceID]
+	if !ok {
+		// Instances are only forgotten by StopInstance, or when the control plane restarts.
+		return &runtime.RuntimeInstanceStatusResponse{
+			Status: &mrdspb.RuntimeInstanceStatus{
+				State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+				Message: "Instance not found",
+			},
+		}, nil
+	}
+	return &runtime.RuntimeInstanceStatusResponse{Status: inst.status}, nil
+}
+
+// CrashNode crashes the node, failing the instances running on it and the instances started on it until it
+// is recovered.
+func (f *FakeRuntime) CrashNode(nodeID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.crashed[nodeID] = true
+	for _, inst := range f.instances {
+		if inst.nodeID == nodeID {
+			inst.status = &mrdspb.RuntimeInstanceStatus{
+				State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+				Message: fmt.Sprintf("Node %s crashed", nodeID),
+			}
+		}
+	}
+}
+
+// RecoverNode lets instances start on the crashed node again. The instances which failed with it stay FAILED.
+func (f *FakeRuntime) RecoverNode(nodeID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.crashed, nodeID)
+}
+
+// CrashedNodes returns the IDs of the crashed nodes, sorted.
+func (f *FakeRuntime) CrashedNodes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	nodeIDs := make([]string, 0, len(f.crashed))
+	for nodeID := range f.crashed {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+	return nodeIDs
+}
+
+func (f *FakeRuntime) isCrashed(nodeID string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.crashed[nodeID]
+}
+
+func (f *FakeRuntime) setInstance(runtimeInstanceID string, nodeID string, status *mrdspb.RuntimeInstanceStatus) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances[runtimeInstanceID] = &instance{nodeID: nodeID, status: status}
+}
+
+// draw draws the latency and the faults of a start, or of a stop. Only starts get stuck or crash nodes.
+func (f *FakeRuntime) draw(start bool) faults {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	latency, failureRate, stuckRate, nodeCrashRate := f.profile.StopLatency, f.profile.StopFailureRate, 0.0, 0.0
+	if start {
+		latency, failureRate = f.profile.StartLatency, f.profile.StartFailureRate
+		stuckRate, nodeCrashRate = f.profile.StuckRate, f.profile.NodeCrashRate
+	}
+	drawn := faults{latency: latency}
+	if f.profile.LatencyJitter > 0 {
+		drawn.latency += time.Duration(f.rand.Int63n(int64(f.profile.LatencyJitter)))
+	}
+	drawn.fail = f.rand.Float64() < failureRate
+	drawn.stuck = !drawn.fail && f.rand.Float64() < stuckRate
+	drawn.crashNode = f.rand.Float64() < nodeCrashRate
+	return drawn
+}
+
+// getRuntimeInstance returns the meta instance of the request and the node of its runtime instance.
+func (f *FakeRuntime) getRuntimeInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*mrdspb.MetaInstance, string, error) {
+	getResp, err := f.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: req.MetaInstanceID})
+	if err != nil {
+		return nil, "", err
+	}
+	for _, ri := range getResp.Record.RuntimeInstances {
+		if ri.Id == req.RuntimeInstanceID {
+			return getResp.Record, ri.NodeId, nil
+		}
+	}
+	return nil, "", fmt.Errorf("runtime instance with ID %s not found", req.RuntimeInstanceID)
+}
+
+func (f *FakeRuntime) updateStatus(
+	ctx context.Context, metaInstance *mrdspb.MetaInstance, runtimeInstanceID string, status *mrdspb.RuntimeInstanceStatus,
+) (*mrdspb.MetaInstance, error) {
+	updateResp, err := f.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+		Metadata:          metaInstance.Metadata,
+		RuntimeInstanceId: runtimeInstanceID,
+		Status:            status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updateResp.Record, nil
+}
+
+// sleep waits for the duration, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
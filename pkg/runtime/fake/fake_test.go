@@ -0,0 +1,201 @@
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type testEnv struct {
+	ctx           context.Context
+	activityEnv   *testsuite.TestActivityEnvironment
+	metaInstances mrdspb.MetaInstancesClient
+	runtime       *FakeRuntime
+	nodeID        string
+	request       *runtime.RuntimeActivityRequest
+}
+
+// newTestEnv creates a meta instance with a runtime instance on node-1, and a fake runtime with the profile.
+func newTestEnv(t *testing.T, profile Profile) *testEnv {
+	f := testserver.NewRuntimeFixture(t, testserver.RuntimeFixtureSpec{
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: &mrdspb.ApplicationResources{Cores: 1, Memory: 1}},
+		},
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": "nginx:latest"}},
+		},
+	})
+
+	rt := NewFakeRuntime(f.MetaInstances, profile)
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := &testEnv{
+		ctx:           context.Background(),
+		activityEnv:   testSuite.NewTestActivityEnvironment(),
+		metaInstances: f.MetaInstances,
+		runtime:       rt,
+		nodeID:        f.NodeID,
+		request: &runtime.RuntimeActivityRequest{
+			MetaInstanceID:    f.MetaInstance.Metadata.Id,
+			RuntimeInstanceID: testserver.RuntimeInstanceID,
+		},
+	}
+	env.activityEnv.RegisterActivity(rt.StartInstance)
+	env.activityEnv.RegisterActivity(rt.StopInstance)
+	return env
+}
+
+// ledgerStatus returns the status of the runtime instance in the ledger.
+func (e *testEnv) ledgerStatus(t *testing.T) *mrdspb.RuntimeInstanceStatus {
+	resp, err := e.metaInstances.GetByID(e.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: e.request.MetaInstanceID})
+	require.NoError(t, err)
+	return resp.Record.RuntimeInstances[0].Status
+}
+
+// observedStatus returns the status of the runtime instance observed by the runtime.
+func (e *testEnv) observedStatus(t *testing.T) *mrdspb.RuntimeInstanceStatus {
+	resp, err := e.runtime.GetInstanceStatus(e.ctx, e.request)
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestStartAndStopInstance(t *testing.T) {
+	env := newTestEnv(t, Profile{StartLatency: 50 * time.Millisecond, StopLatency: 50 * time.Millisecond})
+
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, env.observedStatus(t).State)
+
+	start := time.Now()
+	val, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	var resp runtime.RuntimeActivityResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, resp.MetaInstance.RuntimeInstances[0].Status.State)
+	require.True(t, env.ledgerStatus(t).Ready)
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, env.observedStatus(t).State)
+
+	val, err = env.activityEnv.ExecuteActivity("StopInstance", env.request)
+	require.NoError(t, err)
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED, resp.MetaInstance.RuntimeInstances[0].Status.State)
+
+	// A stopped instance is reported as lost, since it is only asked about while it should be running.
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, env.observedStatus(t).State)
+}
+
+func TestFaults(t *testing.T) {
+	t.Run("Failed start fails the instance", func(t *testing.T) {
+		env := newTestEnv(t, Profile{StartFailureRate: 1})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.ErrorContains(t, err, "Simulated start failure")
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, env.ledgerStatus(t).State)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, env.observedStatus(t).State)
+	})
+
+	t.Run("Failed stop leaves the instance running", func(t *testing.T) {
+		env := newTestEnv(t, Profile{StopFailureRate: 1})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		_, err = env.activityEnv.ExecuteActivity("StopInstance", env.request)
+		require.ErrorContains(t, err, "Simulated stop failure")
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, env.ledgerStatus(t).State)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, env.observedStatus(t).State)
+	})
+
+	t.Run("Stuck instance stays starting", func(t *testing.T) {
+		env := newTestEnv(t, Profile{StuckRate: 1})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_STARTING, env.ledgerStatus(t).State)
+		require.False(t, env.ledgerStatus(t).Ready)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_STARTING, env.observedStatus(t).State)
+	})
+
+	t.Run("Node crash fails its instances", func(t *testing.T) {
+		env := newTestEnv(t, Profile{NodeCrashRate: 1})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		require.Equal(t, []string{env.nodeID}, env.runtime.CrashedNodes())
+
+		// The crash is only observed by the runtime, and left to the reconciler to record.
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, env.ledgerStatus(t).State)
+		status := env.observedStatus(t)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, status.State)
+		require.Equal(t, "Node "+env.nodeID+" crashed", status.Message)
+	})
+
+	t.Run("Start on a crashed node fails until it is recovered", func(t *testing.T) {
+		env := newTestEnv(t, Profile{})
+		env.runtime.CrashNode(env.nodeID)
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.ErrorContains(t, err, "crashed")
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, env.ledgerStatus(t).State)
+
+		env.runtime.RecoverNode(env.nodeID)
+		require.Empty(t, env.runtime.CrashedNodes())
+		_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, env.observedStatus(t).State)
+	})
+}
+
+func TestParseProfile(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    map[string]string
+		expected  Profile
+		expectErr bool
+	}{
+		{name: "Empty config", config: nil, expected: Profile{}},
+		{
+			name: "Full config",
+			config: map[string]string{
+				ConfigStartLatency:     "2s",
+				ConfigStopLatency:      "500ms",
+				ConfigLatencyJitter:    "100ms",
+				ConfigStartFailureRate: "0.1",
+				ConfigStopFailureRate:  "0.05",
+				ConfigStuckRate:        "0.2",
+				ConfigNodeCrashRate:    "0.01",
+				ConfigSeed:             "42",
+			},
+			expected: Profile{
+				StartLatency:     2 * time.Second,
+				StopLatency:      500 * time.Millisecond,
+				LatencyJitter:    100 * time.Millisecond,
+				StartFailureRate: 0.1,
+				StopFailureRate:  0.05,
+				StuckRate:        0.2,
+				NodeCrashRate:    0.01,
+				Seed:             42,
+			},
+		},
+		{name: "Invalid latency", config: map[string]string{ConfigStartLatency: "soon"}, expectErr: true},
+		{name: "Negative latency", config: map[string]string{ConfigStopLatency: "-1s"}, expectErr: true},
+		{name: "Invalid rate", config: map[string]string{ConfigStuckRate: "often"}, expectErr: true},
+		{name: "Rate above one", config: map[string]string{ConfigStartFailureRate: "1.5"}, expectErr: true},
+		{name: "Invalid seed", config: map[string]string{ConfigSeed: "1.5"}, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile, err := ParseProfile(tc.config)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, profile)
+		})
+	}
+}
@@ -8,12 +8,25 @@ import (
 
 	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
 	"github.com/msanath/mrds/gen/api/mrdspb"
+	k8sruntime "github.com/msanath/mrds/pkg/runtime/kubernetes"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/worker"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RuntimeType is the runtime type of the clusters run by the kind runtime.
+const RuntimeType = "kind"
+
+// Keys of the runtime config of a kind cluster.
+const (
+	// ConfigKubeconfig is the path of the kubeconfig file. The default kubeconfig file is used when unset.
+	ConfigKubeconfig = "kubeconfig"
+	// ConfigContext is the kubeconfig context of the cluster. The current context is used when unset.
+	ConfigContext = "context"
 )
 
 type KindRuntime struct {
@@ -39,15 +52,43 @@ func NewKindRuntime(
 	return a
 }
 
+// NewFactory returns the factory of the kind runtimes of the clusters, which connects to the kind cluster
+// selected by the runtime config.
+func NewFactory(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+) runtime.Factory {
+	return func(config map[string]string) (runtime.RuntimeActivities, error) {
+		kubeconfig := config[ConfigKubeconfig]
+		if kubeconfig == "" {
+			kubeconfig = clientcmd.RecommendedHomeFile
+		}
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+			&clientcmd.ConfigOverrides{CurrentContext: config[ConfigContext]},
+		).ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewKindRuntime(metaInstancesClient, deploymentPlanClient, nodesClient, clientset), nil
+	}
+}
+
 func (k *KindRuntime) Register(w worker.Registry) {
 	w.RegisterActivity(k.StartInstance)
 	w.RegisterActivity(k.StopInstance)
+	w.RegisterActivity(k.GetInstanceStatus)
 }
 
 func (k *KindRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
 	activity.GetLogger(ctx).Info("StartInstance", "request", req)
 
-	runtimeDetails, err := k.getRuntimeDetails(ctx, req.MetaInstanceID, req.RuntimeInstanceID)
+	runtimeDetails, err := runtime.GetRuntimeDetails(ctx, k.metaInstancesClient, k.deploymentPlanClient, k.nodesClient, req)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +96,7 @@ func (k *KindRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeAct
 	return k.buildAndCreatePod(ctx, runtimeDetails)
 }
 
-func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *runtimeDetails) (*runtime.RuntimeActivityResponse, error) {
+func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *runtime.RuntimeDetails) (*runtime.RuntimeActivityResponse, error) {
 	activity.GetLogger(ctx).Info("Creating Pod")
 
 	var deployment *mrdspb.Deployment
@@ -69,12 +110,19 @@ func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *run
 		return nil, fmt.Errorf("deployment with ID %s not found", runtimeDetails.MetaInstance.DeploymentId)
 	}
 
+	applications := make(map[string]*mrdspb.Application)
+	for _, app := range runtimeDetails.DeploymentPlan.Applications {
+		applications[app.PayloadName] = app
+	}
+
 	// build containers for the payloard
 	containers := make([]corev1.Container, 0)
 	for _, app := range deployment.PayloadCoordinates {
 		containers = append(containers, corev1.Container{
-			Name:  app.PayloadName,
-			Image: app.Coordinates["image"],
+			Name:           app.PayloadName,
+			Image:          app.Coordinates["image"],
+			LivenessProbe:  k8sruntime.ProbeToK8s(applications[app.PayloadName].GetLivenessProbe()),
+			ReadinessProbe: k8sruntime.ProbeToK8s(applications[app.PayloadName].GetReadinessProbe()),
 		})
 	}
 
@@ -115,8 +163,8 @@ func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *run
 	for {
 		select {
 		case <-timeout:
-			activity.GetLogger(ctx).Error("Timed out waiting for pod to reach 'Running' state", "pod", pod)
-			return nil, fmt.Errorf("timed out waiting for pod %s to reach 'Running' state", podName)
+			activity.GetLogger(ctx).Error("Timed out waiting for pod to be ready", "pod", pod)
+			return nil, fmt.Errorf("timed out waiting for pod %s to be ready", podName)
 		case <-ticker.C:
 			pod, err := k.k8sClientSet.CoreV1().Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
 			if err != nil {
@@ -124,20 +172,9 @@ func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *run
 				continue
 			}
 
-			var state mrdspb.RuntimeInstanceState
-			switch pod.Status.Phase {
-			case corev1.PodPending:
-				state = mrdspb.RuntimeInstanceState_RuntimeState_STARTING
-			case corev1.PodRunning:
-				state = mrdspb.RuntimeInstanceState_RuntimeState_RUNNING
-			case corev1.PodSucceeded:
-				state = mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
-			case corev1.PodFailed:
-				state = mrdspb.RuntimeInstanceState_RuntimeState_FAILED
-			case corev1.PodUnknown:
-				state = mrdspb.RuntimeInstanceState_RuntimeState_UNKNOWN
-			}
+			state := k8sruntime.PodState(pod)
 			message := pod.Status.Message
+			ready := k8sruntime.IsPodReady(pod)
 
 			// Update the runtime state to running.
 			updateResp, err := k.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
@@ -146,19 +183,21 @@ func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *run
 				Status: &mrdspb.RuntimeInstanceStatus{
 					State:   state,
 					Message: message,
+					Ready:   ready,
 				},
 			})
 			if err != nil {
 				activity.GetLogger(ctx).Error("Failed to update runtime status", "error", err.Error())
 				return nil, err
 			}
+			runtimeDetails.MetaInstance = updateResp.Record
 
-			// Check if the pod is in the 'Running' phase
-			if pod.Status.Phase == corev1.PodRunning {
-				activity.GetLogger(ctx).Info("Pod is running", "pod", pod)
+			// Check if the pod is running and passes its readiness probes
+			if ready {
+				activity.GetLogger(ctx).Info("Pod is ready", "pod", pod)
 				return &runtime.RuntimeActivityResponse{MetaInstance: updateResp.Record}, nil
 			} else {
-				activity.GetLogger(ctx).Info("Pod is not running yet", "pod", pod)
+				activity.GetLogger(ctx).Info("Pod is not ready yet", "pod", pod)
 			}
 		}
 	}
@@ -167,7 +206,7 @@ func (k *KindRuntime) buildAndCreatePod(ctx context.Context, runtimeDetails *run
 func (k *KindRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
 	activity.GetLogger(ctx).Info("StopInstance", "request", req)
 
-	runtimeDetails, err := k.getRuntimeDetails(ctx, req.MetaInstanceID, req.RuntimeInstanceID)
+	runtimeDetails, err := runtime.GetRuntimeDetails(ctx, k.metaInstancesClient, k.deploymentPlanClient, k.nodesClient, req)
 	if err != nil {
 		return nil, err
 	}
@@ -215,18 +254,7 @@ func (k *KindRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActi
 			var message string
 			var state mrdspb.RuntimeInstanceState
 			if !isDeleted {
-				switch pod.Status.Phase {
-				case corev1.PodPending:
-					state = mrdspb.RuntimeInstanceState_RuntimeState_STARTING
-				case corev1.PodRunning:
-					state = mrdspb.RuntimeInstanceState_RuntimeState_RUNNING
-				case corev1.PodSucceeded:
-					state = mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
-				case corev1.PodFailed:
-					state = mrdspb.RuntimeInstanceState_RuntimeState_FAILED
-				case corev1.PodUnknown:
-					state = mrdspb.RuntimeInstanceState_RuntimeState_UNKNOWN
-				}
+				state = k8sruntime.PodState(pod)
 				message = pod.Status.Message
 			} else {
 				state = mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
@@ -256,59 +284,29 @@ func (k *KindRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActi
 	}
 }
 
-type runtimeDetails struct {
-	MetaInstance    *mrdspb.MetaInstance
-	RuntimeInstance *mrdspb.RuntimeInstance
-	DeploymentPlan  *mrdspb.DeploymentPlanRecord
-	Node            *mrdspb.Node
-}
+func (k *KindRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	podName := req.RuntimeInstanceID
+	namespace := "default"
 
-func (k *KindRuntime) getRuntimeDetails(ctx context.Context, metaInstanceID string, runtimeInstanceID string) (*runtimeDetails, error) {
-	// Get the corresponding meta instance
-	metaInstanceGetResp, err := k.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{
-		Id: metaInstanceID,
-	})
+	pod, err := k.k8sClientSet.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
-		return nil, err
-	}
-
-	found := false
-	runtimeInstance := &mrdspb.RuntimeInstance{}
-	for _, ri := range metaInstanceGetResp.Record.RuntimeInstances {
-		if ri.Id == runtimeInstanceID {
-			found = true
-			runtimeInstance = ri
-			break
+		if apierrors.IsNotFound(err) {
+			// Pods are only deleted by StopInstance, so a missing pod was lost with its node or removed by hand.
+			return &runtime.RuntimeInstanceStatusResponse{
+				Status: &mrdspb.RuntimeInstanceStatus{
+					State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+					Message: "Pod not found",
+				},
+			}, nil
 		}
+		return nil, fmt.Errorf("failed to get pod: %v", err)
 	}
-	if !found {
-		return nil, fmt.Errorf("runtime instance %s not found in meta instance %s", metaInstanceID, metaInstanceID)
-	}
-
-	// Get the node
-	nodeGetResp, err := k.nodesClient.GetByID(ctx, &mrdspb.GetNodeByIDRequest{
-		Id: runtimeInstance.NodeId,
-	})
-	if err != nil {
-		return nil, err
-	}
-	node := nodeGetResp.Record
-	activity.GetLogger(ctx).Info("Node", "node", node)
 
-	// Get the corresponding deployment plan
-	deploymentPlanGetResp, err := k.deploymentPlanClient.GetByID(ctx, &mrdspb.GetDeploymentPlanByIDRequest{
-		Id: metaInstanceGetResp.Record.DeploymentPlanId,
-	})
-	if err != nil {
-		return nil, err
-	}
-	plan := deploymentPlanGetResp.Record
-	activity.GetLogger(ctx).Info("Deployment Plan", "plan", plan)
-
-	return &runtimeDetails{
-		MetaInstance:    metaInstanceGetResp.Record,
-		RuntimeInstance: runtimeInstance,
-		DeploymentPlan:  plan,
-		Node:            node,
+	return &runtime.RuntimeInstanceStatusResponse{
+		Status: &mrdspb.RuntimeInstanceStatus{
+			State:   k8sruntime.PodState(pod),
+			Message: pod.Status.Message,
+			Ready:   k8sruntime.IsPodReady(pod),
+		},
 	}, nil
 }
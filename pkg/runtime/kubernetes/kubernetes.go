@@ -0,0 +1,295 @@
+// This file implements a runtime which runs the instances as pods of a kubernetes cluster.
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// RuntimeType is the runtime type of the clusters run by the kubernetes runtime.
+const RuntimeType = "kubernetes"
+
+// Keys of the runtime config of a kubernetes cluster.
+const (
+	// ConfigKubeconfig is the path of the kubeconfig file. The in-cluster config is used when unset and the
+	// control plane runs in a pod, the default kubeconfig file otherwise.
+	ConfigKubeconfig = "kubeconfig"
+	// ConfigContext is the kubeconfig context of the cluster. The current context is used when unset.
+	ConfigContext = "context"
+)
+
+var (
+	// podPollInterval is how often the pod of an instance is checked while it starts or stops.
+	podPollInterval = 5 * time.Second
+	// podTimeout is how long an instance may take to start or stop.
+	podTimeout = 5 * time.Minute
+)
+
+type KubernetesRuntime struct {
+	metaInstancesClient  mrdspb.MetaInstancesClient
+	deploymentPlanClient mrdspb.DeploymentPlansClient
+	nodesClient          mrdspb.NodesClient
+
+	k8sClient k8s.Interface
+}
+
+func NewKubernetesRuntime(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+	k8sClient k8s.Interface,
+) runtime.RuntimeActivities {
+	return &KubernetesRuntime{
+		metaInstancesClient:  metaInstancesClient,
+		deploymentPlanClient: deploymentPlanClient,
+		nodesClient:          nodesClient,
+		k8sClient:            k8sClient,
+	}
+}
+
+// NewFactory returns the factory of the kubernetes runtimes of the clusters, which connects to the cluster
+// selected by the runtime config.
+func NewFactory(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+) runtime.Factory {
+	return func(config map[string]string) (runtime.RuntimeActivities, error) {
+		k8sClient, err := NewClient(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewKubernetesRuntime(metaInstancesClient, deploymentPlanClient, nodesClient, k8sClient), nil
+	}
+}
+
+// NewClient returns a client of the kubernetes cluster selected by the runtime config.
+func NewClient(config map[string]string) (k8s.Interface, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = config[ConfigKubeconfig]
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: config[ConfigContext]},
+	).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return k8s.NewForConfig(restConfig)
+}
+
+func (k *KubernetesRuntime) Register(w worker.Registry) {
+	w.RegisterActivity(k.StartInstance)
+	w.RegisterActivity(k.StopInstance)
+	w.RegisterActivity(k.GetInstanceStatus)
+}
+
+// StartInstance creates the pod of the runtime instance with the claims of its persistent volumes, and waits
+// until the pod is ready. An existing pod is deleted and created again when it runs another deployment or
+// images, or when a restart is requested.
+func (k *KubernetesRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StartInstance", "request", req)
+
+	details, err := runtime.GetRuntimeDetails(ctx, k.metaInstancesClient, k.deploymentPlanClient, k.nodesClient, req)
+	if err != nil {
+		return nil, err
+	}
+	pod, claims, err := BuildPod(details)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := k.getPod(ctx, pod.Namespace, pod.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && !req.Restart && IsPodOf(existing, pod) {
+		logger.Info("Pod already exists", "pod", pod.Name)
+		return k.waitForPod(ctx, details, pod.Namespace, func(pod *corev1.Pod) bool {
+			return pod != nil && IsPodReady(pod)
+		})
+	}
+	if existing != nil {
+		logger.Info("Deleting pod to start it again", "pod", pod.Name, "restart", req.Restart)
+		_, err := k.deletePod(ctx, details, pod.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, claim := range claims {
+		_, err := k.k8sClient.CoreV1().PersistentVolumeClaims(pod.Namespace).Create(ctx, claim, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create persistent volume claim %s: %w", claim.Name, err)
+		}
+	}
+	_, err = k.k8sClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create pod %s: %w", pod.Name, err)
+		}
+		logger.Info("Pod already exists", "pod", pod.Name)
+	} else {
+		logger.Info("Pod created", "pod", pod.Name)
+	}
+
+	return k.waitForPod(ctx, details, pod.Namespace, func(pod *corev1.Pod) bool {
+		return pod != nil && IsPodReady(pod)
+	})
+}
+
+// StopInstance deletes the pod of the runtime instance and waits until it is gone. The claims of its
+// persistent volumes are kept for the pod to start again with its data, and are only deleted when the
+// runtime instance is removed, since a new runtime instance gets volumes of its own.
+func (k *KubernetesRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StopInstance", "request", req)
+
+	details, err := runtime.GetRuntimeDetails(ctx, k.metaInstancesClient, k.deploymentPlanClient, k.nodesClient, req)
+	if err != nil {
+		return nil, err
+	}
+	namespace := details.DeploymentPlan.Namespace
+	podName := details.RuntimeInstance.Id
+
+	resp, err := k.deletePod(ctx, details, namespace)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Pod deleted", "pod", podName)
+	if !req.Remove {
+		return resp, nil
+	}
+
+	claims, err := k.k8sClient.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{LabelRuntimeInstance: podName}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistent volume claims of pod %s: %w", podName, err)
+	}
+	for _, claim := range claims.Items {
+		err := k.k8sClient.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, claim.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete persistent volume claim %s: %w", claim.Name, err)
+		}
+	}
+	logger.Info("Persistent volume claims deleted", "pod", podName, "count", len(claims.Items))
+	return resp, nil
+}
+
+func (k *KubernetesRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	details, err := runtime.GetRuntimeDetails(ctx, k.metaInstancesClient, k.deploymentPlanClient, k.nodesClient, req)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := k.getPod(ctx, details.DeploymentPlan.Namespace, details.RuntimeInstance.Id)
+	if err != nil {
+		return nil, err
+	}
+	if pod == nil {
+		// Pods are only deleted by StopInstance, so a missing pod was lost with its node or removed by hand.
+		return &runtime.RuntimeInstanceStatusResponse{
+			Status: &mrdspb.RuntimeInstanceStatus{
+				State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+				Message: "Pod not found",
+			},
+		}, nil
+	}
+	return &runtime.RuntimeInstanceStatusResponse{Status: podStatus(pod)}, nil
+}
+
+// waitForPod records the status of the pod of the runtime instance in the ledger until done returns true
+// for the pod, which is nil once the pod does not exist.
+func (k *KubernetesRuntime) waitForPod(
+	ctx context.Context, details *runtime.RuntimeDetails, namespace string, done func(pod *corev1.Pod) bool,
+) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	podName := details.RuntimeInstance.Id
+
+	ticker := time.NewTicker(podPollInterval)
+	defer ticker.Stop()
+	timeout := time.After(podTimeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, fmt.Errorf("timed out waiting for pod %s", podName)
+		case <-ticker.C:
+		}
+
+		pod, err := k.getPod(ctx, namespace, podName)
+		if err != nil {
+			logger.Error("Failed to get pod", "pod", podName, "error", err)
+			continue
+		}
+		status := &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED,
+			Message: "Pod terminated",
+		}
+		if pod != nil {
+			status = podStatus(pod)
+		}
+
+		updateResp, err := k.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+			Metadata:          details.MetaInstance.Metadata,
+			RuntimeInstanceId: podName,
+			Status:            status,
+		})
+		if err != nil {
+			logger.Error("Failed to update runtime status", "error", err)
+			return nil, err
+		}
+		details.MetaInstance = updateResp.Record
+
+		if done(pod) {
+			return &runtime.RuntimeActivityResponse{MetaInstance: updateResp.Record}, nil
+		}
+		logger.Info("Waiting for pod", "pod", podName, "state", status.State)
+	}
+}
+
+// deletePod deletes the pod of the runtime instance and waits until it is gone.
+func (k *KubernetesRuntime) deletePod(ctx context.Context, details *runtime.RuntimeDetails, namespace string) (*runtime.RuntimeActivityResponse, error) {
+	podName := details.RuntimeInstance.Id
+	err := k.k8sClient.CoreV1().Pods(namespace).Delete(ctx, podName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to delete pod %s: %w", podName, err)
+	}
+	return k.waitForPod(ctx, details, namespace, func(pod *corev1.Pod) bool {
+		return pod == nil
+	})
+}
+
+// getPod returns the pod with the given name, or nil when it does not exist.
+func (k *KubernetesRuntime) getPod(ctx context.Context, namespace string, name string) (*corev1.Pod, error) {
+	pod, err := k.k8sClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pod %s: %w", name, err)
+	}
+	return pod, nil
+}
+
+func podStatus(pod *corev1.Pod) *mrdspb.RuntimeInstanceStatus {
+	return &mrdspb.RuntimeInstanceStatus{
+		State:   PodState(pod),
+		Message: pod.Status.Message,
+		Ready:   IsPodReady(pod),
+	}
+}
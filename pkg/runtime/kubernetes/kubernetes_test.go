@@ -0,0 +1,269 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type testEnv struct {
+	ctx             context.Context
+	activityEnv     *testsuite.TestActivityEnvironment
+	metaInstances   mrdspb.MetaInstancesClient
+	deploymentPlans mrdspb.DeploymentPlansClient
+	k8sClient       *fake.Clientset
+	metaInstance    *mrdspb.MetaInstance
+	planID          string
+}
+
+// newTestEnv creates a meta instance of a deployment plan in the given namespace, with a runtime instance on
+// node-1, and a runtime whose pods are ready once created.
+func newTestEnv(t *testing.T, namespace string) *testEnv {
+	defaultPodPollInterval := podPollInterval
+	t.Cleanup(func() { podPollInterval = defaultPodPollInterval })
+	podPollInterval = 10 * time.Millisecond
+
+	f := testserver.NewRuntimeFixture(t, testserver.RuntimeFixtureSpec{
+		Namespace: namespace,
+		Applications: []*mrdspb.Application{
+			{
+				PayloadName: "web",
+				Resources:   &mrdspb.ApplicationResources{Cores: 2, Memory: 512},
+				Ports: []*mrdspb.ApplicationPort{
+					{Protocol: "tcp", Port: 80},
+				},
+				PersistentVolumes: []*mrdspb.ApplicationPersistentVolume{
+					{StorageClass: "fast-ssd", Capacity: 10, MountPath: "/data"},
+				},
+				ReadinessProbe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_HTTP, Path: "/healthz", Port: 80},
+			},
+			{
+				PayloadName: "sidecar",
+				Resources:   &mrdspb.ApplicationResources{Cores: 1, Memory: 64},
+			},
+		},
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "web", Coordinates: map[string]string{"image": "nginx:latest"}},
+			{PayloadName: "sidecar", Coordinates: map[string]string{"image": "busybox:latest"}},
+		},
+		LocalVolumes: []*mrdspb.NodeLocalVolume{
+			{MountPath: "/mnt/ssd", StorageClass: "fast-ssd", StorageCapacity: 100},
+		},
+	})
+
+	// The fake clientset does not run pods, so they are made ready when they are created.
+	k8sClient := fake.NewSimpleClientset()
+	k8sClient.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, k8sruntime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		return false, nil, nil
+	})
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := &testEnv{
+		ctx:             context.Background(),
+		activityEnv:     testSuite.NewTestActivityEnvironment(),
+		metaInstances:   f.MetaInstances,
+		deploymentPlans: f.DeploymentPlans,
+		k8sClient:       k8sClient,
+		metaInstance:    f.MetaInstance,
+		planID:          f.PlanID,
+	}
+	rt := NewKubernetesRuntime(f.MetaInstances, f.DeploymentPlans, f.Nodes, k8sClient)
+	env.activityEnv.RegisterActivity(rt.StartInstance)
+	env.activityEnv.RegisterActivity(rt.StopInstance)
+	env.activityEnv.RegisterActivity(rt.GetInstanceStatus)
+	return env
+}
+
+func (e *testEnv) request() *runtime.RuntimeActivityRequest {
+	return &runtime.RuntimeActivityRequest{
+		MetaInstanceID:    e.metaInstance.Metadata.Id,
+		RuntimeInstanceID: e.metaInstance.RuntimeInstances[0].Id,
+	}
+}
+
+func TestStartInstance(t *testing.T) {
+	env := newTestEnv(t, "team-a")
+
+	val, err := env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	var resp runtime.RuntimeActivityResponse
+	require.NoError(t, val.Get(&resp))
+	status := resp.MetaInstance.RuntimeInstances[0].Status
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, status.State)
+	require.True(t, status.Ready)
+
+	// The pod runs in the namespace of the plan on the node of the runtime instance.
+	pod, err := env.k8sClient.CoreV1().Pods("team-a").Get(env.ctx, "plan-0-runtime-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Empty(t, pod.Spec.NodeName)
+	terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	require.Equal(t, []corev1.NodeSelectorTerm{{
+		MatchFields: []corev1.NodeSelectorRequirement{
+			{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-1"}},
+		},
+	}}, terms)
+	require.Equal(t, map[string]string{
+		LabelDeploymentPlan:  env.planID,
+		LabelDeployment:      "deployment-1",
+		LabelMetaInstance:    env.metaInstance.Metadata.Id,
+		LabelRuntimeInstance: "plan-0-runtime-0",
+	}, pod.Labels)
+
+	require.Len(t, pod.Spec.Containers, 2)
+	web := pod.Spec.Containers[0]
+	require.Equal(t, "web", web.Name)
+	require.Equal(t, "nginx:latest", web.Image)
+	require.True(t, resource.MustParse("2").Equal(web.Resources.Requests[corev1.ResourceCPU]))
+	require.True(t, resource.MustParse("512Mi").Equal(web.Resources.Requests[corev1.ResourceMemory]))
+	require.Equal(t, []corev1.ContainerPort{{ContainerPort: 80, Protocol: corev1.ProtocolTCP}}, web.Ports)
+	require.Equal(t, "/healthz", web.ReadinessProbe.HTTPGet.Path)
+	require.Equal(t, []corev1.VolumeMount{{Name: "volume-0", MountPath: "/data"}}, web.VolumeMounts)
+	sidecar := pod.Spec.Containers[1]
+	require.Equal(t, "busybox:latest", sidecar.Image)
+	require.Empty(t, sidecar.Ports)
+	require.Empty(t, sidecar.VolumeMounts)
+
+	// The persistent volume is claimed with the storage class and capacity of the application.
+	require.Len(t, pod.Spec.Volumes, 1)
+	require.Equal(t, "plan-0-runtime-0-volume-0", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	claim, err := env.k8sClient.CoreV1().PersistentVolumeClaims("team-a").Get(env.ctx, "plan-0-runtime-0-volume-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "fast-ssd", *claim.Spec.StorageClassName)
+	require.True(t, resource.MustParse("10Gi").Equal(claim.Spec.Resources.Requests[corev1.ResourceStorage]))
+	require.Equal(t, "plan-0-runtime-0", claim.Labels[LabelRuntimeInstance])
+
+	// Starting the instance again is a no-op.
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+}
+
+// podCreates returns the number of pods created in the namespace.
+func (e *testEnv) podCreates(namespace string) int {
+	count := 0
+	for _, action := range e.k8sClient.Actions() {
+		if action.Matches("create", "pods") && action.GetNamespace() == namespace {
+			count++
+		}
+	}
+	return count
+}
+
+func TestStartInstanceReplacesPod(t *testing.T) {
+	env := newTestEnv(t, "team-c")
+
+	_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	require.Equal(t, 1, env.podCreates("team-c"))
+
+	// A restart creates the pod again.
+	req := env.request()
+	req.Restart = true
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", req)
+	require.NoError(t, err)
+	require.Equal(t, 2, env.podCreates("team-c"))
+
+	// An update to a new deployment creates the pod again with its images.
+	planResp, err := env.deploymentPlans.GetByID(env.ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: env.planID})
+	require.NoError(t, err)
+	_, err = env.deploymentPlans.AddDeployment(env.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-2",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "web", Coordinates: map[string]string{"image": "nginx:1.27"}},
+			{PayloadName: "sidecar", Coordinates: map[string]string{"image": "busybox:latest"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+	getResp, err := env.metaInstances.GetByID(env.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: env.metaInstance.Metadata.Id})
+	require.NoError(t, err)
+	_, err = env.metaInstances.UpdateDeploymentID(env.ctx, &mrdspb.UpdateDeploymentIDRequest{
+		Metadata:     getResp.Record.Metadata,
+		DeploymentId: "deployment-2",
+	})
+	require.NoError(t, err)
+
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	require.Equal(t, 3, env.podCreates("team-c"))
+	pod, err := env.k8sClient.CoreV1().Pods("team-c").Get(env.ctx, "plan-0-runtime-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "deployment-2", pod.Labels[LabelDeployment])
+	require.Equal(t, "nginx:1.27", pod.Spec.Containers[0].Image)
+
+	// A pod running the deployment is left as is.
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	require.Equal(t, 3, env.podCreates("team-c"))
+}
+
+func TestStopInstance(t *testing.T) {
+	env := newTestEnv(t, "team-b")
+
+	_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	_, err = env.k8sClient.CoreV1().Pods("team-b").Get(env.ctx, "plan-0-runtime-0", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	val, err := env.activityEnv.ExecuteActivity("StopInstance", env.request())
+	require.NoError(t, err)
+	var resp runtime.RuntimeActivityResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED, resp.MetaInstance.RuntimeInstances[0].Status.State)
+
+	pods, err := env.k8sClient.CoreV1().Pods("team-b").List(env.ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, pods.Items)
+	// The claims are kept for the instance to start again with its data.
+	claims, err := env.k8sClient.CoreV1().PersistentVolumeClaims("team-b").List(env.ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, claims.Items, 1)
+
+	// Stopping a stopped instance is a no-op.
+	_, err = env.activityEnv.ExecuteActivity("StopInstance", env.request())
+	require.NoError(t, err)
+
+	// Removing the instance deletes its claims.
+	req := env.request()
+	req.Remove = true
+	_, err = env.activityEnv.ExecuteActivity("StopInstance", req)
+	require.NoError(t, err)
+	claims, err = env.k8sClient.CoreV1().PersistentVolumeClaims("team-b").List(env.ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, claims.Items)
+}
+
+func TestGetInstanceStatus(t *testing.T) {
+	env := newTestEnv(t, "team-a")
+
+	val, err := env.activityEnv.ExecuteActivity("GetInstanceStatus", env.request())
+	require.NoError(t, err)
+	var resp runtime.RuntimeInstanceStatusResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, resp.Status.State)
+	require.Equal(t, "Pod not found", resp.Status.Message)
+
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request())
+	require.NoError(t, err)
+	val, err = env.activityEnv.ExecuteActivity("GetInstanceStatus", env.request())
+	require.NoError(t, err)
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, resp.Status.State)
+	require.True(t, resp.Status.Ready)
+}
@@ -0,0 +1,231 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Labels set on the pods and persistent volume claims of the runtime instances.
+const (
+	LabelDeploymentPlan  = "mrds.io/deployment-plan"  // ID of the deployment plan.
+	LabelDeployment      = "mrds.io/deployment"       // ID of the deployment.
+	LabelMetaInstance    = "mrds.io/meta-instance"    // ID of the meta instance.
+	LabelRuntimeInstance = "mrds.io/runtime-instance" // ID of the runtime instance, which is the name of the pod.
+)
+
+// BuildPod builds the pod of a runtime instance, with a container for each payload of its deployment, and
+// the claims of the persistent volumes of its applications.
+//
+// The pod is pinned to the node picked by the scheduler with a required node affinity on the node name, so
+// that kubernetes still admits it and binds its volumes, but cannot place it elsewhere. Memory is in MB and
+// the capacity of persistent volumes in GB.
+func BuildPod(details *runtime.RuntimeDetails) (*corev1.Pod, []*corev1.PersistentVolumeClaim, error) {
+	deployment, err := details.Deployment()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	podName := details.RuntimeInstance.Id
+	namespace := details.DeploymentPlan.Namespace
+	podLabels := map[string]string{
+		LabelDeploymentPlan:  details.DeploymentPlan.Metadata.GetId(),
+		LabelDeployment:      deployment.Id,
+		LabelMetaInstance:    details.MetaInstance.Metadata.GetId(),
+		LabelRuntimeInstance: podName,
+	}
+
+	var containers []corev1.Container
+	var volumes []corev1.Volume
+	var claims []*corev1.PersistentVolumeClaim
+	for _, payload := range deployment.PayloadCoordinates {
+		app := details.Application(payload.PayloadName)
+		if app == nil {
+			return nil, nil, fmt.Errorf("application of payload %s not found", payload.PayloadName)
+		}
+
+		container := corev1.Container{
+			Name:           payload.PayloadName,
+			Image:          payload.Coordinates["image"],
+			Resources:      resourcesToK8s(app.Resources),
+			LivenessProbe:  ProbeToK8s(app.LivenessProbe),
+			ReadinessProbe: ProbeToK8s(app.ReadinessProbe),
+		}
+		for _, port := range app.Ports {
+			container.Ports = append(container.Ports, corev1.ContainerPort{
+				ContainerPort: int32(port.Port),
+				Protocol:      corev1.Protocol(strings.ToUpper(port.Protocol)),
+			})
+		}
+
+		// The volumes are numbered across the applications, since payload names need not be valid names.
+		for _, pv := range app.PersistentVolumes {
+			volumeName := fmt.Sprintf("volume-%d", len(volumes))
+			claimName := fmt.Sprintf("%s-%s", podName, volumeName)
+			storageClass := pv.StorageClass
+			claims = append(claims, &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      claimName,
+					Namespace: namespace,
+					Labels:    podLabels,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &storageClass,
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: *resource.NewQuantity(int64(pv.Capacity)<<30, resource.BinarySI),
+						},
+					},
+				},
+			})
+			volumes = append(volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			})
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: pv.MountPath,
+			})
+		}
+		containers = append(containers, container)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: namespace,
+			Labels:    podLabels,
+		},
+		Spec: corev1.PodSpec{
+			Affinity:   nodeAffinity(details.Node.Name),
+			Containers: containers,
+			Volumes:    volumes,
+		},
+	}
+	return pod, claims, nil
+}
+
+// nodeAffinity returns the affinity requiring the pod to run on the node.
+func nodeAffinity(nodeName string) *corev1.Affinity {
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchFields: []corev1.NodeSelectorRequirement{{
+						Key:      metav1.ObjectNameField,
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   []string{nodeName},
+					}},
+				}},
+			},
+		},
+	}
+}
+
+// IsPodOf returns true when the pod runs the deployment and the images of the desired pod, built by BuildPod.
+func IsPodOf(pod *corev1.Pod, desired *corev1.Pod) bool {
+	if pod.Labels[LabelDeployment] != desired.Labels[LabelDeployment] {
+		return false
+	}
+	if len(pod.Spec.Containers) != len(desired.Spec.Containers) {
+		return false
+	}
+	images := make(map[string]string, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		images[container.Name] = container.Image
+	}
+	for _, container := range desired.Spec.Containers {
+		image, ok := images[container.Name]
+		if !ok || image != container.Image {
+			return false
+		}
+	}
+	return true
+}
+
+// resourcesToK8s translates the resources of an application into the requests of its container.
+func resourcesToK8s(resources *mrdspb.ApplicationResources) corev1.ResourceRequirements {
+	requests := corev1.ResourceList{}
+	if resources.GetCores() > 0 {
+		requests[corev1.ResourceCPU] = *resource.NewQuantity(int64(resources.Cores), resource.DecimalSI)
+	}
+	if resources.GetMemory() > 0 {
+		requests[corev1.ResourceMemory] = *resource.NewQuantity(int64(resources.Memory)<<20, resource.BinarySI)
+	}
+	return corev1.ResourceRequirements{Requests: requests}
+}
+
+// ProbeToK8s translates a probe of an application into a container probe. It returns nil when the
+// application has no probe.
+func ProbeToK8s(probe *mrdspb.Probe) *corev1.Probe {
+	var handler corev1.ProbeHandler
+	switch probe.GetType() {
+	case mrdspb.ProbeType_ProbeType_HTTP:
+		path := probe.Path
+		if path == "" {
+			path = "/"
+		}
+		handler.HTTPGet = &corev1.HTTPGetAction{
+			Path: path,
+			Port: intstr.FromInt32(int32(probe.Port)),
+		}
+	case mrdspb.ProbeType_ProbeType_TCP:
+		handler.TCPSocket = &corev1.TCPSocketAction{
+			Port: intstr.FromInt32(int32(probe.Port)),
+		}
+	case mrdspb.ProbeType_ProbeType_EXEC:
+		handler.Exec = &corev1.ExecAction{
+			Command: probe.Command,
+		}
+	default:
+		return nil
+	}
+
+	// Zero values are left for kubernetes to default.
+	return &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: int32(probe.InitialDelaySeconds),
+		PeriodSeconds:       int32(probe.PeriodSeconds),
+		TimeoutSeconds:      int32(probe.TimeoutSeconds),
+		FailureThreshold:    int32(probe.FailureThreshold),
+	}
+}
+
+// IsPodReady returns true when the pod is running and its Ready condition is true, that is when all its
+// containers pass their readiness probes.
+func IsPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PodState maps the phase of a pod to the state of its runtime instance.
+func PodState(pod *corev1.Pod) mrdspb.RuntimeInstanceState {
+	switch pod.Status.Phase {
+	case corev1.PodPending:
+		return mrdspb.RuntimeInstanceState_RuntimeState_STARTING
+	case corev1.PodRunning:
+		return mrdspb.RuntimeInstanceState_RuntimeState_RUNNING
+	case corev1.PodSucceeded:
+		return mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
+	case corev1.PodFailed:
+		return mrdspb.RuntimeInstanceState_RuntimeState_FAILED
+	default:
+		return mrdspb.RuntimeInstanceState_RuntimeState_UNKNOWN
+	}
+}
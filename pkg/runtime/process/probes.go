@@ -0,0 +1,64 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+)
+
+// Defaults of the probes which do not set them. The period and failure threshold are those of kubernetes.
+const (
+	defaultProbeTimeout          = time.Second
+	defaultProbePeriod           = 10 * time.Second
+	defaultProbeFailureThreshold = 3
+)
+
+// checkProbe runs a single check of a probe against the local machine. It returns nil when the probe passes
+// or is unset. EXEC probes run in the directory and with the environment of the process of the payload.
+func checkProbe(ctx context.Context, probe *mrdspb.Probe, dir string, env []string) error {
+	timeout := defaultProbeTimeout
+	if probe.GetTimeoutSeconds() > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch probe.GetType() {
+	case mrdspb.ProbeType_ProbeType_HTTP:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://localhost:%d%s", probe.Port, probe.Path), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("HTTP probe returned status %d", resp.StatusCode)
+		}
+		return nil
+	case mrdspb.ProbeType_ProbeType_TCP:
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", fmt.Sprintf("localhost:%d", probe.Port))
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case mrdspb.ProbeType_ProbeType_EXEC:
+		if len(probe.Command) == 0 {
+			return fmt.Errorf("EXEC probe has no command")
+		}
+		cmd := exec.CommandContext(ctx, probe.Command[0], probe.Command[1:]...)
+		cmd.Dir = dir
+		cmd.Env = env
+		return cmd.Run()
+	default:
+		return nil
+	}
+}
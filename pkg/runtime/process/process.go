@@ -0,0 +1,575 @@
+// This file implements a runtime which runs the instances as local processes, meant to run the whole system
+// on a single machine for development and integration tests.
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"github.com/msanath/gondolf/pkg/ctxslog"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/worker"
+)
+
+// RuntimeType is the runtime type of the clusters run by the process runtime.
+const RuntimeType = "process"
+
+// Keys of the runtime config of a process cluster.
+const (
+	// ConfigDir is the directory of the working directories of the instances, where the output of their
+	// processes is written.
+	ConfigDir = "dir"
+)
+
+// CoordinateCommand is the payload coordinate holding the command line of the process of a payload. The
+// binary path and its arguments are separated by spaces, and are not interpreted by a shell.
+const CoordinateCommand = "command"
+
+var (
+	// pollInterval is how often an instance is checked while it starts.
+	pollInterval = time.Second
+	// startTimeout is how long an instance may take to pass its readiness probes.
+	startTimeout = 5 * time.Minute
+	// stopGracePeriod is how long the processes of an instance may take to exit once they are asked to,
+	// before they are killed.
+	stopGracePeriod = 10 * time.Second
+	// reportAttempts is how many times the status of an instance whose processes exited is written to the
+	// ledger before it is left to the reconciler.
+	reportAttempts = 3
+)
+
+// ProcessRuntime runs a process for each payload of a runtime instance, on the machine of the control plane.
+// The node of the runtime instance is ignored.
+//
+// When a process exits, the other processes of its instance are killed and the instance is reported FAILED,
+// or TERMINATED when the process exited successfully. A process which fails its liveness probe is killed with
+// the other processes of its instance, which is reported FAILED. The processes belong to the control plane, so they
+// are not tracked across its restarts and the reconciler marks them FAILED afterwards.
+type ProcessRuntime struct {
+	metaInstancesClient  mrdspb.MetaInstancesClient
+	deploymentPlanClient mrdspb.DeploymentPlansClient
+	nodesClient          mrdspb.NodesClient
+	defaultDir           string
+
+	mu        sync.Mutex
+	dir       string
+	instances map[string]*instance // keyed by runtime instance ID
+}
+
+var _ runtime.Reconfigurable = &ProcessRuntime{}
+
+func NewProcessRuntime(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+	dir string,
+) runtime.RuntimeActivities {
+	return &ProcessRuntime{
+		metaInstancesClient:  metaInstancesClient,
+		deploymentPlanClient: deploymentPlanClient,
+		nodesClient:          nodesClient,
+		defaultDir:           dir,
+		dir:                  dir,
+		instances:            make(map[string]*instance),
+	}
+}
+
+// NewFactory returns the factory of the process runtimes of the clusters. The instances run in the
+// directory of the runtime config, or in defaultDir when it has none.
+func NewFactory(
+	metaInstancesClient mrdspb.MetaInstancesClient,
+	deploymentPlanClient mrdspb.DeploymentPlansClient,
+	nodesClient mrdspb.NodesClient,
+	defaultDir string,
+) runtime.Factory {
+	return func(config map[string]string) (runtime.RuntimeActivities, error) {
+		p := NewProcessRuntime(metaInstancesClient, deploymentPlanClient, nodesClient, defaultDir).(*ProcessRuntime)
+		err := p.Reconfigure(config)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+}
+
+// Reconfigure runs the instances started from now on in the directory of the runtime config, or in the
+// directory of the runtime when it has none. The processes which are running are kept.
+func (p *ProcessRuntime) Reconfigure(config map[string]string) error {
+	dir := config[ConfigDir]
+	if dir == "" {
+		dir = p.defaultDir
+	}
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dir = dir
+	return nil
+}
+
+// Close stops the processes of all the instances, once the runtime is replaced by a runtime of another type.
+// The instances are then reported FAILED by the reconciler, as after a restart of the control plane.
+func (p *ProcessRuntime) Close() error {
+	p.mu.Lock()
+	instances := p.instances
+	p.instances = make(map[string]*instance)
+	p.mu.Unlock()
+
+	for _, inst := range instances {
+		if !inst.exited() {
+			inst.stop()
+		}
+	}
+	return nil
+}
+
+func (p *ProcessRuntime) Register(w worker.Registry) {
+	w.RegisterActivity(p.StartInstance)
+	w.RegisterActivity(p.StopInstance)
+	w.RegisterActivity(p.GetInstanceStatus)
+}
+
+// StartInstance starts the processes of the runtime instance, and waits until they pass their readiness
+// probes. Running processes are stopped and started again when they run another deployment or commands, or
+// when a restart is requested.
+func (p *ProcessRuntime) StartInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StartInstance", "request", req)
+
+	details, err := runtime.GetRuntimeDetails(ctx, p.metaInstancesClient, p.deploymentPlanClient, p.nodesClient, req)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := details.Deployment()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	inst, ok := p.instances[req.RuntimeInstanceID]
+	if ok && !inst.exited() && (req.Restart || !inst.runs(deployment)) {
+		// The processes are stopped without holding the lock, since they may take the grace period to exit.
+		delete(p.instances, req.RuntimeInstanceID)
+		p.mu.Unlock()
+		logger.Info("Stopping processes to start them again", "runtimeInstance", req.RuntimeInstanceID, "restart", req.Restart)
+		inst.stop()
+		p.mu.Lock()
+		inst, ok = p.instances[req.RuntimeInstanceID]
+	}
+	if ok && inst.exited() {
+		// A new attempt to start an instance whose processes exited starts them again.
+		ok = false
+	}
+	if !ok {
+		inst, err = p.start(details, deployment)
+		if err != nil {
+			p.mu.Unlock()
+			return nil, err
+		}
+		p.instances[req.RuntimeInstanceID] = inst
+		logger.Info("Processes started", "runtimeInstance", req.RuntimeInstanceID)
+	}
+	p.mu.Unlock()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	timeout := time.After(startTimeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, fmt.Errorf("timed out waiting for runtime instance %s to be ready", req.RuntimeInstanceID)
+		case <-ticker.C:
+		}
+
+		if inst.exited() {
+			// The exit is recorded in the ledger by the supervisor of the instance.
+			return nil, fmt.Errorf("runtime instance %s exited while starting: %s", req.RuntimeInstanceID, inst.status(ctx).Message)
+		}
+		status := inst.status(ctx)
+		updateResp, err := p.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+			Metadata:          details.MetaInstance.Metadata,
+			RuntimeInstanceId: req.RuntimeInstanceID,
+			Status:            status,
+		})
+		if err != nil {
+			logger.Error("Failed to update runtime status", "error", err)
+			return nil, err
+		}
+		details.MetaInstance = updateResp.Record
+
+		if status.Ready {
+			logger.Info("Runtime instance is ready", "runtimeInstance", req.RuntimeInstanceID)
+			return &runtime.RuntimeActivityResponse{MetaInstance: updateResp.Record}, nil
+		}
+	}
+}
+
+// StopInstance stops the processes of the runtime instance, killing those which do not exit within the
+// grace period.
+func (p *ProcessRuntime) StopInstance(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeActivityResponse, error) {
+	logger := activity.GetLogger(ctx)
+	logger.Info("StopInstance", "request", req)
+
+	p.mu.Lock()
+	inst, ok := p.instances[req.RuntimeInstanceID]
+	delete(p.instances, req.RuntimeInstanceID)
+	p.mu.Unlock()
+	if ok {
+		inst.stop()
+		logger.Info("Processes stopped", "runtimeInstance", req.RuntimeInstanceID)
+	}
+
+	// The meta instance is read once the processes exited, since an exit may have been recorded meanwhile.
+	details, err := runtime.GetRuntimeDetails(ctx, p.metaInstancesClient, p.deploymentPlanClient, p.nodesClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResp, err := p.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+		Metadata:          details.MetaInstance.Metadata,
+		RuntimeInstanceId: req.RuntimeInstanceID,
+		Status: &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED,
+			Message: "Processes stopped",
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to update runtime status", "error", err)
+		return nil, err
+	}
+	return &runtime.RuntimeActivityResponse{MetaInstance: updateResp.Record}, nil
+}
+
+func (p *ProcessRuntime) GetInstanceStatus(ctx context.Context, req *runtime.RuntimeActivityRequest) (*runtime.RuntimeInstanceStatusResponse, error) {
+	p.mu.Lock()
+	inst, ok := p.instances[req.RuntimeInstanceID]
+	p.mu.Unlock()
+	if !ok {
+		// Processes are only forgotten by StopInstance, so a missing instance was lost with a restart.
+		return &runtime.RuntimeInstanceStatusResponse{
+			Status: &mrdspb.RuntimeInstanceStatus{
+				State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+				Message: "Processes not found",
+			},
+		}, nil
+	}
+	return &runtime.RuntimeInstanceStatusResponse{Status: inst.status(ctx)}, nil
+}
+
+// start starts a process for each payload of the deployment of the runtime instance. The output of the
+// processes is written in the working directory of the instance.
+func (p *ProcessRuntime) start(details *runtime.RuntimeDetails, deployment *mrdspb.Deployment) (*instance, error) {
+	workDir := filepath.Join(p.dir, details.RuntimeInstance.Id)
+	err := os.MkdirAll(workDir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	inst := &instance{
+		metaInstanceID:    details.MetaInstance.Metadata.Id,
+		runtimeInstanceID: details.RuntimeInstance.Id,
+		deploymentID:      deployment.Id,
+		done:              make(chan struct{}),
+	}
+	// abort kills the processes started before a payload failed to start.
+	abort := func(err error) (*instance, error) {
+		inst.kill()
+		for _, proc := range inst.processes {
+			_ = proc.cmd.Wait()
+		}
+		return nil, err
+	}
+	for _, payload := range deployment.PayloadCoordinates {
+		args := strings.Fields(payload.Coordinates[CoordinateCommand])
+		if len(args) == 0 {
+			return abort(fmt.Errorf("payload %s has no %s coordinate", payload.PayloadName, CoordinateCommand))
+		}
+		output, err := os.OpenFile(filepath.Join(workDir, payload.PayloadName+".log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return abort(err)
+		}
+
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = workDir
+		cmd.Stdout = output
+		cmd.Stderr = output
+		cmd.Env = append(os.Environ(),
+			"MRDS_META_INSTANCE_ID="+inst.metaInstanceID,
+			"MRDS_RUNTIME_INSTANCE_ID="+inst.runtimeInstanceID,
+			"MRDS_PAYLOAD_NAME="+payload.PayloadName,
+		)
+		err = cmd.Start()
+		output.Close() // The process keeps its own descriptor.
+		if err != nil {
+			return abort(fmt.Errorf("failed to start payload %s: %w", payload.PayloadName, err))
+		}
+
+		proc := &process{
+			payloadName: payload.PayloadName,
+			command:     payload.Coordinates[CoordinateCommand],
+			cmd:         cmd,
+		}
+		if app := details.Application(payload.PayloadName); app != nil {
+			proc.readinessProbe = app.ReadinessProbe
+			proc.livenessProbe = app.LivenessProbe
+		}
+		inst.processes = append(inst.processes, proc)
+	}
+
+	go p.supervise(inst)
+	return inst, nil
+}
+
+// supervise waits for the processes of an instance and checks their liveness probes. Once one of them exits
+// or fails its liveness probe, the processes are killed and the exit is recorded in the ledger, unless the
+// instance is being stopped.
+func (p *ProcessRuntime) supervise(inst *instance) {
+	exits := make(chan *process, len(inst.processes))
+	unhealthy := make(chan livenessFailure, len(inst.processes))
+	for _, proc := range inst.processes {
+		go func() {
+			proc.err = proc.cmd.Wait()
+			exits <- proc
+		}()
+		if proc.livenessProbe != nil {
+			go inst.probeLiveness(proc, unhealthy)
+		}
+	}
+
+	var first *process
+	var livenessErr error
+	exited := 0
+	select {
+	case first = <-exits:
+		exited++
+	case failure := <-unhealthy:
+		first, livenessErr = failure.process, failure.err
+	}
+	inst.kill()
+	for range len(inst.processes) - exited {
+		<-exits
+	}
+	inst.mu.Lock()
+	inst.exit = first
+	inst.livenessErr = livenessErr
+	stopping := inst.stopping
+	inst.mu.Unlock()
+	close(inst.done)
+
+	if !stopping {
+		p.report(inst)
+	}
+}
+
+// livenessFailure is the failure of the liveness probe of a process.
+type livenessFailure struct {
+	process *process
+	err     error
+}
+
+// probeLiveness checks the liveness probe of a process until the instance exited. The failure is sent to
+// unhealthy once the probe failed its failure threshold of consecutive checks.
+func (i *instance) probeLiveness(proc *process, unhealthy chan<- livenessFailure) {
+	probe := proc.livenessProbe
+	period := defaultProbePeriod
+	if probe.PeriodSeconds > 0 {
+		period = time.Duration(probe.PeriodSeconds) * time.Second
+	}
+	threshold := defaultProbeFailureThreshold
+	if probe.FailureThreshold > 0 {
+		threshold = int(probe.FailureThreshold)
+	}
+
+	select {
+	case <-i.done:
+		return
+	case <-time.After(time.Duration(probe.InitialDelaySeconds) * time.Second):
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		err := checkProbe(context.Background(), probe, proc.cmd.Dir, proc.cmd.Env)
+		if err != nil {
+			failures++
+		} else {
+			failures = 0
+		}
+		if failures >= threshold {
+			unhealthy <- livenessFailure{process: proc, err: err}
+			return
+		}
+
+		select {
+		case <-i.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// report records the status of an instance whose processes exited in the ledger.
+func (p *ProcessRuntime) report(inst *instance) {
+	ctx := context.Background()
+	logger := ctxslog.FromContext(ctx)
+	status := inst.status(ctx)
+	logger.Info("Runtime instance exited", "runtimeInstance", inst.runtimeInstanceID, "message", status.Message)
+
+	var err error
+	for range reportAttempts {
+		var getResp *mrdspb.GetMetaInstanceResponse
+		getResp, err = p.metaInstancesClient.GetByID(ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: inst.metaInstanceID})
+		if err != nil {
+			continue
+		}
+		_, err = p.metaInstancesClient.UpdateRuntimeStatus(ctx, &mrdspb.UpdateRuntimeStatusRequest{
+			Metadata:          getResp.Record.Metadata,
+			RuntimeInstanceId: inst.runtimeInstanceID,
+			Status:            status,
+		})
+		if err == nil {
+			return
+		}
+	}
+	logger.Error("Failed to report the exit of runtime instance", "runtimeInstance", inst.runtimeInstanceID, "error", err)
+}
+
+// instance is the set of processes of a runtime instance.
+type instance struct {
+	metaInstanceID    string
+	runtimeInstanceID string
+	deploymentID      string
+	processes         []*process
+	done              chan struct{} // done is closed once all the processes exited.
+
+	mu          sync.Mutex
+	stopping    bool     // stopping is set when the processes are asked to exit by StopInstance.
+	exit        *process // exit is the process which exited first, or which failed its liveness probe.
+	livenessErr error    // livenessErr is the failure of the liveness probe for which the processes were killed.
+}
+
+type process struct {
+	payloadName    string
+	command        string
+	cmd            *exec.Cmd
+	readinessProbe *mrdspb.Probe
+	livenessProbe  *mrdspb.Probe
+	err            error // err is the result of waiting for the process, set once it exited.
+}
+
+func (i *instance) exited() bool {
+	select {
+	case <-i.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// runs returns true when the instance runs the deployment, with a process for each of its payloads running
+// the command of the payload.
+func (i *instance) runs(deployment *mrdspb.Deployment) bool {
+	if i.deploymentID != deployment.Id || len(i.processes) != len(deployment.PayloadCoordinates) {
+		return false
+	}
+	for _, payload := range deployment.PayloadCoordinates {
+		found := false
+		for _, proc := range i.processes {
+			if proc.payloadName == payload.PayloadName && proc.command == payload.Coordinates[CoordinateCommand] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// status returns the status of the instance. A running instance is ready when all its processes pass their
+// readiness probes.
+func (i *instance) status(ctx context.Context) *mrdspb.RuntimeInstanceStatus {
+	if !i.exited() {
+		ready := true
+		for _, proc := range i.processes {
+			if err := checkProbe(ctx, proc.readinessProbe, proc.cmd.Dir, proc.cmd.Env); err != nil {
+				ready = false
+				break
+			}
+		}
+		return &mrdspb.RuntimeInstanceStatus{
+			State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING,
+			Ready: ready,
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	switch {
+	case i.stopping:
+		return &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED,
+			Message: "Processes stopped",
+		}
+	case i.livenessErr != nil:
+		return &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			Message: fmt.Sprintf("Payload %s failed its liveness probe: %v", i.exit.payloadName, i.livenessErr),
+		}
+	case i.exit.err == nil:
+		return &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED,
+			Message: fmt.Sprintf("Payload %s exited", i.exit.payloadName),
+		}
+	default:
+		return &mrdspb.RuntimeInstanceStatus{
+			State:   mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+			Message: fmt.Sprintf("Payload %s exited: %v", i.exit.payloadName, i.exit.err),
+		}
+	}
+}
+
+// stop asks the processes to exit, kills them after the grace period, and waits until they exited.
+func (i *instance) stop() {
+	i.mu.Lock()
+	i.stopping = true
+	i.mu.Unlock()
+
+	for _, proc := range i.processes {
+		_ = proc.cmd.Process.Signal(syscall.SIGTERM)
+	}
+	select {
+	case <-i.done:
+	case <-time.After(stopGracePeriod):
+		i.kill()
+		<-i.done
+	}
+}
+
+// kill kills the processes of the instance which were started.
+func (i *instance) kill() {
+	for _, proc := range i.processes {
+		err := proc.cmd.Process.Kill()
+		if err != nil && !errors.Is(err, os.ErrProcessDone) {
+			ctxslog.FromContext(context.Background()).Error("Failed to kill process", "payload", proc.payloadName, "error", err)
+		}
+	}
+}
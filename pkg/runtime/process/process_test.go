@@ -0,0 +1,326 @@
+package process
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/runtime"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type testEnv struct {
+	ctx             context.Context
+	activityEnv     *testsuite.TestActivityEnvironment
+	metaInstances   mrdspb.MetaInstancesClient
+	deploymentPlans mrdspb.DeploymentPlansClient
+	planID          string
+	runtime         *ProcessRuntime
+	dir             string
+	request         *runtime.RuntimeActivityRequest
+}
+
+// newTestEnv creates a meta instance of a deployment running the given commands, with a runtime instance.
+// The options are applied to the application of each payload.
+func newTestEnv(t *testing.T, commands map[string]string, opts ...func(*mrdspb.Application)) *testEnv {
+	defaultPollInterval, defaultStopGracePeriod := pollInterval, stopGracePeriod
+	t.Cleanup(func() { pollInterval, stopGracePeriod = defaultPollInterval, defaultStopGracePeriod })
+	pollInterval = 10 * time.Millisecond
+	stopGracePeriod = time.Second
+
+	var applications []*mrdspb.Application
+	var coordinates []*mrdspb.PayloadCoordinates
+	for payloadName, command := range commands {
+		app := &mrdspb.Application{
+			PayloadName: payloadName,
+			Resources:   &mrdspb.ApplicationResources{Cores: 1, Memory: 1},
+		}
+		for _, opt := range opts {
+			opt(app)
+		}
+		applications = append(applications, app)
+		coordinates = append(coordinates, &mrdspb.PayloadCoordinates{
+			PayloadName: payloadName,
+			Coordinates: map[string]string{CoordinateCommand: command},
+		})
+	}
+	f := testserver.NewRuntimeFixture(t, testserver.RuntimeFixtureSpec{
+		Applications:       applications,
+		PayloadCoordinates: coordinates,
+	})
+
+	dir := t.TempDir()
+	rt := NewProcessRuntime(f.MetaInstances, f.DeploymentPlans, f.Nodes, dir).(*ProcessRuntime)
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := &testEnv{
+		ctx:             context.Background(),
+		activityEnv:     testSuite.NewTestActivityEnvironment(),
+		metaInstances:   f.MetaInstances,
+		deploymentPlans: f.DeploymentPlans,
+		planID:          f.PlanID,
+		runtime:         rt,
+		dir:             dir,
+		request: &runtime.RuntimeActivityRequest{
+			MetaInstanceID:    f.MetaInstance.Metadata.Id,
+			RuntimeInstanceID: testserver.RuntimeInstanceID,
+		},
+	}
+	env.activityEnv.RegisterActivity(rt.StartInstance)
+	env.activityEnv.RegisterActivity(rt.StopInstance)
+	return env
+}
+
+// ledgerStatus returns the status of the runtime instance in the ledger.
+func (e *testEnv) ledgerStatus(t *testing.T) *mrdspb.RuntimeInstanceStatus {
+	resp, err := e.metaInstances.GetByID(e.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: e.request.MetaInstanceID})
+	require.NoError(t, err)
+	return resp.Record.RuntimeInstances[0].Status
+}
+
+func TestStartAndStopInstance(t *testing.T) {
+	env := newTestEnv(t, map[string]string{
+		"app":     "sleep 30",
+		"sidecar": "sleep 30",
+	})
+
+	val, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	var resp runtime.RuntimeActivityResponse
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, resp.MetaInstance.RuntimeInstances[0].Status.State)
+	require.True(t, resp.MetaInstance.RuntimeInstances[0].Status.Ready)
+
+	inst := env.runtime.instances[env.request.RuntimeInstanceID]
+	require.Len(t, inst.processes, 2)
+	require.FileExists(t, filepath.Join(env.dir, "plan-0-runtime-0", "app.log"))
+
+	statusResp, err := env.runtime.GetInstanceStatus(env.ctx, env.request)
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, statusResp.Status.State)
+
+	// Starting the instance again does not start other processes.
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	require.Same(t, inst, env.runtime.instances[env.request.RuntimeInstanceID])
+
+	val, err = env.activityEnv.ExecuteActivity("StopInstance", env.request)
+	require.NoError(t, err)
+	require.NoError(t, val.Get(&resp))
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED, resp.MetaInstance.RuntimeInstances[0].Status.State)
+	require.True(t, inst.exited())
+	for _, proc := range inst.processes {
+		require.NotNil(t, proc.cmd.ProcessState)
+	}
+
+	// A stopped instance is reported as lost, since it is only asked about while it should be running.
+	statusResp, err = env.runtime.GetInstanceStatus(env.ctx, env.request)
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_FAILED, statusResp.Status.State)
+
+	// The exit of stopped processes is not reported.
+	require.Never(t, func() bool {
+		return env.ledgerStatus(t).State != mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestStartInstanceRestarts(t *testing.T) {
+	env := newTestEnv(t, map[string]string{"app": "sleep 30"})
+
+	_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	first := env.runtime.instances[env.request.RuntimeInstanceID]
+
+	// A restart stops the processes and starts them again.
+	req := *env.request
+	req.Restart = true
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", &req)
+	require.NoError(t, err)
+	second := env.runtime.instances[env.request.RuntimeInstanceID]
+	require.NotSame(t, first, second)
+	require.True(t, first.exited())
+
+	// An update to a new deployment starts its commands.
+	planResp, err := env.deploymentPlans.GetByID(env.ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: env.planID})
+	require.NoError(t, err)
+	_, err = env.deploymentPlans.AddDeployment(env.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     planResp.Record.Metadata,
+		DeploymentId: "deployment-2",
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{CoordinateCommand: "sleep 31"}},
+		},
+		InstanceCount: 1,
+	})
+	require.NoError(t, err)
+	getResp, err := env.metaInstances.GetByID(env.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: env.request.MetaInstanceID})
+	require.NoError(t, err)
+	_, err = env.metaInstances.UpdateDeploymentID(env.ctx, &mrdspb.UpdateDeploymentIDRequest{
+		Metadata:     getResp.Record.Metadata,
+		DeploymentId: "deployment-2",
+	})
+	require.NoError(t, err)
+
+	_, err = env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	third := env.runtime.instances[env.request.RuntimeInstanceID]
+	require.NotSame(t, second, third)
+	require.True(t, second.exited())
+	require.Equal(t, "deployment-2", third.deploymentID)
+	require.Equal(t, "sleep 31", third.processes[0].command)
+
+	// The stopped processes are not reported as exited.
+	require.Never(t, func() bool {
+		return env.ledgerStatus(t).State != mrdspb.RuntimeInstanceState_RuntimeState_RUNNING
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	_, err = env.activityEnv.ExecuteActivity("StopInstance", env.request)
+	require.NoError(t, err)
+}
+
+func TestReconfigureAndClose(t *testing.T) {
+	env := newTestEnv(t, map[string]string{"app": "sleep 30"})
+
+	_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+	require.NoError(t, err)
+	inst := env.runtime.instances[env.request.RuntimeInstanceID]
+
+	// A new directory is used by the instances started afterwards, and the running ones are kept.
+	dir := filepath.Join(t.TempDir(), "reconfigured")
+	require.NoError(t, env.runtime.Reconfigure(map[string]string{ConfigDir: dir}))
+	require.DirExists(t, dir)
+	require.Equal(t, dir, env.runtime.dir)
+	require.Same(t, inst, env.runtime.instances[env.request.RuntimeInstanceID])
+	require.False(t, inst.exited())
+
+	// Closing the runtime stops the processes.
+	require.NoError(t, env.runtime.Close())
+	require.True(t, inst.exited())
+	require.Empty(t, env.runtime.instances)
+}
+
+func TestInstanceExit(t *testing.T) {
+	t.Run("Exit while starting fails the instance", func(t *testing.T) {
+		env := newTestEnv(t, map[string]string{"app": "ls " + filepath.Join(t.TempDir(), "missing")})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.ErrorContains(t, err, "exited while starting")
+		require.Eventually(t, func() bool {
+			return env.ledgerStatus(t).State == mrdspb.RuntimeInstanceState_RuntimeState_FAILED
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Contains(t, env.ledgerStatus(t).Message, "Payload app exited: exit status")
+	})
+
+	t.Run("Exit after start is reported", func(t *testing.T) {
+		env := newTestEnv(t, map[string]string{
+			"app":     "sleep 0.5",
+			"sidecar": "sleep 30",
+		})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return env.ledgerStatus(t).State == mrdspb.RuntimeInstanceState_RuntimeState_TERMINATED
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Equal(t, "Payload app exited", env.ledgerStatus(t).Message)
+
+		// The other processes of the instance are killed.
+		inst := env.runtime.instances[env.request.RuntimeInstanceID]
+		require.True(t, inst.exited())
+	})
+
+	t.Run("Liveness probe failure is reported", func(t *testing.T) {
+		// The probe runs in the working directory of the instance, with the environment of the payload.
+		env := newTestEnv(t, map[string]string{"app": "sleep 30"}, func(app *mrdspb.Application) {
+			app.LivenessProbe = &mrdspb.Probe{
+				Type:             mrdspb.ProbeType_ProbeType_EXEC,
+				Command:          []string{"sh", "-c", `test "$MRDS_PAYLOAD_NAME" = app && ls alive`},
+				PeriodSeconds:    1,
+				FailureThreshold: 1,
+			}
+		})
+		alive := filepath.Join(env.dir, "plan-0-runtime-0", "alive")
+		require.NoError(t, os.MkdirAll(filepath.Dir(alive), 0o755))
+		require.NoError(t, os.WriteFile(alive, nil, 0o644))
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.NoError(t, err)
+		inst := env.runtime.instances[env.request.RuntimeInstanceID]
+		require.Never(t, inst.exited, 1500*time.Millisecond, 10*time.Millisecond)
+
+		require.NoError(t, os.Remove(alive))
+		require.Eventually(t, func() bool {
+			return env.ledgerStatus(t).State == mrdspb.RuntimeInstanceState_RuntimeState_FAILED
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Contains(t, env.ledgerStatus(t).Message, "Payload app failed its liveness probe")
+		require.True(t, inst.exited())
+	})
+
+	t.Run("Missing command fails the start", func(t *testing.T) {
+		env := newTestEnv(t, map[string]string{"app": ""})
+
+		_, err := env.activityEnv.ExecuteActivity("StartInstance", env.request)
+		require.ErrorContains(t, err, "has no command coordinate")
+	})
+}
+
+func TestCheckProbe(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	tcpPort := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file"), nil, 0o644))
+	dirProbe := &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_EXEC, Command: []string{"sh", "-c", `test "$NAME" = value && ls file`}}
+
+	testCases := []struct {
+		name      string
+		probe     *mrdspb.Probe
+		dir       string
+		env       []string
+		expectErr bool
+	}{
+		{name: "No probe", probe: nil},
+		{name: "HTTP success", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_HTTP, Path: "/healthz", Port: uint32(port)}},
+		{name: "HTTP failure", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_HTTP, Path: "/other", Port: uint32(port)}, expectErr: true},
+		{name: "TCP success", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_TCP, Port: uint32(port)}},
+		{name: "TCP failure", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_TCP, Port: uint32(tcpPort)}, expectErr: true},
+		{name: "EXEC success", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_EXEC, Command: []string{"ls", os.TempDir()}}},
+		{name: "EXEC failure", probe: &mrdspb.Probe{Type: mrdspb.ProbeType_ProbeType_EXEC, Command: []string{"ls", filepath.Join(t.TempDir(), "missing")}}, expectErr: true},
+		{name: "EXEC in the directory and environment", probe: dirProbe, dir: dir, env: []string{"NAME=value"}},
+		{name: "EXEC in another directory", probe: dirProbe, dir: t.TempDir(), env: []string{"NAME=value"}, expectErr: true},
+		{name: "EXEC with another environment", probe: dirProbe, dir: dir, env: []string{"NAME=other"}, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkProbe(ctx, tc.probe, tc.dir, tc.env)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Package signals holds the Temporal signals sent to the workflows of the control plane, and the notifiers
+// which send them. It only depends on the Temporal client, so that the API server signals the workflows
+// without depending on the control plane.
+package signals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/api/serviceerror"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// OperationApprovedSignalName is the signal sent to an operation workflow when its operation is APPROVED.
+const OperationApprovedSignalName = "operation-approved"
+
+type OperationApprovedSignal struct {
+	OperationID string
+	Message     string
+}
+
+// OperationWorkflowID returns the ID of the workflow running the operation of a meta instance.
+func OperationWorkflowID(metaInstanceName string, operationID string) string {
+	return fmt.Sprintf("%s-%s", metaInstanceName, operationID)
+}
+
+// OperationApprovalNotifier signals the operation workflows when their operation is approved.
+type OperationApprovalNotifier struct {
+	tc temporalclient.Client
+}
+
+func NewOperationApprovalNotifier(tc temporalclient.Client) *OperationApprovalNotifier {
+	return &OperationApprovalNotifier{tc: tc}
+}
+
+// NotifyOperationApproved signals the workflow running the operation. Operations which are not run by a
+// workflow, such as the ones approved before the workflow started, are ignored.
+func (n *OperationApprovalNotifier) NotifyOperationApproved(ctx context.Context, metaInstance *mrdspb.MetaInstance, operation *mrdspb.Operation) error {
+	err := n.tc.SignalWorkflow(ctx,
+		OperationWorkflowID(metaInstance.Name, operation.Id),
+		"",
+		OperationApprovedSignalName,
+		OperationApprovedSignal{
+			OperationID: operation.Id,
+			Message:     operation.Status.Message,
+		},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to signal workflow: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package signals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"go.temporal.io/api/serviceerror"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// DeploymentStateChangedSignalName is the signal sent to a deployment workflow when its deployment is paused,
+// resumed or aborted.
+const DeploymentStateChangedSignalName = "deployment-state-changed"
+
+type DeploymentStateChangedSignal struct {
+	DeploymentID string
+	State        mrdspb.DeploymentState
+}
+
+// DeploymentWorkflowID returns the ID of the workflow running the deployment of a deployment plan.
+func DeploymentWorkflowID(deploymentPlanName string, deploymentID string) string {
+	return fmt.Sprintf("%s-%s", deploymentPlanName, deploymentID)
+}
+
+// DeploymentStateNotifier signals the deployment workflows when their deployment is paused, resumed or aborted.
+type DeploymentStateNotifier struct {
+	tc temporalclient.Client
+}
+
+func NewDeploymentStateNotifier(tc temporalclient.Client) *DeploymentStateNotifier {
+	return &DeploymentStateNotifier{tc: tc}
+}
+
+// NotifyDeploymentStateChanged signals the workflow running the deployment. Deployments which are not run by
+// a workflow are ignored, the workflow reads the state from the ledger when it starts.
+func (n *DeploymentStateNotifier) NotifyDeploymentStateChanged(ctx context.Context, deploymentPlan *mrdspb.DeploymentPlanRecord, deployment *mrdspb.Deployment) error {
+	err := n.tc.SignalWorkflow(ctx,
+		DeploymentWorkflowID(deploymentPlan.Name, deployment.Id),
+		"",
+		DeploymentStateChangedSignalName,
+		DeploymentStateChangedSignal{
+			DeploymentID: deployment.Id,
+			State:        deployment.Status.State,
+		},
+	)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to signal workflow: %w", err)
+	}
+	return nil
+}
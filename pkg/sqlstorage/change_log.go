@@ -0,0 +1,44 @@
+package sqlstorage
+
+import (
+	"context"
+
+	"github.com/msanath/mrds/ledger/core"
+	"github.com/msanath/mrds/pkg/sqlstorage/tables"
+)
+
+func changeLogRowToChange(row tables.ChangeLogRow) core.Change {
+	return core.Change{
+		Sequence: row.Sequence,
+		RecordID: row.RecordID,
+		Version:  row.Version,
+		Type:     core.ChangeType(row.ChangeType),
+	}
+}
+
+// listChanges returns the changes of a kind of record after a sequence of the change log. It returns
+// core.ErrChangesPruned when changes after the sequence were pruned.
+func listChanges(ctx context.Context, changeLogTable *tables.ChangeLogTable, kind string, afterSequence uint64, limit uint32) ([]core.Change, error) {
+	rows, err := changeLogTable.List(ctx, tables.ChangeLogTableSelectFilters{
+		Kind:          kind,
+		SequenceAfter: afterSequence,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, errHandler(err)
+	}
+	// The pruned sequence is read after the changes, so that changes pruned while they were listed are not
+	// missed.
+	prunedSequence, err := changeLogTable.PrunedSequence(ctx)
+	if err != nil {
+		return nil, errHandler(err)
+	}
+	if afterSequence < prunedSequence {
+		return nil, core.ErrChangesPruned
+	}
+	var changes []core.Change
+	for _, row := range rows {
+		changes = append(changes, changeLogRowToChange(row))
+	}
+	return changes, nil
+}
@@ -0,0 +1,76 @@
+package sqlstorage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/ledger/core"
+	"github.com/msanath/mrds/ledger/node"
+	"github.com/msanath/mrds/pkg/sqlstorage/test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeLog(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	repo := storage.Node
+	ctx := context.Background()
+
+	insertNode := func(i int) {
+		err := repo.Insert(ctx, node.NodeRecord{
+			Metadata:       core.Metadata{ID: fmt.Sprintf("changelog-node-%d", i), Version: 1},
+			Name:           fmt.Sprintf("changelog-node-%d", i),
+			Status:         node.NodeStatus{State: node.NodeStateUnallocated},
+			UpdateDomain:   "test-domain",
+			TotalResources: node.Resources{Cores: 4, Memory: 64},
+		})
+		require.NoError(t, err)
+	}
+
+	sequence, err := repo.LastChangeSequence(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sequence)
+
+	t.Run("Sequences are consecutive", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			insertNode(i)
+		}
+		changes, err := repo.ListChanges(ctx, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 3)
+		for i, change := range changes {
+			require.Equal(t, uint64(i+1), change.Sequence)
+			require.Equal(t, core.ChangeTypeCreate, change.Type)
+		}
+		sequence, err = repo.LastChangeSequence(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), sequence)
+	})
+
+	t.Run("Pruned changes fail the listing", func(t *testing.T) {
+		require.NoError(t, storage.PruneChangeLog(ctx, time.Now().Add(time.Hour)))
+
+		_, err := repo.ListChanges(ctx, 1, 0)
+		require.ErrorIs(t, err, core.ErrChangesPruned)
+
+		// The changes after the pruned ones are listed, and the sequences continue.
+		changes, err := repo.ListChanges(ctx, sequence, 0)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+		insertNode(3)
+		changes, err = repo.ListChanges(ctx, sequence, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		require.Equal(t, sequence+1, changes[0].Sequence)
+	})
+
+	t.Run("Recent changes are kept", func(t *testing.T) {
+		require.NoError(t, storage.PruneChangeLog(ctx, time.Now().Add(-time.Hour)))
+
+		changes, err := repo.ListChanges(ctx, sequence, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+	})
+}
@@ -2,6 +2,7 @@ package sqlstorage
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/msanath/gondolf/pkg/simplesql"
 	"github.com/msanath/mrds/ledger/cluster"
@@ -24,16 +25,21 @@ func newClusterStorage(db simplesql.Database) cluster.Repository {
 }
 
 func clusterModelToRow(model cluster.ClusterRecord) tables.ClusterRow {
+	runtimeConfig, _ := json.Marshal(model.RuntimeConfig)
 	return tables.ClusterRow{
-		ID:      model.Metadata.ID,
-		Version: model.Metadata.Version,
-		Name:    model.Name,
-		State:   model.Status.State.ToString(),
-		Message: model.Status.Message,
+		ID:            model.Metadata.ID,
+		Version:       model.Metadata.Version,
+		Name:          model.Name,
+		State:         model.Status.State.ToString(),
+		Message:       model.Status.Message,
+		RuntimeType:   model.RuntimeType,
+		RuntimeConfig: string(runtimeConfig),
 	}
 }
 
 func clusterRowToModel(row tables.ClusterRow) cluster.ClusterRecord {
+	var runtimeConfig map[string]string
+	_ = json.Unmarshal([]byte(row.RuntimeConfig), &runtimeConfig)
 	return cluster.ClusterRecord{
 		Metadata: core.Metadata{
 			ID:      row.ID,
@@ -44,6 +50,8 @@ func clusterRowToModel(row tables.ClusterRow) cluster.ClusterRecord {
 			State:   cluster.ClusterStateFromString(row.State),
 			Message: row.Message,
 		},
+		RuntimeType:   row.RuntimeType,
+		RuntimeConfig: runtimeConfig,
 	}
 }
 
@@ -100,7 +108,7 @@ func (s *clusterStorage) Delete(ctx context.Context, metadata core.Metadata) err
 	return nil
 }
 
-func (s *clusterStorage) List(ctx context.Context, filters cluster.ClusterListFilters) ([]cluster.ClusterRecord, error) {
+func (s *clusterStorage) List(ctx context.Context, filters cluster.ClusterListFilters, pageToken string) ([]cluster.ClusterRecord, core.PageInfo, error) {
 	dbFilters := tables.ClusterTableSelectFilters{
 		IDIn:           append([]string{}, filters.IDIn...),
 		NameIn:         append([]string{}, filters.NameIn...),
@@ -117,13 +125,29 @@ func (s *clusterStorage) List(ctx context.Context, filters cluster.ClusterListFi
 		dbFilters.StateNotIn = append(dbFilters.StateNotIn, state.ToString())
 	}
 
-	rows, err := s.clusterTable.List(ctx, dbFilters)
+	ids, page, err := paginate(pageToken, filters.Limit,
+		func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error) {
+			dbFilters.Limit = limit
+			return s.clusterTable.ListKeys(ctx, dbFilters, after)
+		},
+		func() (uint64, error) { return s.clusterTable.Count(ctx, dbFilters) },
+	)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+	if len(ids) == 0 {
+		return nil, page, nil
+	}
+	rows, err := s.clusterTable.List(ctx, tables.ClusterTableSelectFilters{
+		IDIn:           ids,
+		IncludeDeleted: filters.IncludeDeleted,
+	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 	var records []cluster.ClusterRecord
 	for _, row := range rows {
 		records = append(records, clusterRowToModel(row))
 	}
-	return records, nil
+	return orderByIDs(records, ids, func(record cluster.ClusterRecord) string { return record.Metadata.ID }), page, nil
 }
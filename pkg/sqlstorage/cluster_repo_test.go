@@ -28,6 +28,8 @@ func TestClusterRecordLifecycle(t *testing.T) {
 			State:   cluster.ClusterStateActive,
 			Message: "",
 		},
+		RuntimeType:   "kind",
+		RuntimeConfig: map[string]string{"context": "kind-kind"},
 	}
 	repo := storage.Cluster
 
@@ -113,7 +115,7 @@ func testClusterCRUD(t *testing.T, repo cluster.Repository, testRecord cluster.C
 	})
 
 	t.Run("List", func(t *testing.T) {
-		records, err := repo.List(ctx, cluster.ClusterListFilters{})
+		records, _, err := repo.List(ctx, cluster.ClusterListFilters{}, "")
 		require.NoError(t, err)
 		require.Len(t, records, 10)
 
@@ -131,9 +133,9 @@ func testClusterCRUD(t *testing.T, repo cluster.Repository, testRecord cluster.C
 		allRecords := records
 
 		t.Run("List Success With Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				StateIn: []cluster.ClusterState{cluster.ClusterStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -142,9 +144,9 @@ func testClusterCRUD(t *testing.T, repo cluster.Repository, testRecord cluster.C
 		})
 
 		t.Run("List with Names Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				NameIn: []string{allRecords[0].Name, allRecords[1].Name, allRecords[2].Name},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 
@@ -155,28 +157,57 @@ func testClusterCRUD(t *testing.T, repo cluster.Repository, testRecord cluster.C
 		})
 
 		t.Run("List with Limit", func(t *testing.T) {
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				Limit: 3,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
 
+		t.Run("List pages", func(t *testing.T) {
+			var pages [][]cluster.ClusterRecord
+			pageToken := ""
+			for {
+				records, page, err := repo.List(ctx, cluster.ClusterListFilters{
+					Limit: 4,
+				}, pageToken)
+				require.NoError(t, err)
+				require.Equal(t, uint64(10), page.TotalCount)
+				pages = append(pages, records)
+				if page.NextPageToken == "" {
+					break
+				}
+				pageToken = page.NextPageToken
+			}
+			require.Len(t, pages, 3)
+			require.Len(t, pages[2], 2)
+
+			// The pages follow the order of the records listed at once.
+			var records []cluster.ClusterRecord
+			for _, page := range pages {
+				records = append(records, page...)
+			}
+			require.Equal(t, allRecords, records)
+
+			_, _, err := repo.List(ctx, cluster.ClusterListFilters{}, "invalid")
+			require.Error(t, err)
+		})
+
 		t.Run("List with IncludeDeleted", func(t *testing.T) {
 			err = repo.Delete(ctx, allRecords[0].Metadata)
 			require.NoError(t, err)
 
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				IncludeDeleted: true,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 11)
 		})
 
 		t.Run("List with StateNotIn", func(t *testing.T) {
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				StateNotIn: []cluster.ClusterState{cluster.ClusterStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -193,16 +224,16 @@ func testClusterCRUD(t *testing.T, repo cluster.Repository, testRecord cluster.C
 			err = repo.UpdateStatus(ctx, allRecords[1].Metadata, status)
 			require.NoError(t, err)
 			ve := uint64(1)
-			records, err := repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err := repo.List(ctx, cluster.ClusterListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 1)
 
 			ve += 1
-			records, err = repo.List(ctx, cluster.ClusterListFilters{
+			records, _, err = repo.List(ctx, cluster.ClusterListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 0)
 		})
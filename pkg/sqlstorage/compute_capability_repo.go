@@ -105,7 +105,7 @@ func (s *computeCapabilityStorage) Delete(ctx context.Context, metadata core.Met
 	return nil
 }
 
-func (s *computeCapabilityStorage) List(ctx context.Context, filters computecapability.ComputeCapabilityListFilters) ([]computecapability.ComputeCapabilityRecord, error) {
+func (s *computeCapabilityStorage) List(ctx context.Context, filters computecapability.ComputeCapabilityListFilters, pageToken string) ([]computecapability.ComputeCapabilityRecord, core.PageInfo, error) {
 	// Extract core filters
 	dbFilters := tables.ComputeCapabilityTableSelectFilters{
 		IDIn:           append([]string{}, filters.IDIn...),
@@ -126,9 +126,25 @@ func (s *computeCapabilityStorage) List(ctx context.Context, filters computecapa
 		dbFilters.StateNotIn = append(dbFilters.StateNotIn, state.ToString())
 	}
 
-	rows, err := s.computeCapabilityTable.List(ctx, dbFilters)
+	ids, page, err := paginate(pageToken, filters.Limit,
+		func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error) {
+			dbFilters.Limit = limit
+			return s.computeCapabilityTable.ListKeys(ctx, dbFilters, after)
+		},
+		func() (uint64, error) { return s.computeCapabilityTable.Count(ctx, dbFilters) },
+	)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+	if len(ids) == 0 {
+		return nil, page, nil
+	}
+	rows, err := s.computeCapabilityTable.List(ctx, tables.ComputeCapabilityTableSelectFilters{
+		IDIn:           ids,
+		IncludeDeleted: filters.IncludeDeleted,
+	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 
 	var records []computecapability.ComputeCapabilityRecord
@@ -136,5 +152,5 @@ func (s *computeCapabilityStorage) List(ctx context.Context, filters computecapa
 		records = append(records, computeCapabilityRowToModel(row))
 	}
 
-	return records, nil
+	return orderByIDs(records, ids, func(record computecapability.ComputeCapabilityRecord) string { return record.Metadata.ID }), page, nil
 }
@@ -118,7 +118,7 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 	})
 
 	t.Run("List", func(t *testing.T) {
-		records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{})
+		records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{}, "")
 		require.NoError(t, err)
 		require.Len(t, records, 10)
 
@@ -136,9 +136,9 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 		allRecords := records
 
 		t.Run("List Success With Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				StateIn: []computecapability.ComputeCapabilityState{computecapability.ComputeCapabilityStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -147,9 +147,9 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Names Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				NameIn: []string{allRecords[0].Name, allRecords[1].Name, allRecords[2].Name},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 
@@ -160,9 +160,9 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Limit", func(t *testing.T) {
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				Limit: 3,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
@@ -171,17 +171,17 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 			err = repo.Delete(ctx, allRecords[0].Metadata)
 			require.NoError(t, err)
 
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				IncludeDeleted: true,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 11)
 		})
 
 		t.Run("List with StateNotIn", func(t *testing.T) {
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				StateNotIn: []computecapability.ComputeCapabilityState{computecapability.ComputeCapabilityStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -198,24 +198,24 @@ func TestComputeCapabilityRecordLifecycle(t *testing.T) {
 			err = repo.UpdateState(ctx, allRecords[1].Metadata, status)
 			require.NoError(t, err)
 			ve := uint64(1)
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 1)
 
 			ve += 1
-			records, err = repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err = repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 0)
 		})
 
 		t.Run("List by Type", func(t *testing.T) {
-			records, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
+			records, _, err := repo.List(ctx, computecapability.ComputeCapabilityListFilters{
 				TypeIn: []string{"GPU"},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
@@ -3,6 +3,9 @@ package sqlstorage
 import (
 	"context"
 	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 
 	"github.com/msanath/gondolf/pkg/simplesql"
 	"github.com/msanath/mrds/ledger/core"
@@ -17,9 +20,12 @@ type deploymentPlanStorage struct {
 	deploymentPlanApplicationTable                  *tables.DeploymentPlanApplicationTable
 	deploymentPlanApplicationPortTable              *tables.DeploymentPlanApplicationPortTable
 	deploymentPlanApplicationPersistentVolumeTable  *tables.DeploymentPlanApplicationPersistentVolumeTable
+	deploymentPlanApplicationProbeTable             *tables.DeploymentPlanApplicationProbeTable
 	deploymentPlanDeploymentTable                   *tables.DeploymentPlanDeploymentTable
 	deploymentPlanDeploymentPayloadCoordinatesTable *tables.DeploymentPlanDeploymentPayloadCoordinatesTable
 	deploymentPlanMatchingCapabilityTable           *tables.DeploymentMatchingCapabilityTable
+	deploymentPlanSpecHistoryTable                  *tables.DeploymentPlanSpecHistoryTable
+	changeLogTable                                  *tables.ChangeLogTable
 }
 
 // newDeploymentPlanStorage creates a new storage instance satisfying the DeploymentPlanRepository interface
@@ -30,9 +36,12 @@ func newDeploymentPlanStorage(db simplesql.Database) deploymentplan.Repository {
 		deploymentPlanApplicationTable:                  tables.NewDeploymentPlanApplicationTable(db),
 		deploymentPlanApplicationPortTable:              tables.NewDeploymentPlanApplicationPortTable(db),
 		deploymentPlanApplicationPersistentVolumeTable:  tables.NewDeploymentPlanApplicationPersistentVolumeTable(db),
+		deploymentPlanApplicationProbeTable:             tables.NewDeploymentPlanApplicationProbeTable(db),
 		deploymentPlanDeploymentTable:                   tables.NewDeploymentPlanDeploymentTable(db),
 		deploymentPlanDeploymentPayloadCoordinatesTable: tables.NewDeploymentPlanDeploymentPayloadCoordinatesTable(db),
 		deploymentPlanMatchingCapabilityTable:           tables.NewDeploymentMatchingCapabilityTable(db),
+		deploymentPlanSpecHistoryTable:                  tables.NewDeploymentPlanSpecHistoryTable(db),
+		changeLogTable:                                  tables.NewChangeLogTable(db),
 	}
 }
 
@@ -45,6 +54,8 @@ func deploymentPlanRecordToRow(record deploymentplan.DeploymentPlanRecord) table
 		Message:     record.Status.Message,
 		Namespace:   record.Namespace,
 		ServiceName: record.ServiceName,
+
+		SchedulerProfile: record.SchedulerProfile,
 	}
 }
 
@@ -61,6 +72,8 @@ func deploymentPlanRowToRecord(row tables.DeploymentPlanRow) deploymentplan.Depl
 		},
 		Namespace:   row.Namespace,
 		ServiceName: row.ServiceName,
+
+		SchedulerProfile: row.SchedulerProfile,
 	}
 }
 
@@ -117,6 +130,40 @@ func deploymentApplicationPersistentVolumeRowToRecord(row tables.DeploymentPlanA
 	}
 }
 
+func deploymentApplicationProbeRecordToRow(deploymentPlanID string, payloadName string, kind string, record deploymentplan.Probe) tables.DeploymentPlanApplicationProbeRow {
+	jsonStr, _ := json.Marshal(record.Command)
+
+	return tables.DeploymentPlanApplicationProbeRow{
+		DeploymentPlanID:    deploymentPlanID,
+		PayloadName:         payloadName,
+		Kind:                kind,
+		ProbeType:           string(record.Type),
+		Path:                record.Path,
+		Port:                record.Port,
+		Command:             string(jsonStr),
+		InitialDelaySeconds: record.InitialDelaySeconds,
+		PeriodSeconds:       record.PeriodSeconds,
+		TimeoutSeconds:      record.TimeoutSeconds,
+		FailureThreshold:    record.FailureThreshold,
+	}
+}
+
+func deploymentApplicationProbeRowToRecord(row tables.DeploymentPlanApplicationProbeRow) *deploymentplan.Probe {
+	var command []string
+	_ = json.Unmarshal([]byte(row.Command), &command)
+
+	return &deploymentplan.Probe{
+		Type:                deploymentplan.ProbeType(row.ProbeType),
+		Path:                row.Path,
+		Port:                row.Port,
+		Command:             command,
+		InitialDelaySeconds: row.InitialDelaySeconds,
+		PeriodSeconds:       row.PeriodSeconds,
+		TimeoutSeconds:      row.TimeoutSeconds,
+		FailureThreshold:    row.FailureThreshold,
+	}
+}
+
 func deploymentRecordToRow(deploymentPlanID string, record deploymentplan.Deployment) tables.DeploymentPlanDeploymentRow {
 	return tables.DeploymentPlanDeploymentRow{
 		DeploymentPlanID: deploymentPlanID,
@@ -124,6 +171,20 @@ func deploymentRecordToRow(deploymentPlanID string, record deploymentplan.Deploy
 		State:            string(record.Status.State),
 		Message:          record.Status.Message,
 		InstanceCount:    record.InstanceCount,
+
+		RolloutStrategy:  string(record.RolloutStrategy.Type),
+		MaxUnavailable:   record.RolloutStrategy.MaxUnavailable,
+		MaxSurge:         record.RolloutStrategy.MaxSurge,
+		BatchSize:        record.RolloutStrategy.BatchSize,
+		PauseSeconds:     record.RolloutStrategy.PauseSeconds,
+		HoldSeconds:      record.RolloutStrategy.HoldSeconds,
+		CompletedBatches: record.RolloutProgress.CompletedBatches,
+		TotalBatches:     record.RolloutProgress.TotalBatches,
+
+		CanaryInstanceCount:         record.Canary.InstanceCount,
+		CanaryPercentage:            record.Canary.Percentage,
+		CanaryBakeSeconds:           record.Canary.BakeSeconds,
+		CanaryMaxUnhealthyInstances: record.Canary.MaxUnhealthyInstances,
 	}
 }
 
@@ -132,6 +193,24 @@ func deploymentRowToRecord(row tables.DeploymentPlanDeploymentRow) deploymentpla
 		ID:            row.ID,
 		Status:        deploymentplan.DeploymentStatus{State: deploymentplan.DeploymentState(row.State), Message: row.Message},
 		InstanceCount: row.InstanceCount,
+		RolloutStrategy: deploymentplan.RolloutStrategy{
+			Type:           deploymentplan.RolloutStrategyType(row.RolloutStrategy),
+			MaxUnavailable: row.MaxUnavailable,
+			MaxSurge:       row.MaxSurge,
+			BatchSize:      row.BatchSize,
+			PauseSeconds:   row.PauseSeconds,
+			HoldSeconds:    row.HoldSeconds,
+		},
+		RolloutProgress: deploymentplan.RolloutProgress{
+			CompletedBatches: row.CompletedBatches,
+			TotalBatches:     row.TotalBatches,
+		},
+		Canary: deploymentplan.Canary{
+			InstanceCount:         row.CanaryInstanceCount,
+			Percentage:            row.CanaryPercentage,
+			BakeSeconds:           row.CanaryBakeSeconds,
+			MaxUnhealthyInstances: row.CanaryMaxUnhealthyInstances,
+		},
 	}
 }
 
@@ -180,6 +259,28 @@ func deploymentPlanMatchingCapabilityRowToRecord(row tables.DeploymentPlanMatchi
 	}
 }
 
+func deploymentPlanSpecRevisionToRow(deploymentPlanID string, version uint64, spec deploymentplan.DeploymentPlanSpec, replacedAt time.Time) tables.DeploymentPlanSpecHistoryRow {
+	jsonStr, _ := json.Marshal(spec)
+
+	return tables.DeploymentPlanSpecHistoryRow{
+		DeploymentPlanID: deploymentPlanID,
+		Version:          version,
+		Spec:             string(jsonStr),
+		ReplacedAt:       replacedAt.Unix(),
+	}
+}
+
+func deploymentPlanSpecHistoryRowToRecord(row tables.DeploymentPlanSpecHistoryRow) deploymentplan.SpecRevision {
+	var spec deploymentplan.DeploymentPlanSpec
+	_ = json.Unmarshal([]byte(row.Spec), &spec)
+
+	return deploymentplan.SpecRevision{
+		Version:    row.Version,
+		Spec:       spec,
+		ReplacedAt: time.Unix(row.ReplacedAt, 0),
+	}
+}
+
 func (s *deploymentPlanStorage) Insert(ctx context.Context, record deploymentplan.DeploymentPlanRecord) error {
 	tx, err := s.DB.BeginTxx(ctx, nil)
 	if err != nil {
@@ -198,19 +299,9 @@ func (s *deploymentPlanStorage) Insert(ctx context.Context, record deploymentpla
 		if err != nil {
 			return errHandler(err)
 		}
-
-		for _, port := range app.Ports {
-			err = s.deploymentPlanApplicationPortTable.Insert(ctx, execer, deploymentApplicationPortRecordToRow(record.Metadata.ID, app.PayloadName, port))
-			if err != nil {
-				return errHandler(err)
-			}
-		}
-
-		for _, pv := range app.PersistentVolumes {
-			err = s.deploymentPlanApplicationPersistentVolumeTable.Insert(ctx, execer, deploymentApplicationPersistentVolumeRecordToRow(record.Metadata.ID, app.PayloadName, pv))
-			if err != nil {
-				return errHandler(err)
-			}
+		err = s.insertApplicationDetails(ctx, execer, record.Metadata.ID, app)
+		if err != nil {
+			return err
 		}
 	}
 	for _, capability := range record.MatchingComputeCapabilities {
@@ -228,6 +319,53 @@ func (s *deploymentPlanStorage) Insert(ctx context.Context, record deploymentpla
 	return nil
 }
 
+// insertApplicationDetails inserts the ports, persistent volumes and probes of an application.
+func (s *deploymentPlanStorage) insertApplicationDetails(ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string, app deploymentplan.Application) error {
+	for _, port := range app.Ports {
+		err := s.deploymentPlanApplicationPortTable.Insert(ctx, execer, deploymentApplicationPortRecordToRow(deploymentPlanID, app.PayloadName, port))
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+
+	for _, pv := range app.PersistentVolumes {
+		err := s.deploymentPlanApplicationPersistentVolumeTable.Insert(ctx, execer, deploymentApplicationPersistentVolumeRecordToRow(deploymentPlanID, app.PayloadName, pv))
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+
+	probes := map[string]*deploymentplan.Probe{
+		tables.DeploymentPlanApplicationProbeKindLiveness:  app.LivenessProbe,
+		tables.DeploymentPlanApplicationProbeKindReadiness: app.ReadinessProbe,
+	}
+	for kind, probe := range probes {
+		if probe == nil {
+			continue
+		}
+		err := s.deploymentPlanApplicationProbeTable.Insert(ctx, execer, deploymentApplicationProbeRecordToRow(deploymentPlanID, app.PayloadName, kind, *probe))
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+	return nil
+}
+
+// setApplicationProbes sets the probes of an application from the probe rows of its deployment plan.
+func setApplicationProbes(application *deploymentplan.Application, rows []tables.DeploymentPlanApplicationProbeRow) {
+	for _, row := range rows {
+		if row.PayloadName != application.PayloadName {
+			continue
+		}
+		switch row.Kind {
+		case tables.DeploymentPlanApplicationProbeKindLiveness:
+			application.LivenessProbe = deploymentApplicationProbeRowToRecord(row)
+		case tables.DeploymentPlanApplicationProbeKindReadiness:
+			application.ReadinessProbe = deploymentApplicationProbeRowToRecord(row)
+		}
+	}
+}
+
 func (s *deploymentPlanStorage) GetByID(ctx context.Context, id string) (deploymentplan.DeploymentPlanRecord, error) {
 	row, err := s.deploymentPlanTable.Get(ctx, tables.DeploymentPlanKeys{
 		ID: &id,
@@ -256,8 +394,15 @@ func (s *deploymentPlanStorage) getByPartialRecord(ctx context.Context, deployme
 	if err != nil {
 		return deploymentplan.DeploymentPlanRecord{}, errHandler(err)
 	}
+	probeRows, err := s.deploymentPlanApplicationProbeTable.List(ctx, tables.DeploymentPlanApplicationProbeTableSelectFilters{
+		DeploymentPlanIDIn: []string{record.Metadata.ID},
+	})
+	if err != nil {
+		return deploymentplan.DeploymentPlanRecord{}, errHandler(err)
+	}
 	for _, row := range applicationRows {
 		application := deploymentApplicationRowToRecord(row)
+		setApplicationProbes(&application, probeRows)
 
 		portRows, err := s.deploymentPlanApplicationPortTable.List(ctx, tables.DeploymentPlanApplicationPortTableSelectFilters{
 			DeploymentPlanIDIn: []string{record.Metadata.ID},
@@ -319,14 +464,25 @@ func (s *deploymentPlanStorage) getByPartialRecord(ctx context.Context, deployme
 }
 
 func (s *deploymentPlanStorage) UpdateStatus(ctx context.Context, metadata core.Metadata, status deploymentplan.DeploymentPlanStatus) error {
-	execer := s.DB
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
 	state := string(status.State)
 	message := status.Message
 	updateFields := tables.DeploymentPlanTableUpdateFields{
 		State:   &state,
 		Message: &message,
 	}
-	err := s.deploymentPlanTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	err = s.deploymentPlanTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
@@ -334,15 +490,26 @@ func (s *deploymentPlanStorage) UpdateStatus(ctx context.Context, metadata core.
 }
 
 func (s *deploymentPlanStorage) Delete(ctx context.Context, metadata core.Metadata) error {
-	execer := s.DB
-	err := s.deploymentPlanTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	err = s.deploymentPlanTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
 	return nil
 }
 
-func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan.DeploymentPlanListFilters) ([]deploymentplan.DeploymentPlanRecord, error) {
+func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan.DeploymentPlanListFilters, pageToken string) ([]deploymentplan.DeploymentPlanRecord, core.PageInfo, error) {
 	dbFilters := tables.DeploymentPlanTableSelectFilters{
 		IDIn:           append([]string{}, filters.IDIn...),
 		NameIn:         append([]string{}, filters.NameIn...),
@@ -359,9 +526,25 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 		dbFilters.StateNotIn = append(dbFilters.StateNotIn, string(state))
 	}
 
-	rows, err := s.deploymentPlanTable.List(ctx, dbFilters)
+	ids, page, err := paginate(pageToken, filters.Limit,
+		func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error) {
+			dbFilters.Limit = limit
+			return s.deploymentPlanTable.ListKeys(ctx, dbFilters, after)
+		},
+		func() (uint64, error) { return s.deploymentPlanTable.Count(ctx, dbFilters) },
+	)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+	if len(ids) == 0 {
+		return nil, page, nil
+	}
+	rows, err := s.deploymentPlanTable.List(ctx, tables.DeploymentPlanTableSelectFilters{
+		IDIn:           ids,
+		IncludeDeleted: filters.IncludeDeleted,
+	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 	var records []deploymentplan.DeploymentPlanRecord
 	for _, row := range rows {
@@ -376,18 +559,29 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 		DeploymentPlanIDIn: deploymentPlanIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
+	}
+	probeRows, err := s.deploymentPlanApplicationProbeTable.List(ctx, tables.DeploymentPlanApplicationProbeTableSelectFilters{
+		DeploymentPlanIDIn: deploymentPlanIDs,
+	})
+	if err != nil {
+		return nil, core.PageInfo{}, errHandler(err)
+	}
+	deploymentPlanIDToProbeRows := make(map[string][]tables.DeploymentPlanApplicationProbeRow)
+	for _, row := range probeRows {
+		deploymentPlanIDToProbeRows[row.DeploymentPlanID] = append(deploymentPlanIDToProbeRows[row.DeploymentPlanID], row)
 	}
 	deploymentPlanIDToApplications := make(map[string][]deploymentplan.Application)
 	for _, row := range applicationRows {
 		application := deploymentApplicationRowToRecord(row)
+		setApplicationProbes(&application, deploymentPlanIDToProbeRows[row.DeploymentPlanID])
 
 		portRows, err := s.deploymentPlanApplicationPortTable.List(ctx, tables.DeploymentPlanApplicationPortTableSelectFilters{
 			DeploymentPlanIDIn: []string{row.DeploymentPlanID},
 			PayloadNameIn:      []string{application.PayloadName},
 		})
 		if err != nil {
-			return nil, errHandler(err)
+			return nil, core.PageInfo{}, errHandler(err)
 		}
 		for _, portRow := range portRows {
 			application.Ports = append(application.Ports, deploymentApplicationPortRowToRecord(portRow))
@@ -398,13 +592,13 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 			PayloadNameIn:      []string{application.PayloadName},
 		})
 		if err != nil {
-			return nil, errHandler(err)
+			return nil, core.PageInfo{}, errHandler(err)
 		}
 		for _, pvRow := range pvRows {
 			application.PersistentVolumes = append(application.PersistentVolumes, deploymentApplicationPersistentVolumeRowToRecord(pvRow))
 		}
 
-		deploymentPlanIDToApplications[row.DeploymentPlanID] = append(deploymentPlanIDToApplications[row.DeploymentPlanID], deploymentApplicationRowToRecord(row))
+		deploymentPlanIDToApplications[row.DeploymentPlanID] = append(deploymentPlanIDToApplications[row.DeploymentPlanID], application)
 	}
 	for i, record := range records {
 		records[i].Applications = deploymentPlanIDToApplications[record.Metadata.ID]
@@ -414,7 +608,7 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 		DeploymentPlanIDIn: deploymentPlanIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
 	}
 	deploymentPlanIDToDeployments := make(map[string][]deploymentplan.Deployment)
 	for _, row := range deploymentRows {
@@ -424,7 +618,7 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 			DeploymentIDIn:     []string{row.ID},
 		})
 		if err != nil {
-			return nil, errHandler(err)
+			return nil, core.PageInfo{}, errHandler(err)
 		}
 		for _, row := range rows {
 			deployment.PayloadCoordinates = append(deployment.PayloadCoordinates, deploymentPlanDeploymentPayloadCoordinatesRowToRecord(row))
@@ -440,7 +634,7 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 		DeploymentPlanIDIn: deploymentPlanIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
 	}
 	deploymentPlanIDToMatchingCapabilities := make(map[string][]deploymentplan.MatchingComputeCapability)
 	for _, row := range matchingCapabilityRows {
@@ -449,7 +643,127 @@ func (s *deploymentPlanStorage) List(ctx context.Context, filters deploymentplan
 	for i, record := range records {
 		records[i].MatchingComputeCapabilities = deploymentPlanIDToMatchingCapabilities[record.Metadata.ID]
 	}
-	return records, nil
+	return orderByIDs(records, ids, func(record deploymentplan.DeploymentPlanRecord) string { return record.Metadata.ID }), page, nil
+}
+
+func (s *deploymentPlanStorage) UpdateSpec(ctx context.Context, metadata core.Metadata, spec deploymentplan.DeploymentPlanSpec) error {
+	existingRecord, err := s.GetByID(ctx, metadata.ID)
+	if err != nil {
+		return err
+	}
+	// The applications removed before are listed too, so that they are restored when they are added back.
+	applicationRows, err := s.deploymentPlanApplicationTable.List(ctx, tables.DeploymentPlanApplicationTableSelectFilters{
+		DeploymentPlanIDIn: []string{metadata.ID},
+		IncludeDeleted:     true,
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	existingApplications := make(map[string]bool)
+	for _, row := range applicationRows {
+		existingApplications[row.PayloadName] = true
+	}
+
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	// Keep the replaced spec in the history.
+	err = s.deploymentPlanSpecHistoryTable.Insert(ctx, execer, deploymentPlanSpecRevisionToRow(
+		metadata.ID,
+		metadata.Version,
+		deploymentplan.DeploymentPlanSpec{
+			MatchingComputeCapabilities: existingRecord.MatchingComputeCapabilities,
+			Applications:                existingRecord.Applications,
+		},
+		time.Now(),
+	))
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = s.deploymentPlanApplicationPortTable.DeleteByDeploymentPlan(ctx, execer, metadata.ID)
+	if err != nil {
+		return errHandler(err)
+	}
+	err = s.deploymentPlanApplicationPersistentVolumeTable.DeleteByDeploymentPlan(ctx, execer, metadata.ID)
+	if err != nil {
+		return errHandler(err)
+	}
+	err = s.deploymentPlanApplicationProbeTable.DeleteByDeploymentPlan(ctx, execer, metadata.ID)
+	if err != nil {
+		return errHandler(err)
+	}
+	err = s.deploymentPlanMatchingCapabilityTable.DeleteByDeploymentPlan(ctx, execer, metadata.ID)
+	if err != nil {
+		return errHandler(err)
+	}
+	// The applications are referenced by the payload coordinates of the deployments, so they are updated in
+	// place, and the removed ones are marked deleted.
+	notDeleted := int64(0)
+	payloadNames := make(map[string]bool)
+	for _, app := range spec.Applications {
+		payloadNames[app.PayloadName] = true
+		if existingApplications[app.PayloadName] {
+			err = s.deploymentPlanApplicationTable.Update(ctx, execer, metadata.ID, app.PayloadName, tables.DeploymentPlanApplicationTableUpdateFields{
+				Cores:     &app.Resources.Cores,
+				Memory:    &app.Resources.Memory,
+				DeletedAt: &notDeleted,
+			})
+		} else {
+			err = s.deploymentPlanApplicationTable.Insert(ctx, execer, deploymentApplicationRecordToRow(metadata.ID, app))
+		}
+		if err != nil {
+			return errHandler(err)
+		}
+		err = s.insertApplicationDetails(ctx, execer, metadata.ID, app)
+		if err != nil {
+			return err
+		}
+	}
+	deletedAt := time.Now().Unix()
+	for _, app := range existingRecord.Applications {
+		if payloadNames[app.PayloadName] {
+			continue
+		}
+		err = s.deploymentPlanApplicationTable.Update(ctx, execer, metadata.ID, app.PayloadName, tables.DeploymentPlanApplicationTableUpdateFields{
+			DeletedAt: &deletedAt,
+		})
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+	for _, capability := range spec.MatchingComputeCapabilities {
+		err = s.deploymentPlanMatchingCapabilityTable.Insert(ctx, execer, deploymentPlanMatchingCapabilityRecordToRow(metadata.ID, capability))
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+
+	// bump the version of the deployment plan
+	err = s.deploymentPlanTable.Update(ctx, execer, metadata.ID, metadata.Version, tables.DeploymentPlanTableUpdateFields{})
+	if err != nil {
+		return errHandler(err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *deploymentPlanStorage) ListSpecHistory(ctx context.Context, deploymentPlanID string) ([]deploymentplan.SpecRevision, error) {
+	rows, err := s.deploymentPlanSpecHistoryTable.List(ctx, tables.DeploymentPlanSpecHistoryTableSelectFilters{
+		DeploymentPlanIDIn: []string{deploymentPlanID},
+	})
+	if err != nil {
+		return nil, errHandler(err)
+	}
+	var revisions []deploymentplan.SpecRevision
+	for _, row := range rows {
+		revisions = append(revisions, deploymentPlanSpecHistoryRowToRecord(row))
+	}
+	return revisions, nil
 }
 
 func (s *deploymentPlanStorage) InsertDeployment(ctx context.Context, metadata core.Metadata, deployment deploymentplan.Deployment) error {
@@ -508,3 +822,40 @@ func (s *deploymentPlanStorage) UpdateDeploymentStatus(ctx context.Context, meta
 
 	return tx.Commit()
 }
+
+func (s *deploymentPlanStorage) UpdateDeploymentProgress(ctx context.Context, metadata core.Metadata, deploymentID string, progress deploymentplan.RolloutProgress) error {
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	err = s.deploymentPlanDeploymentTable.Update(ctx, execer, deploymentID, metadata.ID, tables.DeploymentPlanDeploymentTableUpdateFields{
+		CompletedBatches: &progress.CompletedBatches,
+		TotalBatches:     &progress.TotalBatches,
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+
+	// bump the version of the deployment plan
+	err = s.deploymentPlanTable.Update(ctx, execer, metadata.ID, metadata.Version, tables.DeploymentPlanTableUpdateFields{})
+	if err != nil {
+		return errHandler(err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *deploymentPlanStorage) ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error) {
+	return listChanges(ctx, s.changeLogTable, tables.ChangeLogKindDeploymentPlan, afterSequence, limit)
+}
+
+func (s *deploymentPlanStorage) LastChangeSequence(ctx context.Context) (uint64, error) {
+	sequence, err := s.changeLogTable.LastSequence(ctx)
+	if err != nil {
+		return 0, errHandler(err)
+	}
+	return sequence, nil
+}
@@ -66,6 +66,12 @@ func TestDeploymentPlanRecordLifecycle(t *testing.T) {
 						Port:     8081,
 					},
 				},
+				ReadinessProbe: &deploymentplan.Probe{
+					Type:          deploymentplan.ProbeTypeHTTP,
+					Path:          "/healthz",
+					Port:          8080,
+					PeriodSeconds: 5,
+				},
 			},
 			{
 				PayloadName: "app2",
@@ -80,6 +86,11 @@ func TestDeploymentPlanRecordLifecycle(t *testing.T) {
 						MountPath:    "/data",
 					},
 				},
+				LivenessProbe: &deploymentplan.Probe{
+					Type:             deploymentplan.ProbeTypeExec,
+					Command:          []string{"cat", "/tmp/healthy"},
+					FailureThreshold: 3,
+				},
 			},
 		},
 	}
@@ -225,7 +236,7 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 	})
 
 	t.Run("List", func(t *testing.T) {
-		records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{})
+		records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{}, "")
 		require.NoError(t, err)
 		require.Len(t, records, 10)
 
@@ -243,9 +254,9 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 		allRecords := records
 
 		t.Run("List Success With Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				StateIn: []deploymentplan.DeploymentPlanState{deploymentplan.DeploymentPlanStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -255,9 +266,9 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 		})
 
 		t.Run("List with Names Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				NameIn: []string{allRecords[0].Name, allRecords[1].Name, allRecords[2].Name},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 
@@ -268,9 +279,9 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 		})
 
 		t.Run("List with Limit", func(t *testing.T) {
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				Limit: 3,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
@@ -279,17 +290,17 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 			err = repo.Delete(ctx, allRecords[0].Metadata)
 			require.NoError(t, err)
 
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				IncludeDeleted: true,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 11)
 		})
 
 		t.Run("List with StateNotIn", func(t *testing.T) {
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				StateNotIn: []deploymentplan.DeploymentPlanState{deploymentplan.DeploymentPlanStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -306,16 +317,16 @@ func testDeploymentPlanCRUD(t *testing.T, repo deploymentplan.Repository, testRe
 			err = repo.UpdateStatus(ctx, allRecords[1].Metadata, status)
 			require.NoError(t, err)
 			ve := uint64(1)
-			records, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err := repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 1)
 
 			ve += 1
-			records, err = repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
+			records, _, err = repo.List(ctx, deploymentplan.DeploymentPlanListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 0)
 		})
@@ -3,6 +3,8 @@ package sqlstorage
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/msanath/gondolf/pkg/simplesql"
 	"github.com/msanath/mrds/ledger/core"
@@ -18,8 +20,14 @@ type metaInstanceStorage struct {
 	metaInstanceOperationTable       *tables.MetaInstanceOperationTable
 	metaInstanceRuntimeInstanceTable *tables.MetaInstanceRuntimeInstanceTable
 	deploymentPlanApplicationTable   *tables.DeploymentPlanApplicationTable
+	persistentVolumeTable            *tables.DeploymentPlanApplicationPersistentVolumeTable
+	portTable                        *tables.DeploymentPlanApplicationPortTable
 	nodeTable                        *tables.NodeTable
 	nodePayloadTable                 *tables.NodePayloadTable
+	nodeLocalVolumeTable             *tables.NodeLocalVolumeTable
+	nodeLocalVolumeAllocationTable   *tables.NodeLocalVolumeAllocationTable
+	nodePortAllocationTable          *tables.NodePortAllocationTable
+	changeLogTable                   *tables.ChangeLogTable
 }
 
 // newMetaInstanceStorage creates a new storage instance satisfying the MetaInstanceRepository interface
@@ -30,8 +38,14 @@ func newMetaInstanceStorage(db simplesql.Database) metainstance.Repository {
 		metaInstanceOperationTable:       tables.NewMetaInstanceOperationTable(db),
 		metaInstanceRuntimeInstanceTable: tables.NewMetaInstanceRuntimeInstanceTable(db),
 		deploymentPlanApplicationTable:   tables.NewDeploymentPlanApplicationTable(db),
+		persistentVolumeTable:            tables.NewDeploymentPlanApplicationPersistentVolumeTable(db),
+		portTable:                        tables.NewDeploymentPlanApplicationPortTable(db),
 		nodeTable:                        tables.NewNodeTable(db),
 		nodePayloadTable:                 tables.NewNodePayloadTable(db),
+		nodeLocalVolumeTable:             tables.NewNodeLocalVolumeTable(db),
+		nodeLocalVolumeAllocationTable:   tables.NewNodeLocalVolumeAllocationTable(db),
+		nodePortAllocationTable:          tables.NewNodePortAllocationTable(db),
+		changeLogTable:                   tables.NewChangeLogTable(db),
 	}
 }
 
@@ -64,6 +78,10 @@ func metaInstanceRowToModel(row tables.MetaInstanceRow) metainstance.MetaInstanc
 }
 
 func metaInstanceOperationRecordToRow(metaInstanceID string, record metainstance.Operation) tables.MetaInstanceOperationRow {
+	createdAt := int64(0)
+	if !record.CreatedAt.IsZero() {
+		createdAt = record.CreatedAt.Unix()
+	}
 	return tables.MetaInstanceOperationRow{
 		ID:             record.ID,
 		MetaInstanceID: metaInstanceID,
@@ -71,10 +89,15 @@ func metaInstanceOperationRecordToRow(metaInstanceID string, record metainstance
 		IntentID:       record.IntentID,
 		State:          string(record.Status.State),
 		Message:        record.Status.Message,
+		CreatedAt:      createdAt,
 	}
 }
 
 func metaInstanceOperationRowToModel(row tables.MetaInstanceOperationRow) metainstance.Operation {
+	var createdAt time.Time
+	if row.CreatedAt > 0 {
+		createdAt = time.Unix(row.CreatedAt, 0)
+	}
 	return metainstance.Operation{
 		ID:       row.ID,
 		Type:     metainstance.OperationType(row.Type),
@@ -83,6 +106,7 @@ func metaInstanceOperationRowToModel(row tables.MetaInstanceOperationRow) metain
 			State:   metainstance.OperationState(row.State),
 			Message: row.Message,
 		},
+		CreatedAt: createdAt,
 	}
 }
 
@@ -94,6 +118,7 @@ func metaInstanceRuntimeInstanceRecordToRow(metaInstanceID string, record metain
 		IsActive:       record.IsActive,
 		State:          string(record.Status.State),
 		Message:        record.Status.Message,
+		Ready:          record.Status.Ready,
 	}
 }
 
@@ -105,13 +130,29 @@ func metaInstanceRuntimeInstanceRowToModel(row tables.MetaInstanceRuntimeInstanc
 		Status: metainstance.RuntimeInstanceStatus{
 			State:   metainstance.RuntimeInstanceState(row.State),
 			Message: row.Message,
+			Ready:   row.Ready,
+		},
+		Resources: metainstance.RuntimeInstanceResources{
+			Cores:  row.Cores,
+			Memory: row.Memory,
 		},
 	}
 }
 
 func (s *metaInstanceStorage) Insert(ctx context.Context, record metainstance.MetaInstanceRecord) error {
-	execer := s.DB
-	err := s.metaInstanceTable.Insert(ctx, execer, metaInstanceRecordToRow(record))
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	err = s.metaInstanceTable.Insert(ctx, execer, metaInstanceRecordToRow(record))
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
@@ -165,14 +206,25 @@ func (s *metaInstanceStorage) getByPartialRecord(ctx context.Context, metaInstan
 }
 
 func (s *metaInstanceStorage) UpdateStatus(ctx context.Context, metadata core.Metadata, status metainstance.MetaInstanceStatus) error {
-	execer := s.DB
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
 	state := string(status.State)
 	message := status.Message
 	updateFields := tables.MetaInstanceTableUpdateFields{
 		State:   &state,
 		Message: &message,
 	}
-	err := s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	err = s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
@@ -180,11 +232,22 @@ func (s *metaInstanceStorage) UpdateStatus(ctx context.Context, metadata core.Me
 }
 
 func (s *metaInstanceStorage) UpdateDeploymentID(ctx context.Context, metadata core.Metadata, deploymentID string) error {
-	execer := s.DB
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
 	updateFields := tables.MetaInstanceTableUpdateFields{
 		DeploymentID: &deploymentID,
 	}
-	err := s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	err = s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
@@ -192,15 +255,26 @@ func (s *metaInstanceStorage) UpdateDeploymentID(ctx context.Context, metadata c
 }
 
 func (s *metaInstanceStorage) Delete(ctx context.Context, metadata core.Metadata) error {
-	execer := s.DB
-	err := s.metaInstanceTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	err = s.metaInstanceTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
 	return nil
 }
 
-func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.MetaInstanceListFilters) ([]metainstance.MetaInstanceRecord, error) {
+func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.MetaInstanceListFilters, pageToken string) ([]metainstance.MetaInstanceRecord, core.PageInfo, error) {
 	dbFilters := tables.MetaInstanceTableSelectFilters{
 		IDIn:               append([]string{}, filters.IDIn...),
 		NameIn:             append([]string{}, filters.NameIn...),
@@ -219,9 +293,25 @@ func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.Met
 		dbFilters.StateNotIn = append(dbFilters.StateNotIn, string(state))
 	}
 
-	rows, err := s.metaInstanceTable.List(ctx, dbFilters)
+	ids, page, err := paginate(pageToken, filters.Limit,
+		func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error) {
+			dbFilters.Limit = limit
+			return s.metaInstanceTable.ListKeys(ctx, dbFilters, after)
+		},
+		func() (uint64, error) { return s.metaInstanceTable.Count(ctx, dbFilters) },
+	)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+	if len(ids) == 0 {
+		return nil, page, nil
+	}
+	rows, err := s.metaInstanceTable.List(ctx, tables.MetaInstanceTableSelectFilters{
+		IDIn:           ids,
+		IncludeDeleted: filters.IncludeDeleted,
+	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 	metaInstanceIDs := make([]string, len(rows))
 	for i, row := range rows {
@@ -232,7 +322,7 @@ func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.Met
 		MetaInstanceIDIn: metaInstanceIDs,
 	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 	operationMap := make(map[string][]metainstance.Operation)
 	for _, row := range operationRows {
@@ -243,7 +333,7 @@ func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.Met
 		MetaInstanceIDIn: metaInstanceIDs,
 	})
 	if err != nil {
-		return nil, err
+		return nil, core.PageInfo{}, err
 	}
 	runtimeInstanceMap := make(map[string][]metainstance.RuntimeInstance)
 	for _, row := range runtimeInstanceRows {
@@ -257,7 +347,7 @@ func (s *metaInstanceStorage) List(ctx context.Context, filters metainstance.Met
 		record.RuntimeInstances = runtimeInstanceMap[row.ID]
 		records = append(records, record)
 	}
-	return records, nil
+	return orderByIDs(records, ids, func(record metainstance.MetaInstanceRecord) string { return record.Metadata.ID }), page, nil
 }
 
 func (s *metaInstanceStorage) InsertOperation(ctx context.Context, metadata core.Metadata, operation metainstance.Operation) error {
@@ -378,6 +468,48 @@ func (s *metaInstanceStorage) InsertRuntimeInstance(ctx context.Context, metadat
 		return errHandler(err)
 	}
 
+	// Find a local volume on the node for each persistent volume of the plan.
+	persistentVolumeRows, err := s.persistentVolumeTable.List(ctx, tables.DeploymentPlanApplicationPersistentVolumeTableSelectFilters{
+		DeploymentPlanIDIn: []string{metaInstanceRow.DeploymentPlanID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	localVolumeRows, err := s.nodeLocalVolumeTable.List(ctx, tables.NodeLocalVolumeTableSelectFilters{
+		NodeIDIn: []string{nodeRow.ID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	volumeAllocations, err := allocateLocalVolumes(nodeRow.Name, runtimeInstance.ID, localVolumeRows, persistentVolumeRows)
+	if err != nil {
+		return err
+	}
+
+	// Check that none of the ports of the plan are already allocated on the node.
+	portRows, err := s.portTable.List(ctx, tables.DeploymentPlanApplicationPortTableSelectFilters{
+		DeploymentPlanIDIn: []string{metaInstanceRow.DeploymentPlanID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	portAllocationRows, err := s.nodePortAllocationTable.List(ctx, tables.NodePortAllocationTableSelectFilters{
+		NodeIDIn: []string{nodeRow.ID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	for _, port := range portRows {
+		for _, allocation := range portAllocationRows {
+			if allocation.Protocol == port.Protocol && allocation.Port == port.Port {
+				return ledgererrors.NewLedgerError(
+					ledgererrors.ErrRecordInsertConflict,
+					fmt.Sprintf("Port %s/%d is already in use on the node. Node: %s, Runtime Instance: %s", port.Protocol, port.Port, nodeRow.Name, allocation.RuntimeInstanceID),
+				)
+			}
+		}
+	}
+
 	tx, err := s.DB.BeginTxx(ctx, nil)
 	if err != nil {
 		return errHandler(err)
@@ -385,7 +517,11 @@ func (s *metaInstanceStorage) InsertRuntimeInstance(ctx context.Context, metadat
 	defer tx.Rollback()
 
 	execer := tx
-	err = s.metaInstanceRuntimeInstanceTable.Insert(ctx, execer, metaInstanceRuntimeInstanceRecordToRow(metadata.ID, runtimeInstance))
+	// Record the reserved resources, so that they are released even when the spec of the plan changes.
+	runtimeInstanceRow := metaInstanceRuntimeInstanceRecordToRow(metadata.ID, runtimeInstance)
+	runtimeInstanceRow.Cores = requestedCores
+	runtimeInstanceRow.Memory = requestedMemory
+	err = s.metaInstanceRuntimeInstanceTable.Insert(ctx, execer, runtimeInstanceRow)
 	if err != nil {
 		return errHandler(err)
 	}
@@ -424,6 +560,40 @@ func (s *metaInstanceStorage) InsertRuntimeInstance(ctx context.Context, metadat
 		}
 	}
 
+	for _, lv := range localVolumeRows {
+		consumed := uint32(0)
+		for _, allocation := range volumeAllocations {
+			if allocation.MountPath == lv.MountPath {
+				consumed += allocation.Capacity
+			}
+		}
+		if consumed == 0 {
+			continue
+		}
+		err = s.nodeLocalVolumeTable.UpdateRemainingCapacity(ctx, execer, nodeRow.ID, lv.MountPath, lv.RemainingCapacity-consumed)
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+	for _, allocation := range volumeAllocations {
+		err = s.nodeLocalVolumeAllocationTable.Insert(ctx, execer, allocation)
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+	for _, port := range portRows {
+		err = s.nodePortAllocationTable.Insert(ctx, execer, tables.NodePortAllocationRow{
+			NodeID:            nodeRow.ID,
+			Protocol:          port.Protocol,
+			Port:              port.Port,
+			RuntimeInstanceID: runtimeInstance.ID,
+			PayloadName:       port.PayloadName,
+		})
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+
 	// update the meta instance state version
 	err = s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, tables.MetaInstanceTableUpdateFields{})
 	if err != nil {
@@ -451,6 +621,7 @@ func (s *metaInstanceStorage) UpdateRuntimeInstanceStatus(ctx context.Context, m
 	updateFields := tables.MetaInstanceRuntimeInstanceTableUpdateFields{
 		State:   &state,
 		Message: &message,
+		Ready:   &status.Ready,
 	}
 	err = s.metaInstanceRuntimeInstanceTable.Update(ctx, execer, runtimeInstanceID, metadata.ID, updateFields)
 	if err != nil {
@@ -501,6 +672,41 @@ func (s *metaInstanceStorage) UpdateRuntimeActiveState(ctx context.Context, meta
 	return nil
 }
 
+func (s *metaInstanceStorage) UpdateRuntimeActiveStates(ctx context.Context, states []metainstance.RuntimeActiveStates) error {
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+
+	for _, state := range states {
+		for instanceID, active := range state.ActiveStates {
+			isActive := active
+			updateFields := tables.MetaInstanceRuntimeInstanceTableUpdateFields{
+				IsActive: &isActive,
+			}
+			err = s.metaInstanceRuntimeInstanceTable.Update(ctx, execer, instanceID, state.Metadata.ID, updateFields)
+			if err != nil {
+				return errHandler(err)
+			}
+		}
+
+		// update the meta instance state version
+		err = s.metaInstanceTable.Update(ctx, execer, state.Metadata.ID, state.Metadata.Version, tables.MetaInstanceTableUpdateFields{})
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errHandler(err)
+	}
+	return nil
+}
+
 func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadata core.Metadata, runtimeInstanceID string) error {
 	// Get the associated metaInstance Record.
 	// Now get the sum of all the cores and memory for all applications in the deployment plan.
@@ -512,8 +718,10 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 	if err != nil {
 		return errHandler(err)
 	}
+	// The applications removed from the spec are listed too, as the runtime instance may hold their payloads.
 	applicationRows, err := s.deploymentPlanApplicationTable.List(ctx, tables.DeploymentPlanApplicationTableSelectFilters{
 		DeploymentPlanIDIn: []string{metaInstanceRow.DeploymentPlanID},
+		IncludeDeleted:     true,
 	})
 	if err != nil {
 		return errHandler(err)
@@ -522,8 +730,10 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 	requestedMemory := uint32(0)
 	payloadNames := []string{}
 	for _, app := range applicationRows {
-		requestedCores += app.Cores
-		requestedMemory += app.Memory
+		if app.DeletedAt == 0 {
+			requestedCores += app.Cores
+			requestedMemory += app.Memory
+		}
 		payloadNames = append(payloadNames, app.PayloadName)
 	}
 
@@ -556,6 +766,19 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 		return errHandler(err)
 	}
 
+	volumeAllocationRows, err := s.nodeLocalVolumeAllocationTable.List(ctx, tables.NodeLocalVolumeAllocationTableSelectFilters{
+		RuntimeInstanceIDIn: []string{runtimeInstanceID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+	localVolumeRows, err := s.nodeLocalVolumeTable.List(ctx, tables.NodeLocalVolumeTableSelectFilters{
+		NodeIDIn: []string{nodeRow.ID},
+	})
+	if err != nil {
+		return errHandler(err)
+	}
+
 	tx, err := s.DB.BeginTxx(ctx, nil)
 	if err != nil {
 		return errHandler(err)
@@ -568,7 +791,12 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 		return errHandler(err)
 	}
 
-	// Add the resources back to the node
+	// Add the resources back to the node. Runtime instances added before the resources were recorded release
+	// the resources of the current spec.
+	if runtimeInstanceRow.Cores != 0 || runtimeInstanceRow.Memory != 0 {
+		requestedCores = runtimeInstanceRow.Cores
+		requestedMemory = runtimeInstanceRow.Memory
+	}
 	newRemainingCores := nodeRow.RemainingCores + requestedCores
 	newRemainingMemory := nodeRow.RemainingMemory + requestedMemory
 
@@ -588,6 +816,33 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 		}
 	}
 
+	// Release the capacity consumed on the local volumes of the node.
+	for _, lv := range localVolumeRows {
+		released := uint32(0)
+		for _, allocation := range volumeAllocationRows {
+			if allocation.MountPath == lv.MountPath {
+				released += allocation.Capacity
+			}
+		}
+		if released == 0 {
+			continue
+		}
+		err = s.nodeLocalVolumeTable.UpdateRemainingCapacity(ctx, execer, nodeRow.ID, lv.MountPath, lv.RemainingCapacity+released)
+		if err != nil {
+			return errHandler(err)
+		}
+	}
+	err = s.nodeLocalVolumeAllocationTable.DeleteByRuntimeInstance(ctx, execer, runtimeInstanceID)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	// Release the ports held on the node.
+	err = s.nodePortAllocationTable.DeleteByRuntimeInstance(ctx, execer, runtimeInstanceID)
+	if err != nil {
+		return errHandler(err)
+	}
+
 	// update the meta instance state version
 	err = s.metaInstanceTable.Update(ctx, execer, metadata.ID, metadata.Version, tables.MetaInstanceTableUpdateFields{})
 	if err != nil {
@@ -600,3 +855,65 @@ func (s *metaInstanceStorage) DeleteRuntimeInstance(ctx context.Context, metadat
 	}
 	return nil
 }
+
+// allocateLocalVolumes picks a local volume of the node for every persistent volume. A local volume can back
+// several persistent volumes as long as it has remaining capacity. The largest persistent volumes are placed
+// first, each on the local volume with the least remaining capacity which can hold it.
+func allocateLocalVolumes(
+	nodeName string,
+	runtimeInstanceID string,
+	localVolumes []tables.NodeLocalVolumeRow,
+	persistentVolumes []tables.DeploymentPlanApplicationPersistentVolumeRow,
+) ([]tables.NodeLocalVolumeAllocationRow, error) {
+	remaining := make([]uint32, len(localVolumes))
+	for i, lv := range localVolumes {
+		remaining[i] = lv.RemainingCapacity
+	}
+
+	sorted := make([]tables.DeploymentPlanApplicationPersistentVolumeRow, len(persistentVolumes))
+	copy(sorted, persistentVolumes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Capacity > sorted[j].Capacity
+	})
+
+	allocations := make([]tables.NodeLocalVolumeAllocationRow, 0, len(sorted))
+	for _, pv := range sorted {
+		best := -1
+		for i, lv := range localVolumes {
+			if lv.StorageClass != pv.StorageClass || remaining[i] < pv.Capacity {
+				continue
+			}
+			if best == -1 || remaining[i] < remaining[best] {
+				best = i
+			}
+		}
+		if best == -1 {
+			return nil, ledgererrors.NewLedgerError(
+				ledgererrors.ErrRecordInsertConflict,
+				fmt.Sprintf("Node does not have a local volume of storage class %s with %d remaining capacity for %s. Node: %s", pv.StorageClass, pv.Capacity, pv.MountPath, nodeName),
+			)
+		}
+		remaining[best] -= pv.Capacity
+		allocations = append(allocations, tables.NodeLocalVolumeAllocationRow{
+			NodeID:            localVolumes[best].NodeID,
+			MountPath:         localVolumes[best].MountPath,
+			RuntimeInstanceID: runtimeInstanceID,
+			PayloadName:       pv.PayloadName,
+			VolumeMountPath:   pv.MountPath,
+			Capacity:          pv.Capacity,
+		})
+	}
+	return allocations, nil
+}
+
+func (s *metaInstanceStorage) ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error) {
+	return listChanges(ctx, s.changeLogTable, tables.ChangeLogKindMetaInstance, afterSequence, limit)
+}
+
+func (s *metaInstanceStorage) LastChangeSequence(ctx context.Context) (uint64, error) {
+	sequence, err := s.changeLogTable.LastSequence(ctx)
+	if err != nil {
+		return 0, errHandler(err)
+	}
+	return sequence, nil
+}
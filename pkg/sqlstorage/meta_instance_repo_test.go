@@ -195,6 +195,8 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 		updatedRecord, err := repo.GetByName(ctx, testRecord.Name)
 		require.NoError(t, err)
 		require.Len(t, updatedRecord.RuntimeInstances, 1)
+		// The resources of the plan are reserved for the runtime instance.
+		runtimeInstance.Resources = metainstance.RuntimeInstanceResources{Cores: 12, Memory: 64}
 		require.Equal(t, runtimeInstance, updatedRecord.RuntimeInstances[0])
 		require.Equal(t, testRecord.Metadata.Version+1, updatedRecord.Metadata.Version)
 		testRecord = updatedRecord
@@ -376,7 +378,7 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 	}
 
 	t.Run("List", func(t *testing.T) {
-		records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{})
+		records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{}, "")
 		require.NoError(t, err)
 		require.Len(t, records, 10)
 
@@ -393,9 +395,9 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 		allRecords := records
 
 		t.Run("List Success With Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				StateIn: []metainstance.MetaInstanceState{metainstance.MetaInstanceStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -404,9 +406,9 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Names Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				NameIn: []string{allRecords[0].Name, allRecords[1].Name, allRecords[2].Name},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 
@@ -417,9 +419,9 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Limit", func(t *testing.T) {
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				Limit: 3,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
@@ -431,17 +433,17 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 			err = repo.Delete(ctx, rec.Metadata)
 			require.NoError(t, err)
 
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				IncludeDeleted: true,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 11)
 		})
 
 		t.Run("List with StateNotIn", func(t *testing.T) {
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				StateNotIn: []metainstance.MetaInstanceState{metainstance.MetaInstanceStateActive},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -459,18 +461,383 @@ func TestMetaInstanceRecordLifecycle(t *testing.T) {
 			err = repo.UpdateStatus(ctx, rec.Metadata, status)
 			require.NoError(t, err)
 			ve := uint64(1)
-			records, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err := repo.List(ctx, metainstance.MetaInstanceListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 4)
 
 			ve += 2
-			records, err = repo.List(ctx, metainstance.MetaInstanceListFilters{
+			records, _, err = repo.List(ctx, metainstance.MetaInstanceListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 0)
 		})
 	})
 }
+
+func TestMetaInstanceLocalVolumeCapacity(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	ctx := context.Background()
+
+	// Create a deployment plan with two persistent volumes.
+	err := storage.DeploymentPlan.Insert(ctx, deploymentplan.DeploymentPlanRecord{
+		Metadata: core.Metadata{
+			ID:      "dp1",
+			Version: 1,
+		},
+		Name: "dp1",
+		Applications: []deploymentplan.Application{
+			{
+				PayloadName: "app1",
+				Resources: deploymentplan.ApplicationResources{
+					Cores:  1,
+					Memory: 1,
+				},
+				PersistentVolumes: []deploymentplan.ApplicationPersistentVolume{
+					{StorageClass: "SSD", Capacity: 300, MountPath: "/var/data"},
+					{StorageClass: "SSD", Capacity: 200, MountPath: "/var/logs"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = storage.DeploymentPlan.InsertDeployment(ctx, core.Metadata{ID: "dp1", Version: 1}, deploymentplan.Deployment{ID: "d1"})
+	require.NoError(t, err)
+
+	err = storage.Node.Insert(ctx, node.NodeRecord{
+		Metadata: core.Metadata{
+			ID:      "node1",
+			Version: 1,
+		},
+		Name:               "node1",
+		TotalResources:     node.Resources{Cores: 8, Memory: 8},
+		RemainingResources: node.Resources{Cores: 8, Memory: 8},
+		LocalVolumes: []node.LocalVolume{
+			{MountPath: "/data", StorageClass: "SSD", StorageCapacity: 800, RemainingCapacity: 800},
+		},
+		Status: node.NodeStatus{
+			State: node.NodeStateAllocated,
+		},
+	})
+	require.NoError(t, err)
+
+	repo := storage.MetaInstance
+	newMetaInstance := func(id string) metainstance.MetaInstanceRecord {
+		record := metainstance.MetaInstanceRecord{
+			Metadata:         core.Metadata{ID: id, Version: 1},
+			Name:             id,
+			Status:           metainstance.MetaInstanceStatus{State: metainstance.MetaInstanceStateActive},
+			DeploymentPlanID: "dp1",
+			DeploymentID:     "d1",
+		}
+		require.NoError(t, repo.Insert(ctx, record))
+		return record
+	}
+	remainingCapacity := func() uint32 {
+		nodeRecord, err := storage.Node.GetByID(ctx, "node1")
+		require.NoError(t, err)
+		require.Len(t, nodeRecord.LocalVolumes, 1)
+		return nodeRecord.LocalVolumes[0].RemainingCapacity
+	}
+
+	first := newMetaInstance("mi1")
+	second := newMetaInstance("mi2")
+
+	t.Run("Add Runtime Instance Consumes Capacity", func(t *testing.T) {
+		err := repo.InsertRuntimeInstance(ctx, first.Metadata, metainstance.RuntimeInstance{ID: "ri1", NodeID: "node1"})
+		require.NoError(t, err)
+		require.Equal(t, uint32(300), remainingCapacity())
+	})
+
+	t.Run("Add Runtime Instance Without Remaining Capacity Failure", func(t *testing.T) {
+		err := repo.InsertRuntimeInstance(ctx, second.Metadata, metainstance.RuntimeInstance{ID: "ri2", NodeID: "node1"})
+		require.Error(t, err)
+		require.Equal(t, ledgererrors.ErrRecordInsertConflict, err.(ledgererrors.LedgerError).Code)
+		require.Equal(t, uint32(300), remainingCapacity())
+	})
+
+	t.Run("Delete Runtime Instance Releases Capacity", func(t *testing.T) {
+		updated, err := repo.GetByID(ctx, first.Metadata.ID)
+		require.NoError(t, err)
+		err = repo.DeleteRuntimeInstance(ctx, updated.Metadata, "ri1")
+		require.NoError(t, err)
+		require.Equal(t, uint32(800), remainingCapacity())
+
+		err = repo.InsertRuntimeInstance(ctx, second.Metadata, metainstance.RuntimeInstance{ID: "ri2", NodeID: "node1"})
+		require.NoError(t, err)
+		require.Equal(t, uint32(300), remainingCapacity())
+	})
+}
+
+func TestMetaInstancePortAllocation(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	ctx := context.Background()
+
+	// Create two deployment plans which both want TCP:80.
+	for _, planID := range []string{"dp1", "dp2"} {
+		err := storage.DeploymentPlan.Insert(ctx, deploymentplan.DeploymentPlanRecord{
+			Metadata: core.Metadata{
+				ID:      planID,
+				Version: 1,
+			},
+			Name: planID,
+			Applications: []deploymentplan.Application{
+				{
+					PayloadName: planID + "-app",
+					Resources: deploymentplan.ApplicationResources{
+						Cores:  1,
+						Memory: 1,
+					},
+					Ports: []deploymentplan.ApplicationPort{
+						{Protocol: "TCP", Port: 80},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		err = storage.DeploymentPlan.InsertDeployment(ctx, core.Metadata{ID: planID, Version: 1}, deploymentplan.Deployment{ID: planID + "-d1"})
+		require.NoError(t, err)
+	}
+
+	for _, nodeID := range []string{"node1", "node2"} {
+		err := storage.Node.Insert(ctx, node.NodeRecord{
+			Metadata: core.Metadata{
+				ID:      nodeID,
+				Version: 1,
+			},
+			Name:               nodeID,
+			TotalResources:     node.Resources{Cores: 8, Memory: 8},
+			RemainingResources: node.Resources{Cores: 8, Memory: 8},
+			Status: node.NodeStatus{
+				State: node.NodeStateAllocated,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	repo := storage.MetaInstance
+	newMetaInstance := func(id string, planID string) metainstance.MetaInstanceRecord {
+		record := metainstance.MetaInstanceRecord{
+			Metadata:         core.Metadata{ID: id, Version: 1},
+			Name:             id,
+			Status:           metainstance.MetaInstanceStatus{State: metainstance.MetaInstanceStateActive},
+			DeploymentPlanID: planID,
+			DeploymentID:     planID + "-d1",
+		}
+		require.NoError(t, repo.Insert(ctx, record))
+		return record
+	}
+	nodesWithPortFree := func() []string {
+		records, _, err := storage.Node.List(ctx, node.NodeListFilters{
+			PortsNotInUse: []node.Port{{Protocol: "TCP", Port: 80}},
+		}, "")
+		require.NoError(t, err)
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.Metadata.ID)
+		}
+		return ids
+	}
+
+	first := newMetaInstance("mi1", "dp1")
+	second := newMetaInstance("mi2", "dp2")
+
+	t.Run("Add Runtime Instance Allocates Ports", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"node1", "node2"}, nodesWithPortFree())
+
+		err := repo.InsertRuntimeInstance(ctx, first.Metadata, metainstance.RuntimeInstance{ID: "ri1", NodeID: "node1"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"node2"}, nodesWithPortFree())
+
+		// A different port does not exclude the node.
+		records, _, err := storage.Node.List(ctx, node.NodeListFilters{
+			PortsNotInUse: []node.Port{{Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 443}},
+		}, "")
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+	})
+
+	t.Run("Add Runtime Instance With Port In Use Failure", func(t *testing.T) {
+		err := repo.InsertRuntimeInstance(ctx, second.Metadata, metainstance.RuntimeInstance{ID: "ri2", NodeID: "node1"})
+		require.Error(t, err)
+		require.Equal(t, ledgererrors.ErrRecordInsertConflict, err.(ledgererrors.LedgerError).Code)
+	})
+
+	t.Run("Delete Runtime Instance Releases Ports", func(t *testing.T) {
+		updated, err := repo.GetByID(ctx, first.Metadata.ID)
+		require.NoError(t, err)
+		err = repo.DeleteRuntimeInstance(ctx, updated.Metadata, "ri1")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"node1", "node2"}, nodesWithPortFree())
+
+		err = repo.InsertRuntimeInstance(ctx, second.Metadata, metainstance.RuntimeInstance{ID: "ri2", NodeID: "node1"})
+		require.NoError(t, err)
+	})
+}
+
+func TestMetaInstanceResourcesAfterSpecUpdate(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	ctx := context.Background()
+
+	err := storage.DeploymentPlan.Insert(ctx, deploymentplan.DeploymentPlanRecord{
+		Metadata: core.Metadata{
+			ID:      "dp1",
+			Version: 1,
+		},
+		Name: "dp1",
+		Applications: []deploymentplan.Application{
+			{
+				PayloadName: "app1",
+				Resources: deploymentplan.ApplicationResources{
+					Cores:  1,
+					Memory: 2,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = storage.DeploymentPlan.InsertDeployment(ctx, core.Metadata{ID: "dp1", Version: 1}, deploymentplan.Deployment{ID: "d1"})
+	require.NoError(t, err)
+
+	err = storage.Node.Insert(ctx, node.NodeRecord{
+		Metadata: core.Metadata{
+			ID:      "node1",
+			Version: 1,
+		},
+		Name:               "node1",
+		TotalResources:     node.Resources{Cores: 8, Memory: 8},
+		RemainingResources: node.Resources{Cores: 8, Memory: 8},
+		Status: node.NodeStatus{
+			State: node.NodeStateAllocated,
+		},
+	})
+	require.NoError(t, err)
+
+	repo := storage.MetaInstance
+	record := metainstance.MetaInstanceRecord{
+		Metadata:         core.Metadata{ID: "mi1", Version: 1},
+		Name:             "mi1",
+		Status:           metainstance.MetaInstanceStatus{State: metainstance.MetaInstanceStateActive},
+		DeploymentPlanID: "dp1",
+		DeploymentID:     "d1",
+	}
+	require.NoError(t, repo.Insert(ctx, record))
+	remainingResources := func() node.Resources {
+		nodeRecord, err := storage.Node.GetByID(ctx, "node1")
+		require.NoError(t, err)
+		return nodeRecord.RemainingResources
+	}
+
+	err = repo.InsertRuntimeInstance(ctx, record.Metadata, metainstance.RuntimeInstance{ID: "ri1", NodeID: "node1"})
+	require.NoError(t, err)
+	require.Equal(t, node.Resources{Cores: 7, Memory: 6}, remainingResources())
+
+	updated, err := repo.GetByID(ctx, "mi1")
+	require.NoError(t, err)
+	require.Len(t, updated.RuntimeInstances, 1)
+	require.Equal(t, metainstance.RuntimeInstanceResources{Cores: 1, Memory: 2}, updated.RuntimeInstances[0].Resources)
+
+	// The runtime instance releases the resources it reserved, not the resources of the new spec.
+	plan, err := storage.DeploymentPlan.GetByID(ctx, "dp1")
+	require.NoError(t, err)
+	err = storage.DeploymentPlan.UpdateSpec(ctx, plan.Metadata, deploymentplan.DeploymentPlanSpec{
+		Applications: []deploymentplan.Application{
+			{
+				PayloadName: "app1",
+				Resources: deploymentplan.ApplicationResources{
+					Cores:  4,
+					Memory: 4,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = repo.DeleteRuntimeInstance(ctx, updated.Metadata, "ri1")
+	require.NoError(t, err)
+	require.Equal(t, node.Resources{Cores: 8, Memory: 8}, remainingResources())
+}
+
+func TestMetaInstancePayloadsAfterSpecUpdate(t *testing.T) {
+	storage := test.TestSQLStorage(t)
+	ctx := context.Background()
+
+	err := storage.DeploymentPlan.Insert(ctx, deploymentplan.DeploymentPlanRecord{
+		Metadata: core.Metadata{
+			ID:      "dp1",
+			Version: 1,
+		},
+		Name: "dp1",
+		Applications: []deploymentplan.Application{
+			{
+				PayloadName: "app1",
+				Resources: deploymentplan.ApplicationResources{
+					Cores:  1,
+					Memory: 1,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = storage.DeploymentPlan.InsertDeployment(ctx, core.Metadata{ID: "dp1", Version: 1}, deploymentplan.Deployment{ID: "d1"})
+	require.NoError(t, err)
+
+	err = storage.Node.Insert(ctx, node.NodeRecord{
+		Metadata: core.Metadata{
+			ID:      "node1",
+			Version: 1,
+		},
+		Name:               "node1",
+		TotalResources:     node.Resources{Cores: 8, Memory: 8},
+		RemainingResources: node.Resources{Cores: 8, Memory: 8},
+		Status: node.NodeStatus{
+			State: node.NodeStateAllocated,
+		},
+	})
+	require.NoError(t, err)
+
+	repo := storage.MetaInstance
+	record := metainstance.MetaInstanceRecord{
+		Metadata:         core.Metadata{ID: "mi1", Version: 1},
+		Name:             "mi1",
+		Status:           metainstance.MetaInstanceStatus{State: metainstance.MetaInstanceStateActive},
+		DeploymentPlanID: "dp1",
+		DeploymentID:     "d1",
+	}
+	require.NoError(t, repo.Insert(ctx, record))
+	updateSpec := func(payloadNames ...string) {
+		plan, err := storage.DeploymentPlan.GetByID(ctx, "dp1")
+		require.NoError(t, err)
+		var applications []deploymentplan.Application
+		for _, payloadName := range payloadNames {
+			applications = append(applications, deploymentplan.Application{
+				PayloadName: payloadName,
+				Resources:   deploymentplan.ApplicationResources{Cores: 1, Memory: 1},
+			})
+		}
+		err = storage.DeploymentPlan.UpdateSpec(ctx, plan.Metadata, deploymentplan.DeploymentPlanSpec{Applications: applications})
+		require.NoError(t, err)
+	}
+
+	err = repo.InsertRuntimeInstance(ctx, record.Metadata, metainstance.RuntimeInstance{ID: "ri1", NodeID: "node1"})
+	require.NoError(t, err)
+
+	// The payload of the application removed from the spec is released with the runtime instance, so that
+	// the node can run it again once it is added back.
+	updateSpec("app2")
+	updated, err := repo.GetByID(ctx, "mi1")
+	require.NoError(t, err)
+	err = repo.DeleteRuntimeInstance(ctx, updated.Metadata, "ri1")
+	require.NoError(t, err)
+
+	updateSpec("app1", "app2")
+	updated, err = repo.GetByID(ctx, "mi1")
+	require.NoError(t, err)
+	err = repo.InsertRuntimeInstance(ctx, updated.Metadata, metainstance.RuntimeInstance{ID: "ri2", NodeID: "node1"})
+	require.NoError(t, err)
+	nodeRecord, err := storage.Node.GetByID(ctx, "node1")
+	require.NoError(t, err)
+	require.Equal(t, node.Resources{Cores: 6, Memory: 6}, nodeRecord.RemainingResources)
+}
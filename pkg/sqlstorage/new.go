@@ -1,6 +1,9 @@
 package sqlstorage
 
 import (
+	"context"
+	"time"
+
 	ledgererrors "github.com/msanath/mrds/ledger/errors"
 	"github.com/msanath/mrds/pkg/sqlstorage/tables"
 
@@ -25,6 +28,8 @@ type SQLStorage struct {
 	Cluster           cluster.Repository
 	DeploymentPlan    deploymentplan.Repository
 	// ++ledgerbuilder:RepositoryInterface
+
+	changeLogTable *tables.ChangeLogTable
 }
 
 func NewSQLStorage(
@@ -54,9 +59,17 @@ func NewSQLStorage(
 		MetaInstance:      newMetaInstanceStorage(simpleDB),
 		DeploymentPlan:    newDeploymentPlanStorage(simpleDB),
 		// ++ledgerbuilder:RepoInstance
+
+		changeLogTable: tables.NewChangeLogTable(simpleDB),
 	}, nil
 }
 
+// PruneChangeLog deletes the changes recorded before the given time. The watches which did not see them yet
+// must start again from the current records.
+func (s *SQLStorage) PruneChangeLog(ctx context.Context, before time.Time) error {
+	return errHandler(s.changeLogTable.Prune(ctx, before.Unix()))
+}
+
 func errHandler(err error) error {
 	if err == nil {
 		return nil
@@ -17,6 +17,7 @@ type nodeStorage struct {
 	nodeLocalVolumeTable *tables.NodeLocalVolumeTable
 	nodeCapabilityTable  *tables.NodeCapabilityTable
 	nodeDisruptionTable  *tables.NodeDisruptionTable
+	changeLogTable       *tables.ChangeLogTable
 }
 
 func nodeRecordToRow(record node.NodeRecord) tables.NodeRow {
@@ -94,18 +95,20 @@ func nodeDisruptionRowToRecord(row tables.NodeDisruptionRow) node.Disruption {
 
 func nodeLocalVolumeRecordToRow(nodeID string, record node.LocalVolume) tables.NodeLocalVolumeRow {
 	return tables.NodeLocalVolumeRow{
-		NodeID:          nodeID,
-		MountPath:       record.MountPath,
-		StorageClass:    record.StorageClass,
-		StorageCapacity: record.StorageCapacity,
+		NodeID:            nodeID,
+		MountPath:         record.MountPath,
+		StorageClass:      record.StorageClass,
+		StorageCapacity:   record.StorageCapacity,
+		RemainingCapacity: record.RemainingCapacity,
 	}
 }
 
 func nodeLocalVolumeRowToRecord(row tables.NodeLocalVolumeRow) node.LocalVolume {
 	return node.LocalVolume{
-		MountPath:       row.MountPath,
-		StorageClass:    row.StorageClass,
-		StorageCapacity: row.StorageCapacity,
+		MountPath:         row.MountPath,
+		StorageClass:      row.StorageClass,
+		StorageCapacity:   row.StorageCapacity,
+		RemainingCapacity: row.RemainingCapacity,
 	}
 }
 
@@ -128,6 +131,7 @@ func newNodeStorage(db simplesql.Database) node.Repository {
 		nodeLocalVolumeTable: tables.NewNodeLocalVolumeTable(db),
 		nodeCapabilityTable:  tables.NewNodeCapabilityTable(db),
 		nodeDisruptionTable:  tables.NewNodeDisruptionTable(db),
+		changeLogTable:       tables.NewChangeLogTable(db),
 	}
 }
 
@@ -224,7 +228,13 @@ func (s *nodeStorage) getByPartialRecord(ctx context.Context, record node.NodeRe
 }
 
 func (s *nodeStorage) UpdateStatus(ctx context.Context, metadata core.Metadata, status node.NodeStatus, clusterID string) error {
-	execer := s.DB
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
 	state := string(status.State)
 	message := status.Message
 	updateFields := tables.NodeUpdateFields{
@@ -232,7 +242,41 @@ func (s *nodeStorage) UpdateStatus(ctx context.Context, metadata core.Metadata,
 		Message:   &message,
 		ClusterID: &clusterID,
 	}
-	err := s.nodeTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	err = s.nodeTable.Update(ctx, execer, metadata.ID, metadata.Version, updateFields)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errHandler(err)
+	}
+	return nil
+}
+
+func (s *nodeStorage) UpdateSpec(ctx context.Context, record node.NodeRecord) error {
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	updateFields := tables.NodeUpdateFields{
+		UpdateDomain:         &record.UpdateDomain,
+		TotalCores:           &record.TotalResources.Cores,
+		TotalMemory:          &record.TotalResources.Memory,
+		SystemReservedCores:  &record.SystemReservedResources.Cores,
+		SystemReservedMemory: &record.SystemReservedResources.Memory,
+		RemainingCores:       &record.RemainingResources.Cores,
+		RemainingMemory:      &record.RemainingResources.Memory,
+	}
+	err = s.nodeTable.Update(ctx, execer, record.Metadata.ID, record.Metadata.Version, updateFields)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
@@ -240,15 +284,26 @@ func (s *nodeStorage) UpdateStatus(ctx context.Context, metadata core.Metadata,
 }
 
 func (s *nodeStorage) Delete(ctx context.Context, metadata core.Metadata) error {
-	execer := s.DB
-	err := s.nodeTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return errHandler(err)
+	}
+	defer tx.Rollback()
+
+	execer := tx
+	err = s.nodeTable.Delete(ctx, execer, metadata.ID, metadata.Version)
+	if err != nil {
+		return errHandler(err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return errHandler(err)
 	}
 	return nil
 }
 
-func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([]node.NodeRecord, error) {
+func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters, pageToken string) ([]node.NodeRecord, core.PageInfo, error) {
 	// Extract core filters
 	dbFilters := tables.NodeSelectFilters{
 		IDIn:               append([]string{}, filters.IDIn...),
@@ -267,6 +322,17 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		PayloadNameNotIn:   append([]string{}, filters.PayloadNameNotIn...),
 		UpdateDomainIn:     append([]string{}, filters.UpdateDomainIn...),
 	}
+	dbFilters.NoActiveDisruption = filters.NoActiveDisruption
+	if filters.NoDisruptionStartingBefore != nil {
+		startTime := uint64(filters.NoDisruptionStartingBefore.Unix())
+		dbFilters.NoDisruptionStartingBefore = &startTime
+	}
+	for _, port := range filters.PortsNotInUse {
+		dbFilters.PortsNotInUse = append(dbFilters.PortsNotInUse, tables.NodePort{
+			Protocol: port.Protocol,
+			Port:     port.Port,
+		})
+	}
 
 	// Extract node specific filters
 	for _, state := range filters.StateIn {
@@ -277,9 +343,25 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		dbFilters.StateNotIn = append(dbFilters.StateNotIn, string(state))
 	}
 
-	rows, errs := s.nodeTable.List(ctx, dbFilters)
-	if errs != nil {
-		return nil, errs
+	ids, page, err := paginate(pageToken, filters.Limit,
+		func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error) {
+			dbFilters.Limit = limit
+			return s.nodeTable.ListKeys(ctx, dbFilters, after)
+		},
+		func() (uint64, error) { return s.nodeTable.Count(ctx, dbFilters) },
+	)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+	if len(ids) == 0 {
+		return nil, page, nil
+	}
+	rows, err := s.nodeTable.List(ctx, tables.NodeSelectFilters{
+		IDIn:           ids,
+		IncludeDeleted: filters.IncludeDeleted,
+	})
+	if err != nil {
+		return nil, core.PageInfo{}, err
 	}
 
 	// Get all the IDs of the nodes
@@ -293,7 +375,7 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		NodeIDIn: nodeIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
 	}
 
 	// Create a map of nodeID to list of disruptions
@@ -306,7 +388,7 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		NodeIDIn: nodeIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
 	}
 	volumeMap := make(map[string][]node.LocalVolume)
 	for _, volume := range volumeRows {
@@ -318,7 +400,7 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		NodeIDIn: nodeIDs,
 	})
 	if err != nil {
-		return nil, errHandler(err)
+		return nil, core.PageInfo{}, errHandler(err)
 	}
 	capabilityMap := make(map[string][]string)
 	for _, capability := range capabilityRows {
@@ -334,7 +416,7 @@ func (s *nodeStorage) List(ctx context.Context, filters node.NodeListFilters) ([
 		records = append(records, record)
 	}
 
-	return records, nil
+	return orderByIDs(records, ids, func(record node.NodeRecord) string { return record.Metadata.ID }), page, nil
 }
 
 func (s *nodeStorage) InsertDisruption(ctx context.Context, nodeMetadata core.Metadata, record node.Disruption) error {
@@ -472,3 +554,15 @@ func (s *nodeStorage) DeleteCapability(ctx context.Context, nodeMetadata core.Me
 	}
 	return nil
 }
+
+func (s *nodeStorage) ListChanges(ctx context.Context, afterSequence uint64, limit uint32) ([]core.Change, error) {
+	return listChanges(ctx, s.changeLogTable, tables.ChangeLogKindNode, afterSequence, limit)
+}
+
+func (s *nodeStorage) LastChangeSequence(ctx context.Context) (uint64, error) {
+	sequence, err := s.changeLogTable.LastSequence(ctx)
+	if err != nil {
+		return 0, errHandler(err)
+	}
+	return sequence, nil
+}
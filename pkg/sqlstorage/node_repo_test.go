@@ -125,6 +125,26 @@ func TestNodeRecordLifecycle(t *testing.T) {
 		testRecord = updatedRecord
 	})
 
+	t.Run("Update Spec Success", func(t *testing.T) {
+		record := testRecord
+		record.UpdateDomain = "new-domain"
+		record.TotalResources = node.Resources{Cores: 32, Memory: 256}
+		record.SystemReservedResources = node.Resources{Cores: 2, Memory: 16}
+		record.RemainingResources = node.Resources{Cores: 30, Memory: 240}
+
+		err = repo.UpdateSpec(ctx, record)
+		require.NoError(t, err)
+
+		updatedRecord, err := repo.GetByName(ctx, testRecord.Name)
+		require.NoError(t, err)
+		require.Equal(t, record.UpdateDomain, updatedRecord.UpdateDomain)
+		require.Equal(t, record.TotalResources, updatedRecord.TotalResources)
+		require.Equal(t, record.SystemReservedResources, updatedRecord.SystemReservedResources)
+		require.Equal(t, record.RemainingResources, updatedRecord.RemainingResources)
+		require.Equal(t, testRecord.Metadata.Version+1, updatedRecord.Metadata.Version)
+		testRecord = updatedRecord
+	})
+
 	t.Run("Add disruption", func(t *testing.T) {
 		disruption := node.Disruption{
 			ID:          "disruption-1",
@@ -234,7 +254,7 @@ func TestNodeRecordLifecycle(t *testing.T) {
 	})
 
 	t.Run("List", func(t *testing.T) {
-		records, err := repo.List(ctx, node.NodeListFilters{})
+		records, _, err := repo.List(ctx, node.NodeListFilters{}, "")
 		require.NoError(t, err)
 		require.Len(t, records, 10)
 
@@ -252,9 +272,9 @@ func TestNodeRecordLifecycle(t *testing.T) {
 		allRecords := records
 
 		t.Run("List Success With Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				StateIn: []node.NodeState{node.NodeStateUnallocated},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -263,9 +283,9 @@ func TestNodeRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Names Filter", func(t *testing.T) {
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				NameIn: []string{allRecords[0].Name, allRecords[1].Name, allRecords[2].Name},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 
@@ -276,9 +296,9 @@ func TestNodeRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List with Limit", func(t *testing.T) {
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				Limit: 3,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 3)
 		})
@@ -287,17 +307,17 @@ func TestNodeRecordLifecycle(t *testing.T) {
 			err = repo.Delete(ctx, allRecords[0].Metadata)
 			require.NoError(t, err)
 
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				IncludeDeleted: true,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 11)
 		})
 
 		t.Run("List with StateNotIn", func(t *testing.T) {
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				StateNotIn: []node.NodeState{node.NodeStateUnallocated},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 5)
 			for _, record := range records {
@@ -315,25 +335,25 @@ func TestNodeRecordLifecycle(t *testing.T) {
 			require.NoError(t, err)
 
 			ve := uint64(1)
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 1)
 
 			ve += 1
-			records, err = repo.List(ctx, node.NodeListFilters{
+			records, _, err = repo.List(ctx, node.NodeListFilters{
 				VersionEq: &ve,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 0)
 		})
 
 		t.Run("List Remaining Resources", func(t *testing.T) {
 			remainingCores := uint32(21)
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				RemainingCoresGte: &remainingCores,
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 6)
 			for _, record := range records {
@@ -342,14 +362,51 @@ func TestNodeRecordLifecycle(t *testing.T) {
 		})
 
 		t.Run("List by ClusterID", func(t *testing.T) {
-			records, err := repo.List(ctx, node.NodeListFilters{
+			records, _, err := repo.List(ctx, node.NodeListFilters{
 				ClusterIDIn: []string{"cluster-1"},
-			})
+			}, "")
 			require.NoError(t, err)
 			require.Len(t, records, 2)
 			for _, record := range records {
 				require.Equal(t, "cluster-1", record.ClusterID)
 			}
 		})
+
+		t.Run("List by Disruptions", func(t *testing.T) {
+			now := time.Now().Truncate(time.Second)
+			disruptions := map[string]node.Disruption{
+				allRecords[2].Metadata.ID: {ID: "upcoming", StartTime: now.Add(time.Hour), Status: node.DisruptionStatus{State: node.DisruptionStateScheduled}},
+				allRecords[3].Metadata.ID: {ID: "imminent", StartTime: now.Add(-time.Minute), Status: node.DisruptionStatus{State: node.DisruptionStateApproved}},
+				allRecords[4].Metadata.ID: {ID: "done", StartTime: now.Add(-time.Hour), Status: node.DisruptionStatus{State: node.DisruptionStateCompleted}},
+			}
+			for nodeID, disruption := range disruptions {
+				record, err := repo.GetByID(ctx, nodeID)
+				require.NoError(t, err)
+				err = repo.InsertDisruption(ctx, record.Metadata, disruption)
+				require.NoError(t, err)
+			}
+
+			// The first record is deleted. The upcoming and imminent disruptions are active.
+			records, _, err := repo.List(ctx, node.NodeListFilters{
+				NoActiveDisruption: true,
+			}, "")
+			require.NoError(t, err)
+			require.Len(t, records, 7)
+			for _, record := range records {
+				require.NotEqual(t, allRecords[2].Metadata.ID, record.Metadata.ID)
+				require.NotEqual(t, allRecords[3].Metadata.ID, record.Metadata.ID)
+			}
+
+			// Only the imminent disruption starts within the next half an hour.
+			before := now.Add(30 * time.Minute)
+			records, _, err = repo.List(ctx, node.NodeListFilters{
+				NoDisruptionStartingBefore: &before,
+			}, "")
+			require.NoError(t, err)
+			require.Len(t, records, 8)
+			for _, record := range records {
+				require.NotEqual(t, allRecords[3].Metadata.ID, record.Metadata.ID)
+			}
+		})
 	})
 }
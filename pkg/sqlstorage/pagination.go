@@ -0,0 +1,96 @@
+package sqlstorage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+
+	"github.com/msanath/mrds/ledger/core"
+	ledgererrors "github.com/msanath/mrds/ledger/errors"
+	"github.com/msanath/mrds/pkg/sqlstorage/tables"
+)
+
+// encodePageToken returns the token of the page starting after the given key.
+func encodePageToken(key tables.PageKey) string {
+	token, _ := json.Marshal([]string{key.Name, key.ID})
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodePageToken(pageToken string) (tables.PageKey, error) {
+	invalidErr := ledgererrors.NewLedgerError(ledgererrors.ErrRequestInvalid, "Invalid page token.")
+	token, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return tables.PageKey{}, invalidErr
+	}
+	var parts []string
+	err = json.Unmarshal(token, &parts)
+	if err != nil || len(parts) != 2 {
+		return tables.PageKey{}, invalidErr
+	}
+	return tables.PageKey{Name: parts[0], ID: parts[1]}, nil
+}
+
+// paginate lists the keys of the page starting after the page token, and returns their IDs. A page has at
+// most limit records, and all the records when limit is 0. listKeys returns the keys ordered after the
+// after key, at most limit of them when it is not 0, and count returns the number of records of the list.
+//
+// The page starts after the key of the last record of the previous page rather than at an offset, so that
+// records created or deleted while the pages are listed do not shift the following pages.
+func paginate(
+	pageToken string,
+	limit uint32,
+	listKeys func(after *tables.PageKey, limit uint32) ([]tables.PageKey, error),
+	count func() (uint64, error),
+) ([]string, core.PageInfo, error) {
+	var after *tables.PageKey
+	if pageToken != "" {
+		key, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, core.PageInfo{}, err
+		}
+		after = &key
+	}
+
+	// One more key than the page holds tells whether there is a next page.
+	fetchLimit := limit
+	if limit > 0 {
+		fetchLimit = limit + 1
+	}
+	keys, err := listKeys(after, fetchLimit)
+	if err != nil {
+		return nil, core.PageInfo{}, err
+	}
+
+	var page core.PageInfo
+	if limit > 0 && len(keys) > int(limit) {
+		keys = keys[:limit]
+		page.NextPageToken = encodePageToken(keys[len(keys)-1])
+	}
+	if after == nil && page.NextPageToken == "" {
+		// The page holds all the records of the list.
+		page.TotalCount = uint64(len(keys))
+	} else {
+		page.TotalCount, err = count()
+		if err != nil {
+			return nil, core.PageInfo{}, err
+		}
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+	return ids, page, nil
+}
+
+// orderByIDs orders the records of a page as the IDs returned by paginate.
+func orderByIDs[R any](records []R, ids []string, id func(R) string) []R {
+	positions := make(map[string]int, len(ids))
+	for i, id := range ids {
+		positions[id] = i
+	}
+	slices.SortFunc(records, func(a, b R) int {
+		return positions[id(a)] - positions[id(b)]
+	})
+	return records
+}
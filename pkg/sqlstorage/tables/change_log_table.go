@@ -0,0 +1,213 @@
+package tables
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+var changeLogTableMigrations = []simplesql.Migration{
+	{
+		Version: 40, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE change_log (
+				sequence BIGINT NOT NULL PRIMARY KEY,
+				kind VARCHAR(255) NOT NULL,
+				record_id VARCHAR(255) NOT NULL,
+				version BIGINT NOT NULL,
+				change_type VARCHAR(255) NOT NULL,
+				created_at BIGINT NOT NULL
+			);
+		`,
+		Down: `
+			DROP TABLE IF EXISTS change_log;
+		`,
+	},
+	{
+		Version: 43, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE change_log_sequence (
+				id INT NOT NULL PRIMARY KEY,
+				sequence BIGINT NOT NULL,
+				pruned_sequence BIGINT NOT NULL
+			);
+		`,
+		Down: `
+			DROP TABLE IF EXISTS change_log_sequence;
+		`,
+	},
+	{
+		Version: 44, // Update the version number sequentially.
+		Up: `
+			INSERT INTO change_log_sequence (id, sequence, pruned_sequence)
+			SELECT 1, COALESCE(MAX(sequence), 0), 0 FROM change_log;
+		`,
+		Down: `
+			DELETE FROM change_log_sequence;
+		`,
+	},
+}
+
+// The kinds of records whose changes are recorded in the change log.
+const (
+	ChangeLogKindNode           = "Node"
+	ChangeLogKindMetaInstance   = "MetaInstance"
+	ChangeLogKindDeploymentPlan = "DeploymentPlan"
+)
+
+// The types of the changes recorded in the change log. They match the change types of the ledger.
+const (
+	ChangeLogTypeCreate = "ChangeType_CREATE"
+	ChangeLogTypeUpdate = "ChangeType_UPDATE"
+	ChangeLogTypeDelete = "ChangeType_DELETE"
+)
+
+// ChangeLogRow records a change of a record. Sequence orders the changes of all kinds, and Version is the
+// version of the record after the change.
+type ChangeLogRow struct {
+	Sequence   uint64 `db:"sequence"`
+	Kind       string `db:"kind"`
+	RecordID   string `db:"record_id"`
+	Version    uint64 `db:"version"`
+	ChangeType string `db:"change_type"`
+	CreatedAt  int64  `db:"created_at"`
+}
+
+type ChangeLogTableSelectFilters struct {
+	Kind          string
+	SequenceAfter uint64 // Selects the changes with a greater sequence.
+	Limit         uint32
+}
+
+const changeLogTableName = "change_log"
+
+type ChangeLogTable struct {
+	simplesql.Database
+	tableName string
+}
+
+func NewChangeLogTable(db simplesql.Database) *ChangeLogTable {
+	return &ChangeLogTable{
+		Database:  db,
+		tableName: changeLogTableName,
+	}
+}
+
+// Insert records a change with the next sequence. The sequence is taken from the counter row of the
+// change_log_sequence table, whose lock is held by the transaction of the change until it commits. So the
+// changes are committed in the order of their sequences, and a watcher which read a sequence never misses a
+// lower one committed later.
+func (s *ChangeLogTable) Insert(ctx context.Context, execer sqlx.ExecerContext, kind string, recordID string, version uint64, changeType string) error {
+	_, err := execer.ExecContext(ctx, `UPDATE change_log_sequence SET sequence = sequence + 1 WHERE id = 1`)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO change_log (sequence, kind, record_id, version, change_type, created_at)
+		SELECT sequence, :kind, :record_id, :version, :change_type, :created_at
+		FROM change_log_sequence
+		WHERE id = 1
+	`
+	params := map[string]interface{}{
+		"kind":        kind,
+		"record_id":   recordID,
+		"version":     version,
+		"change_type": changeType,
+		"created_at":  time.Now().Unix(),
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
+// List returns the changes of a kind in the order of their sequence.
+func (s *ChangeLogTable) List(ctx context.Context, filters ChangeLogTableSelectFilters) ([]ChangeLogRow, error) {
+	query := `
+		SELECT sequence, kind, record_id, version, change_type, created_at
+		FROM change_log
+		WHERE kind = :kind AND sequence > :sequence_after
+		ORDER BY sequence
+	`
+	params := map[string]interface{}{
+		"kind":           filters.Kind,
+		"sequence_after": filters.SequenceAfter,
+	}
+	if filters.Limit > 0 {
+		query += " LIMIT :limit"
+		params["limit"] = filters.Limit
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return nil, err
+	}
+	query = s.DB.Rebind(query)
+
+	var rows []ChangeLogRow
+	err = s.DB.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// LastSequence returns the sequence of the last committed change, or 0 when there is none.
+func (s *ChangeLogTable) LastSequence(ctx context.Context) (uint64, error) {
+	var sequence uint64
+	err := s.DB.GetContext(ctx, &sequence, `SELECT sequence FROM change_log_sequence WHERE id = 1`)
+	if err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// PrunedSequence returns the sequence up to which the changes were pruned, or 0 when none was.
+func (s *ChangeLogTable) PrunedSequence(ctx context.Context) (uint64, error) {
+	var sequence uint64
+	err := s.DB.GetContext(ctx, &sequence, `SELECT pruned_sequence FROM change_log_sequence WHERE id = 1`)
+	if err != nil {
+		return 0, err
+	}
+	return sequence, nil
+}
+
+// Prune deletes the changes created before the given unix time, and records the sequence up to which the
+// changes were pruned.
+func (s *ChangeLogTable) Prune(ctx context.Context, createdBefore int64) error {
+	tx, err := s.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE change_log_sequence
+		SET pruned_sequence = COALESCE(
+			(SELECT MAX(sequence) FROM change_log WHERE created_at < :created_before), pruned_sequence
+		)
+		WHERE id = 1
+	`
+	query, args, err := sqlx.Named(query, map[string]interface{}{"created_before": createdBefore})
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, s.DB.Rebind(query), args...)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM change_log
+		WHERE sequence <= (SELECT pruned_sequence FROM change_log_sequence WHERE id = 1)
+	`)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -27,6 +27,24 @@ var clusterTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS cluster;
 			`,
 	},
+	{
+		Version: 41, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE cluster ADD COLUMN runtime_type VARCHAR(255) NOT NULL DEFAULT '';
+		`,
+		Down: `
+			ALTER TABLE cluster DROP COLUMN runtime_type;
+		`,
+	},
+	{
+		Version: 42, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE cluster ADD COLUMN runtime_config VARCHAR(4096) NOT NULL DEFAULT '';
+		`,
+		Down: `
+			ALTER TABLE cluster DROP COLUMN runtime_config;
+		`,
+	},
 }
 
 type ClusterRow struct {
@@ -36,6 +54,9 @@ type ClusterRow struct {
 	DeletedAt int64  `db:"deleted_at"`
 	State     string `db:"state" orm:"op=create,update filter=In,NotIn"`
 	Message   string `db:"message" orm:"op=create,update"`
+
+	RuntimeType   string `db:"runtime_type" orm:"op=create"`
+	RuntimeConfig string `db:"runtime_config" orm:"op=create"`
 }
 
 type ClusterTableKeys struct {
@@ -102,6 +123,17 @@ func (s *ClusterTable) Delete(ctx context.Context, execer sqlx.ExecerContext, id
 	})
 }
 
+// ListKeys returns the page keys of the rows matching the filters, ordered by name and ID. Only the keys
+// ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func (s *ClusterTable) ListKeys(ctx context.Context, filters ClusterTableSelectFilters, after *PageKey) ([]PageKey, error) {
+	return selectPageKeys(ctx, s.Database, s.tableName, filters, after)
+}
+
+// Count returns the number of rows matching the filters, ignoring their Limit.
+func (s *ClusterTable) Count(ctx context.Context, filters ClusterTableSelectFilters) (uint64, error) {
+	return countRows(ctx, s.Database, s.tableName, filters)
+}
+
 func (s *ClusterTable) List(ctx context.Context, filters ClusterTableSelectFilters) ([]ClusterRow, error) {
 	var rows []ClusterRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
@@ -109,6 +109,17 @@ func (s *ComputeCapabilityTable) Delete(ctx context.Context, execer sqlx.ExecerC
 	})
 }
 
+// ListKeys returns the page keys of the rows matching the filters, ordered by name and ID. Only the keys
+// ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func (s *ComputeCapabilityTable) ListKeys(ctx context.Context, filters ComputeCapabilityTableSelectFilters, after *PageKey) ([]PageKey, error) {
+	return selectPageKeys(ctx, s.Database, s.tableName, filters, after)
+}
+
+// Count returns the number of rows matching the filters, ignoring their Limit.
+func (s *ComputeCapabilityTable) Count(ctx context.Context, filters ComputeCapabilityTableSelectFilters) (uint64, error) {
+	return countRows(ctx, s.Database, s.tableName, filters)
+}
+
 func (s *ComputeCapabilityTable) List(ctx context.Context, filters ComputeCapabilityTableSelectFilters) ([]ComputeCapabilityRow, error) {
 	var rows []ComputeCapabilityRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
@@ -59,6 +59,24 @@ func (s *DeploymentPlanApplicationPersistentVolumeTable) Insert(ctx context.Cont
 	return s.Database.InsertRow(ctx, execer, s.tableName, row)
 }
 
+// DeleteByDeploymentPlan deletes the persistent volumes of the applications of a deployment plan.
+func (s *DeploymentPlanApplicationPersistentVolumeTable) DeleteByDeploymentPlan(ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string) error {
+	query := `
+		DELETE FROM deployment_plan_application_persistent_volume
+		WHERE deployment_plan_id = :deployment_plan_id
+	`
+	params := map[string]interface{}{
+		"deployment_plan_id": deploymentPlanID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (s *DeploymentPlanApplicationPersistentVolumeTable) List(ctx context.Context, filters DeploymentPlanApplicationPersistentVolumeTableSelectFilters) ([]DeploymentPlanApplicationPersistentVolumeRow, error) {
 	var rows []DeploymentPlanApplicationPersistentVolumeRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
@@ -57,6 +57,24 @@ func (s *DeploymentPlanApplicationPortTable) Insert(ctx context.Context, execer
 	return s.Database.InsertRow(ctx, execer, s.tableName, row)
 }
 
+// DeleteByDeploymentPlan deletes the ports of the applications of a deployment plan.
+func (s *DeploymentPlanApplicationPortTable) DeleteByDeploymentPlan(ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string) error {
+	query := `
+		DELETE FROM deployment_plan_application_port
+		WHERE deployment_plan_id = :deployment_plan_id
+	`
+	params := map[string]interface{}{
+		"deployment_plan_id": deploymentPlanID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (s *DeploymentPlanApplicationPortTable) List(ctx context.Context, filters DeploymentPlanApplicationPortTableSelectFilters) ([]DeploymentPlanApplicationPortRow, error) {
 	var rows []DeploymentPlanApplicationPortRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
@@ -0,0 +1,107 @@
+package tables
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+var deploymentPlanApplicationProbeTableMigrations = []simplesql.Migration{
+	{
+		Version: 38, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE deployment_plan_application_probe (
+				deployment_plan_id VARCHAR(255) NOT NULL,
+				payload_name VARCHAR(255) NOT NULL,
+				kind VARCHAR(255) NOT NULL,
+				probe_type VARCHAR(255) NOT NULL,
+				path TEXT NOT NULL,
+				port INT NOT NULL,
+				command TEXT NOT NULL,
+				initial_delay_seconds INT NOT NULL,
+				period_seconds INT NOT NULL,
+				timeout_seconds INT NOT NULL,
+				failure_threshold INT NOT NULL,
+				deleted_at BIGINT NOT NULL DEFAULT 0,
+				PRIMARY KEY (deployment_plan_id, payload_name, kind),
+				FOREIGN KEY (deployment_plan_id, payload_name) REFERENCES deployment_plan_application(deployment_plan_id, payload_name) ON DELETE CASCADE
+			);
+		`,
+		Down: `
+				DROP TABLE IF EXISTS deployment_plan_application_probe;
+			`,
+	},
+}
+
+const (
+	// DeploymentPlanApplicationProbeKindLiveness is the kind of the row of a liveness probe.
+	DeploymentPlanApplicationProbeKindLiveness = "LIVENESS"
+	// DeploymentPlanApplicationProbeKindReadiness is the kind of the row of a readiness probe.
+	DeploymentPlanApplicationProbeKindReadiness = "READINESS"
+)
+
+type DeploymentPlanApplicationProbeRow struct {
+	DeploymentPlanID    string `db:"deployment_plan_id" orm:"op=create key=primary_key filter=In"`
+	PayloadName         string `db:"payload_name" orm:"op=create key=primary_key filter=In"`
+	Kind                string `db:"kind" orm:"op=create key=primary_key"`
+	ProbeType           string `db:"probe_type" orm:"op=create"`
+	Path                string `db:"path" orm:"op=create"`
+	Port                uint32 `db:"port" orm:"op=create"`
+	Command             string `db:"command" orm:"op=create"`
+	InitialDelaySeconds uint32 `db:"initial_delay_seconds" orm:"op=create"`
+	PeriodSeconds       uint32 `db:"period_seconds" orm:"op=create"`
+	TimeoutSeconds      uint32 `db:"timeout_seconds" orm:"op=create"`
+	FailureThreshold    uint32 `db:"failure_threshold" orm:"op=create"`
+}
+
+type DeploymentPlanApplicationProbeTableSelectFilters struct {
+	DeploymentPlanIDIn []string `db:"deployment_plan_id:in"` // IN condition
+	PayloadNameIn      []string `db:"payload_name:in"`       // IN condition
+}
+
+const deploymentPlanApplicationProbeTableName = "deployment_plan_application_probe"
+
+type DeploymentPlanApplicationProbeTable struct {
+	simplesql.Database
+	tableName string
+}
+
+func NewDeploymentPlanApplicationProbeTable(db simplesql.Database) *DeploymentPlanApplicationProbeTable {
+	return &DeploymentPlanApplicationProbeTable{
+		Database:  db,
+		tableName: deploymentPlanApplicationProbeTableName,
+	}
+}
+
+func (s *DeploymentPlanApplicationProbeTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row DeploymentPlanApplicationProbeRow) error {
+	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+}
+
+// DeleteByDeploymentPlan deletes the probes of the applications of a deployment plan.
+func (s *DeploymentPlanApplicationProbeTable) DeleteByDeploymentPlan(ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string) error {
+	query := `
+		DELETE FROM deployment_plan_application_probe
+		WHERE deployment_plan_id = :deployment_plan_id
+	`
+	params := map[string]interface{}{
+		"deployment_plan_id": deploymentPlanID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *DeploymentPlanApplicationProbeTable) List(ctx context.Context, filters DeploymentPlanApplicationProbeTableSelectFilters) ([]DeploymentPlanApplicationProbeRow, error) {
+	var rows []DeploymentPlanApplicationProbeRow
+	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 
@@ -31,13 +32,24 @@ var deploymentPlanApplicationTableMigrations = []simplesql.Migration{
 type DeploymentPlanApplicationRow struct {
 	DeploymentPlanID string `db:"deployment_plan_id" orm:"op=create filter=In"`
 	PayloadName      string `db:"payload_name" orm:"op=create filter=In"`
-	Cores            uint32 `db:"cores" orm:"op=create"`
-	Memory           uint32 `db:"memory" orm:"op=create"`
+	Cores            uint32 `db:"cores" orm:"op=create,update"`
+	Memory           uint32 `db:"memory" orm:"op=create,update"`
+	// DeletedAt is the unix time at which the application was removed from the spec of the plan. The row is
+	// kept, as the payload coordinates of the past deployments reference it.
+	DeletedAt int64 `db:"deleted_at"`
+}
+
+type DeploymentPlanApplicationTableUpdateFields struct {
+	Cores     *uint32 `db:"cores"`
+	Memory    *uint32 `db:"memory"`
+	DeletedAt *int64  `db:"deleted_at"`
 }
 
 type DeploymentPlanApplicationTableSelectFilters struct {
 	DeploymentPlanIDIn []string `db:"deployment_plan_id:in"` // IN condition
 	PayloadNameIn      []string `db:"payload_name:in"`       // IN condition
+
+	IncludeDeleted bool `db:"include_deleted"` // Special boolean handling
 }
 
 const deploymentPlanApplicationTableName = "deployment_plan_application"
@@ -58,6 +70,44 @@ func (s *DeploymentPlanApplicationTable) Insert(ctx context.Context, execer sqlx
 	return s.Database.InsertRow(ctx, execer, s.tableName, row)
 }
 
+func (s *DeploymentPlanApplicationTable) Update(
+	ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string, payloadName string, updateFields DeploymentPlanApplicationTableUpdateFields,
+) error {
+	query := `
+		UPDATE deployment_plan_application
+		SET
+	`
+	params := map[string]interface{}{
+		"deployment_plan_id": deploymentPlanID,
+		"payload_name":       payloadName,
+	}
+
+	var updates []string
+	if updateFields.Cores != nil {
+		updates = append(updates, "cores = :cores")
+		params["cores"] = *updateFields.Cores
+	}
+
+	if updateFields.Memory != nil {
+		updates = append(updates, "memory = :memory")
+		params["memory"] = *updateFields.Memory
+	}
+
+	if updateFields.DeletedAt != nil {
+		updates = append(updates, "deleted_at = :deleted_at")
+		params["deleted_at"] = *updateFields.DeletedAt
+	}
+
+	query += strings.Join(updates, ", ") + " WHERE deployment_plan_id = :deployment_plan_id AND payload_name = :payload_name"
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (s *DeploymentPlanApplicationTable) List(ctx context.Context, filters DeploymentPlanApplicationTableSelectFilters) ([]DeploymentPlanApplicationRow, error) {
 	var rows []DeploymentPlanApplicationRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
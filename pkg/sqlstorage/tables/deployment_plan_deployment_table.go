@@ -27,6 +27,114 @@ var deploymentPlanDeploymentTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS deployment_plan_deployment;
 			`,
 	},
+	{
+		Version: 23, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN rollout_strategy VARCHAR(255) NOT NULL DEFAULT '';
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN rollout_strategy;
+		`,
+	},
+	{
+		Version: 24, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN max_unavailable INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN max_unavailable;
+		`,
+	},
+	{
+		Version: 25, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN max_surge INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN max_surge;
+		`,
+	},
+	{
+		Version: 26, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN batch_size INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN batch_size;
+		`,
+	},
+	{
+		Version: 27, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN pause_seconds INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN pause_seconds;
+		`,
+	},
+	{
+		Version: 28, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN completed_batches INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN completed_batches;
+		`,
+	},
+	{
+		Version: 29, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN total_batches INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN total_batches;
+		`,
+	},
+	{
+		Version: 30, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN canary_instance_count INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN canary_instance_count;
+		`,
+	},
+	{
+		Version: 31, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN canary_percentage INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN canary_percentage;
+		`,
+	},
+	{
+		Version: 32, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN canary_bake_seconds INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN canary_bake_seconds;
+		`,
+	},
+	{
+		Version: 33, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN canary_max_unhealthy_instances INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN canary_max_unhealthy_instances;
+		`,
+	},
+	{
+		Version: 34, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan_deployment ADD COLUMN hold_seconds INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE deployment_plan_deployment DROP COLUMN hold_seconds;
+		`,
+	},
 }
 
 type DeploymentPlanDeploymentRow struct {
@@ -35,11 +143,27 @@ type DeploymentPlanDeploymentRow struct {
 	InstanceCount    uint32 `db:"instance_count" orm:"op=create"`
 	State            string `db:"state" orm:"op=create,update filter=In,NotIn"`
 	Message          string `db:"message" orm:"op=create,update"`
+
+	RolloutStrategy  string `db:"rollout_strategy" orm:"op=create"`
+	MaxUnavailable   uint32 `db:"max_unavailable" orm:"op=create"`
+	MaxSurge         uint32 `db:"max_surge" orm:"op=create"`
+	BatchSize        uint32 `db:"batch_size" orm:"op=create"`
+	PauseSeconds     uint32 `db:"pause_seconds" orm:"op=create"`
+	HoldSeconds      uint32 `db:"hold_seconds" orm:"op=create"`
+	CompletedBatches uint32 `db:"completed_batches" orm:"op=create,update"`
+	TotalBatches     uint32 `db:"total_batches" orm:"op=create,update"`
+
+	CanaryInstanceCount         uint32 `db:"canary_instance_count" orm:"op=create"`
+	CanaryPercentage            uint32 `db:"canary_percentage" orm:"op=create"`
+	CanaryBakeSeconds           uint32 `db:"canary_bake_seconds" orm:"op=create"`
+	CanaryMaxUnhealthyInstances uint32 `db:"canary_max_unhealthy_instances" orm:"op=create"`
 }
 
 type DeploymentPlanDeploymentTableUpdateFields struct {
-	State   *string `db:"state"`
-	Message *string `db:"message"`
+	State            *string `db:"state"`
+	Message          *string `db:"message"`
+	CompletedBatches *uint32 `db:"completed_batches"`
+	TotalBatches     *uint32 `db:"total_batches"`
 }
 
 type DeploymentPlanDeploymentTableSelectFilters struct {
@@ -91,6 +215,16 @@ func (s *DeploymentPlanDeploymentTable) Update(
 		params["message"] = *updateFields.Message
 	}
 
+	if updateFields.CompletedBatches != nil {
+		updates = append(updates, "completed_batches = :completed_batches")
+		params["completed_batches"] = *updateFields.CompletedBatches
+	}
+
+	if updateFields.TotalBatches != nil {
+		updates = append(updates, "total_batches = :total_batches")
+		params["total_batches"] = *updateFields.TotalBatches
+	}
+
 	query += strings.Join(updates, ", ") + " WHERE id = :id AND deployment_plan_id = :deployment_plan_id"
 	query, args, err := sqlx.Named(query, params)
 	if err != nil {
@@ -57,6 +57,24 @@ func (s *DeploymentMatchingCapabilityTable) Insert(ctx context.Context, execer s
 	return s.Database.InsertRow(ctx, execer, s.tableName, row)
 }
 
+// DeleteByDeploymentPlan deletes the matching capabilities of a deployment plan.
+func (s *DeploymentMatchingCapabilityTable) DeleteByDeploymentPlan(ctx context.Context, execer sqlx.ExecerContext, deploymentPlanID string) error {
+	query := `
+		DELETE FROM deployment_plan_matching_capability
+		WHERE deployment_plan_id = :deployment_plan_id
+	`
+	params := map[string]interface{}{
+		"deployment_plan_id": deploymentPlanID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (s *DeploymentMatchingCapabilityTable) List(ctx context.Context, filters DeploymentPlanMatchingCapabilityTableSelectFilters) ([]DeploymentPlanMatchingCapabilityRow, error) {
 	var rows []DeploymentPlanMatchingCapabilityRow
 	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
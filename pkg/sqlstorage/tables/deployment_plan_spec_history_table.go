@@ -0,0 +1,70 @@
+package tables
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+var deploymentPlanSpecHistoryTableMigrations = []simplesql.Migration{
+	{
+		Version: 35, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE deployment_plan_spec_history (
+				deployment_plan_id VARCHAR(255) NOT NULL,
+				version BIGINT NOT NULL,
+				spec TEXT NOT NULL,
+				replaced_at BIGINT NOT NULL,
+				deleted_at BIGINT NOT NULL DEFAULT 0,
+				PRIMARY KEY (deployment_plan_id, version),
+				FOREIGN KEY (deployment_plan_id) REFERENCES deployment_plan(id) ON DELETE CASCADE
+			);
+		`,
+		Down: `
+				DROP TABLE IF EXISTS deployment_plan_spec_history;
+			`,
+	},
+}
+
+// DeploymentPlanSpecHistoryRow records a spec of a deployment plan which was replaced by a spec update. Version
+// is the version of the deployment plan when the spec was replaced, and Spec is the JSON encoded spec.
+// ReplacedAt is the unix time of the update.
+type DeploymentPlanSpecHistoryRow struct {
+	DeploymentPlanID string `db:"deployment_plan_id" orm:"op=create key=primary_key filter=In"`
+	Version          uint64 `db:"version" orm:"op=create key=primary_key"`
+	Spec             string `db:"spec" orm:"op=create"`
+	ReplacedAt       int64  `db:"replaced_at" orm:"op=create"`
+}
+
+type DeploymentPlanSpecHistoryTableSelectFilters struct {
+	DeploymentPlanIDIn []string `db:"deployment_plan_id:in"` // IN condition
+}
+
+const deploymentPlanSpecHistoryTableName = "deployment_plan_spec_history"
+
+type DeploymentPlanSpecHistoryTable struct {
+	simplesql.Database
+	tableName string
+}
+
+func NewDeploymentPlanSpecHistoryTable(db simplesql.Database) *DeploymentPlanSpecHistoryTable {
+	return &DeploymentPlanSpecHistoryTable{
+		Database:  db,
+		tableName: deploymentPlanSpecHistoryTableName,
+	}
+}
+
+func (s *DeploymentPlanSpecHistoryTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row DeploymentPlanSpecHistoryRow) error {
+	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+}
+
+func (s *DeploymentPlanSpecHistoryTable) List(ctx context.Context, filters DeploymentPlanSpecHistoryTableSelectFilters) ([]DeploymentPlanSpecHistoryRow, error) {
+	var rows []DeploymentPlanSpecHistoryRow
+	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
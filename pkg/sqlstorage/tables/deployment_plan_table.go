@@ -29,6 +29,15 @@ var deploymentPlanTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS deployment_plan;
 			`,
 	},
+	{
+		Version: 18, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE deployment_plan ADD COLUMN scheduler_profile VARCHAR(255) NOT NULL DEFAULT '';
+		`,
+		Down: `
+			ALTER TABLE deployment_plan DROP COLUMN scheduler_profile;
+		`,
+	},
 }
 
 type DeploymentPlanRow struct {
@@ -40,6 +49,8 @@ type DeploymentPlanRow struct {
 	Message     string `db:"message" orm:"op=create,update"`
 	Namespace   string `db:"namespace" orm:"op=create filter=In"`
 	ServiceName string `db:"service_name" orm:"op=create filter=In"`
+
+	SchedulerProfile string `db:"scheduler_profile" orm:"op=create"`
 }
 
 type DeploymentPlanKeys struct {
@@ -70,20 +81,27 @@ type DeploymentPlanTableSelectFilters struct {
 
 const deploymentPlanTableName = "deployment_plan"
 
+// DeploymentPlanTable records every change of a deployment plan in the change log, with the execer of the change.
 type DeploymentPlanTable struct {
 	simplesql.Database
 	tableName string
+	changeLog *ChangeLogTable
 }
 
 func NewDeploymentPlanTable(db simplesql.Database) *DeploymentPlanTable {
 	return &DeploymentPlanTable{
 		Database:  db,
 		tableName: deploymentPlanTableName,
+		changeLog: NewChangeLogTable(db),
 	}
 }
 
 func (s *DeploymentPlanTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row DeploymentPlanRow) error {
-	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+	err := s.Database.InsertRow(ctx, execer, s.tableName, row)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindDeploymentPlan, row.ID, row.Version, ChangeLogTypeCreate)
 }
 
 func (s *DeploymentPlanTable) Get(ctx context.Context, keys DeploymentPlanKeys) (DeploymentPlanRow, error) {
@@ -98,14 +116,33 @@ func (s *DeploymentPlanTable) Get(ctx context.Context, keys DeploymentPlanKeys)
 func (s *DeploymentPlanTable) Update(
 	ctx context.Context, execer sqlx.ExecerContext, id string, version uint64, updateFields DeploymentPlanTableUpdateFields,
 ) error {
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindDeploymentPlan, id, version+1, ChangeLogTypeUpdate)
 }
 
 func (s *DeploymentPlanTable) Delete(ctx context.Context, execer sqlx.ExecerContext, id string, version uint64) error {
 	timeNow := time.Now().Unix()
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, DeploymentPlanTableUpdateFields{
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, DeploymentPlanTableUpdateFields{
 		DeletedAt: &timeNow,
 	})
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindDeploymentPlan, id, version+1, ChangeLogTypeDelete)
+}
+
+// ListKeys returns the page keys of the rows matching the filters, ordered by name and ID. Only the keys
+// ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func (s *DeploymentPlanTable) ListKeys(ctx context.Context, filters DeploymentPlanTableSelectFilters, after *PageKey) ([]PageKey, error) {
+	return selectPageKeys(ctx, s.Database, s.tableName, filters, after)
+}
+
+// Count returns the number of rows matching the filters, ignoring their Limit.
+func (s *DeploymentPlanTable) Count(ctx context.Context, filters DeploymentPlanTableSelectFilters) (uint64, error) {
+	return countRows(ctx, s.Database, s.tableName, filters)
 }
 
 func (s *DeploymentPlanTable) List(ctx context.Context, filters DeploymentPlanTableSelectFilters) ([]DeploymentPlanRow, error) {
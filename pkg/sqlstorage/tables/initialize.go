@@ -24,6 +24,11 @@ func Initialize(simpleDB simplesql.Database) error {
 	schemaMigrations = append(schemaMigrations, metaInstanceOperationTableMigrations...)
 	schemaMigrations = append(schemaMigrations, metaInstanceRuntimeInstanceTableMigrations...)
 	schemaMigrations = append(schemaMigrations, nodePayloadTableMigrations...)
+	schemaMigrations = append(schemaMigrations, nodeLocalVolumeAllocationTableMigrations...)
+	schemaMigrations = append(schemaMigrations, nodePortAllocationTableMigrations...)
+	schemaMigrations = append(schemaMigrations, deploymentPlanSpecHistoryTableMigrations...)
+	schemaMigrations = append(schemaMigrations, deploymentPlanApplicationProbeTableMigrations...)
+	schemaMigrations = append(schemaMigrations, changeLogTableMigrations...)
 	// ++ledgerbuilder:Migrations
 
 	err := simpleDB.ApplyMigrations(schemaMigrations)
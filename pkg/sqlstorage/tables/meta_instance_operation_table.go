@@ -29,6 +29,15 @@ var metaInstanceOperationTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS meta_instance_operation;
 			`,
 	},
+	{
+		Version: 45, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE meta_instance_operation ADD COLUMN created_at BIGINT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE meta_instance_operation DROP COLUMN created_at;
+		`,
+	},
 }
 
 type MetaInstanceOperationRow struct {
@@ -38,6 +47,7 @@ type MetaInstanceOperationRow struct {
 	IntentID       string `db:"intent_id" orm:"op=create"`
 	State          string `db:"state" orm:"op=create,update filter=In,NotIn"`
 	Message        string `db:"message" orm:"op=create,update"`
+	CreatedAt      int64  `db:"created_at" orm:"op=create"` // CreatedAt is the unix time at which the operation was added.
 }
 
 type MetaInstanceOperationTableUpdateFields struct {
@@ -29,6 +29,33 @@ var metaInstanceRuntimeInstanceTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS meta_instance_runtime_instance;
 			`,
 	},
+	{
+		Version: 36, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE meta_instance_runtime_instance ADD COLUMN cores INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE meta_instance_runtime_instance DROP COLUMN cores;
+		`,
+	},
+	{
+		Version: 37, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE meta_instance_runtime_instance ADD COLUMN memory INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE meta_instance_runtime_instance DROP COLUMN memory;
+		`,
+	},
+	{
+		Version: 39, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE meta_instance_runtime_instance ADD COLUMN ready BOOLEAN NOT NULL DEFAULT FALSE;
+		`,
+		Down: `
+			ALTER TABLE meta_instance_runtime_instance DROP COLUMN ready;
+		`,
+	},
 }
 
 type MetaInstanceRuntimeInstanceRow struct {
@@ -38,11 +65,16 @@ type MetaInstanceRuntimeInstanceRow struct {
 	IsActive       bool   `db:"is_active" orm:"op=create,update filter=In"`
 	State          string `db:"state" orm:"op=create,update filter=In,NotIn"`
 	Message        string `db:"message" orm:"op=create,update"`
+	Ready          bool   `db:"ready" orm:"op=create,update"`
+	// Cores and Memory are the resources reserved on the node for the runtime instance.
+	Cores  uint32 `db:"cores" orm:"op=create"`
+	Memory uint32 `db:"memory" orm:"op=create"`
 }
 
 type MetaInstanceRuntimeInstanceTableUpdateFields struct {
 	State    *string `db:"state"`
 	Message  *string `db:"message"`
+	Ready    *bool   `db:"ready"`
 	IsActive *bool   `db:"is_active"`
 }
 
@@ -96,6 +128,11 @@ func (s *MetaInstanceRuntimeInstanceTable) Update(
 		params["message"] = *updateFields.Message
 	}
 
+	if updateFields.Ready != nil {
+		updates = append(updates, "ready = :ready")
+		params["ready"] = *updateFields.Ready
+	}
+
 	if updateFields.IsActive != nil {
 		updates = append(updates, "is_active = :is_active")
 		params["is_active"] = *updateFields.IsActive
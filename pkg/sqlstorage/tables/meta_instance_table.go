@@ -73,20 +73,27 @@ type MetaInstanceTableSelectFilters struct {
 
 const metaInstanceTableName = "meta_instance"
 
+// MetaInstanceTable records every change of a meta instance in the change log, with the execer of the change.
 type MetaInstanceTable struct {
 	simplesql.Database
 	tableName string
+	changeLog *ChangeLogTable
 }
 
 func NewMetaInstanceTable(db simplesql.Database) *MetaInstanceTable {
 	return &MetaInstanceTable{
 		Database:  db,
 		tableName: metaInstanceTableName,
+		changeLog: NewChangeLogTable(db),
 	}
 }
 
 func (s *MetaInstanceTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row MetaInstanceRow) error {
-	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+	err := s.Database.InsertRow(ctx, execer, s.tableName, row)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindMetaInstance, row.ID, row.Version, ChangeLogTypeCreate)
 }
 
 func (s *MetaInstanceTable) Get(ctx context.Context, keys MetaInstanceKeys) (MetaInstanceRow, error) {
@@ -101,14 +108,33 @@ func (s *MetaInstanceTable) Get(ctx context.Context, keys MetaInstanceKeys) (Met
 func (s *MetaInstanceTable) Update(
 	ctx context.Context, execer sqlx.ExecerContext, id string, version uint64, updateFields MetaInstanceTableUpdateFields,
 ) error {
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindMetaInstance, id, version+1, ChangeLogTypeUpdate)
 }
 
 func (s *MetaInstanceTable) Delete(ctx context.Context, execer sqlx.ExecerContext, id string, version uint64) error {
 	timeNow := time.Now().Unix()
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, MetaInstanceTableUpdateFields{
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, MetaInstanceTableUpdateFields{
 		DeletedAt: &timeNow,
 	})
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindMetaInstance, id, version+1, ChangeLogTypeDelete)
+}
+
+// ListKeys returns the page keys of the rows matching the filters, ordered by name and ID. Only the keys
+// ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func (s *MetaInstanceTable) ListKeys(ctx context.Context, filters MetaInstanceTableSelectFilters, after *PageKey) ([]PageKey, error) {
+	return selectPageKeys(ctx, s.Database, s.tableName, filters, after)
+}
+
+// Count returns the number of rows matching the filters, ignoring their Limit.
+func (s *MetaInstanceTable) Count(ctx context.Context, filters MetaInstanceTableSelectFilters) (uint64, error) {
+	return countRows(ctx, s.Database, s.tableName, filters)
 }
 
 func (s *MetaInstanceTable) List(ctx context.Context, filters MetaInstanceTableSelectFilters) ([]MetaInstanceRow, error) {
@@ -0,0 +1,91 @@
+package tables
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+var nodeLocalVolumeAllocationTableMigrations = []simplesql.Migration{
+	{
+		Version: 21, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE node_local_volume_allocation (
+				node_id VARCHAR(255) NOT NULL,
+				mount_path VARCHAR(255) NOT NULL,
+				runtime_instance_id VARCHAR(255) NOT NULL,
+				payload_name VARCHAR(255) NOT NULL,
+				volume_mount_path VARCHAR(255) NOT NULL,
+				capacity INT NOT NULL,
+				deleted_at BIGINT NOT NULL DEFAULT 0,
+				PRIMARY KEY (runtime_instance_id, payload_name, volume_mount_path),
+				FOREIGN KEY (node_id, mount_path) REFERENCES node_local_volume(node_id, mount_path) ON DELETE CASCADE
+			);
+		`,
+		Down: `
+				DROP TABLE IF EXISTS node_local_volume_allocation;
+			`,
+	},
+}
+
+// NodeLocalVolumeAllocationRow records the capacity of a node local volume consumed by a persistent volume
+// of a runtime instance.
+type NodeLocalVolumeAllocationRow struct {
+	NodeID            string `db:"node_id" orm:"op=create filter=In"`
+	MountPath         string `db:"mount_path" orm:"op=create"`
+	RuntimeInstanceID string `db:"runtime_instance_id" orm:"op=create key=primary_key filter=In"`
+	PayloadName       string `db:"payload_name" orm:"op=create key=primary_key"`
+	VolumeMountPath   string `db:"volume_mount_path" orm:"op=create key=primary_key"`
+	Capacity          uint32 `db:"capacity" orm:"op=create"`
+}
+
+type NodeLocalVolumeAllocationTableSelectFilters struct {
+	NodeIDIn            []string `db:"node_id:in"`             // IN condition
+	RuntimeInstanceIDIn []string `db:"runtime_instance_id:in"` // IN condition
+}
+
+const nodeLocalVolumeAllocationTableName = "node_local_volume_allocation"
+
+type NodeLocalVolumeAllocationTable struct {
+	simplesql.Database
+	tableName string
+}
+
+func NewNodeLocalVolumeAllocationTable(db simplesql.Database) *NodeLocalVolumeAllocationTable {
+	return &NodeLocalVolumeAllocationTable{
+		Database:  db,
+		tableName: nodeLocalVolumeAllocationTableName,
+	}
+}
+
+func (s *NodeLocalVolumeAllocationTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row NodeLocalVolumeAllocationRow) error {
+	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+}
+
+func (s *NodeLocalVolumeAllocationTable) DeleteByRuntimeInstance(ctx context.Context, execer sqlx.ExecerContext, runtimeInstanceID string) error {
+	query := `
+		DELETE FROM node_local_volume_allocation
+		WHERE runtime_instance_id = :runtime_instance_id
+	`
+	params := map[string]interface{}{
+		"runtime_instance_id": runtimeInstanceID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *NodeLocalVolumeAllocationTable) List(ctx context.Context, filters NodeLocalVolumeAllocationTableSelectFilters) ([]NodeLocalVolumeAllocationRow, error) {
+	var rows []NodeLocalVolumeAllocationRow
+	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
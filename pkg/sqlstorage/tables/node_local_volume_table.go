@@ -26,6 +26,24 @@ var nodeLocalVolumeTableMigrations = []simplesql.Migration{
 				DROP TABLE IF EXISTS node_local_volume;
 			`,
 	},
+	{
+		Version: 19, // Update the version number sequentially.
+		Up: `
+			ALTER TABLE node_local_volume ADD COLUMN remaining_capacity INT NOT NULL DEFAULT 0;
+		`,
+		Down: `
+			ALTER TABLE node_local_volume DROP COLUMN remaining_capacity;
+		`,
+	},
+	{
+		Version: 20, // Update the version number sequentially.
+		Up: `
+			UPDATE node_local_volume SET remaining_capacity = storage_capacity;
+		`,
+		Down: `
+			UPDATE node_local_volume SET remaining_capacity = 0;
+		`,
+	},
 }
 
 type NodeLocalVolumeRow struct {
@@ -33,6 +51,8 @@ type NodeLocalVolumeRow struct {
 	MountPath       string `db:"mount_path" orm:"op=create"`
 	StorageClass    string `db:"storage_class" orm:"op=create filter=In"`
 	StorageCapacity uint32 `db:"storage_capacity" orm:"op=create"`
+
+	RemainingCapacity uint32 `db:"remaining_capacity" orm:"op=create,update"`
 }
 
 type NodeLocalVolumeTableSelectFilters struct {
@@ -75,6 +95,28 @@ func (s *NodeLocalVolumeTable) Delete(ctx context.Context, execer sqlx.ExecerCon
 	return err
 }
 
+func (s *NodeLocalVolumeTable) UpdateRemainingCapacity(
+	ctx context.Context, execer sqlx.ExecerContext, nodeID string, mountPath string, remainingCapacity uint32,
+) error {
+	query := `
+		UPDATE node_local_volume
+		SET remaining_capacity = :remaining_capacity
+		WHERE node_id = :node_id AND mount_path = :mount_path
+	`
+	params := map[string]interface{}{
+		"node_id":            nodeID,
+		"mount_path":         mountPath,
+		"remaining_capacity": remainingCapacity,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
 // TODO: This should also be auto-generated.
 func (s *NodeLocalVolumeTable) List(ctx context.Context, filters NodeLocalVolumeTableSelectFilters) ([]NodeLocalVolumeRow, error) {
 	var rows []NodeLocalVolumeRow
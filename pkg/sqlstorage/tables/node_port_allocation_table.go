@@ -0,0 +1,95 @@
+package tables
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+var nodePortAllocationTableMigrations = []simplesql.Migration{
+	{
+		Version: 22, // Update the version number sequentially.
+		Up: `
+			CREATE TABLE node_port_allocation (
+				node_id VARCHAR(255) NOT NULL,
+				protocol VARCHAR(255) NOT NULL,
+				port INT NOT NULL,
+				runtime_instance_id VARCHAR(255) NOT NULL,
+				payload_name VARCHAR(255) NOT NULL,
+				deleted_at BIGINT NOT NULL DEFAULT 0,
+				PRIMARY KEY (node_id, protocol, port),
+				FOREIGN KEY (node_id) REFERENCES node(id) ON DELETE CASCADE
+			);
+		`,
+		Down: `
+				DROP TABLE IF EXISTS node_port_allocation;
+			`,
+	},
+}
+
+// NodePortAllocationRow records a host port of a node held by a payload of a runtime instance. The primary key
+// guarantees that a port is never held twice on the same node.
+type NodePortAllocationRow struct {
+	NodeID            string `db:"node_id" orm:"op=create key=primary_key filter=In"`
+	Protocol          string `db:"protocol" orm:"op=create key=primary_key"`
+	Port              uint32 `db:"port" orm:"op=create key=primary_key"`
+	RuntimeInstanceID string `db:"runtime_instance_id" orm:"op=create filter=In"`
+	PayloadName       string `db:"payload_name" orm:"op=create"`
+}
+
+// NodePort is a protocol and port pair on a node.
+type NodePort struct {
+	Protocol string
+	Port     uint32
+}
+
+type NodePortAllocationTableSelectFilters struct {
+	NodeIDIn            []string `db:"node_id:in"`             // IN condition
+	RuntimeInstanceIDIn []string `db:"runtime_instance_id:in"` // IN condition
+}
+
+const nodePortAllocationTableName = "node_port_allocation"
+
+type NodePortAllocationTable struct {
+	simplesql.Database
+	tableName string
+}
+
+func NewNodePortAllocationTable(db simplesql.Database) *NodePortAllocationTable {
+	return &NodePortAllocationTable{
+		Database:  db,
+		tableName: nodePortAllocationTableName,
+	}
+}
+
+func (s *NodePortAllocationTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row NodePortAllocationRow) error {
+	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+}
+
+func (s *NodePortAllocationTable) DeleteByRuntimeInstance(ctx context.Context, execer sqlx.ExecerContext, runtimeInstanceID string) error {
+	query := `
+		DELETE FROM node_port_allocation
+		WHERE runtime_instance_id = :runtime_instance_id
+	`
+	params := map[string]interface{}{
+		"runtime_instance_id": runtimeInstanceID,
+	}
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query = s.DB.Rebind(query)
+	_, err = execer.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (s *NodePortAllocationTable) List(ctx context.Context, filters NodePortAllocationTableSelectFilters) ([]NodePortAllocationRow, error) {
+	var rows []NodePortAllocationRow
+	err := s.Database.SelectRows(ctx, s.tableName, filters, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -45,7 +46,7 @@ type NodeRow struct {
 	DeletedAt            int64  `db:"deleted_at"`
 	State                string `db:"state" orm:"op=create,update filter=In,NotIn"`
 	Message              string `db:"message" orm:"op=create,update"`
-	UpdateDomain         string `db:"update_domain" orm:"op=create filter=In"`
+	UpdateDomain         string `db:"update_domain" orm:"op=create,update filter=In"`
 	ClusterID            string `db:"cluster_id" orm:"op=create filter=In"`
 	TotalCores           uint32 `db:"total_cores" orm:"op=create,update"`
 	TotalMemory          uint32 `db:"total_memory" orm:"op=create,update"`
@@ -61,12 +62,17 @@ type NodeKeys struct {
 }
 
 type NodeUpdateFields struct {
-	State           *string `db:"state"`
-	Message         *string `db:"message"`
-	ClusterID       *string `db:"cluster_id"`
-	DeletedAt       *int64  `db:"deleted_at"`
-	RemainingCores  *uint32 `db:"remaining_cores"`
-	RemainingMemory *uint32 `db:"remaining_memory"`
+	State                *string `db:"state"`
+	Message              *string `db:"message"`
+	ClusterID            *string `db:"cluster_id"`
+	DeletedAt            *int64  `db:"deleted_at"`
+	UpdateDomain         *string `db:"update_domain"`
+	TotalCores           *uint32 `db:"total_cores"`
+	TotalMemory          *uint32 `db:"total_memory"`
+	SystemReservedCores  *uint32 `db:"system_reserved_cores"`
+	SystemReservedMemory *uint32 `db:"system_reserved_memory"`
+	RemainingCores       *uint32 `db:"remaining_cores"`
+	RemainingMemory      *uint32 `db:"remaining_memory"`
 }
 
 type NodeSelectFilters struct {
@@ -87,26 +93,40 @@ type NodeSelectFilters struct {
 	PayloadNameNotIn   []string `db:"payload_name:not_in"`  // NOT IN condition
 	UpdateDomainIn     []string `db:"update_domain:in"`
 
+	PortsNotInUse              []NodePort // Ports which must not be allocated on the node.
+	NoActiveDisruption         bool       // Exclude the nodes with a disruption which is not completed.
+	NoDisruptionStartingBefore *uint64    // Exclude the nodes with a disruption, not completed, starting before this unix time.
+
 	IncludeDeleted bool   `db:"include_deleted"` // Special boolean handling
 	Limit          uint32 `db:"limit"`
 }
 
 const nodeTableName = "node"
 
+// disruptionStateCompleted is the state of a node disruption which no longer affects the node.
+const disruptionStateCompleted = "DisruptionState_COMPLETED"
+
+// NodeTable records every change of a node in the change log, with the execer of the change.
 type NodeTable struct {
 	simplesql.Database
 	tableName string
+	changeLog *ChangeLogTable
 }
 
 func NewNodeTable(db simplesql.Database) *NodeTable {
 	return &NodeTable{
 		Database:  db,
 		tableName: nodeTableName,
+		changeLog: NewChangeLogTable(db),
 	}
 }
 
 func (s *NodeTable) Insert(ctx context.Context, execer sqlx.ExecerContext, row NodeRow) error {
-	return s.Database.InsertRow(ctx, execer, s.tableName, row)
+	err := s.Database.InsertRow(ctx, execer, s.tableName, row)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindNode, row.ID, row.Version, ChangeLogTypeCreate)
 }
 
 func (s *NodeTable) Get(ctx context.Context, keys NodeKeys) (NodeRow, error) {
@@ -121,26 +141,38 @@ func (s *NodeTable) Get(ctx context.Context, keys NodeKeys) (NodeRow, error) {
 func (s *NodeTable) Update(
 	ctx context.Context, execer sqlx.ExecerContext, id string, version uint64, updateFields NodeUpdateFields,
 ) error {
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, updateFields)
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindNode, id, version+1, ChangeLogTypeUpdate)
 }
 
 func (s *NodeTable) Delete(ctx context.Context, execer sqlx.ExecerContext, id string, version uint64) error {
 	timeNow := time.Now().Unix()
-	return s.Database.UpdateRow(ctx, execer, id, version, s.tableName, NodeUpdateFields{
+	err := s.Database.UpdateRow(ctx, execer, id, version, s.tableName, NodeUpdateFields{
 		DeletedAt: &timeNow,
 	})
+	if err != nil {
+		return err
+	}
+	return s.changeLog.Insert(ctx, execer, ChangeLogKindNode, id, version+1, ChangeLogTypeDelete)
 }
 
 func (s *NodeTable) List(ctx context.Context, filters NodeSelectFilters) ([]NodeRow, error) {
 	// TODO: Optimize this function to reduce the number of queries.
-	uniqueIDs, err := s.getUniqueIDs(ctx, filters)
+	keys, err := s.getKeys(ctx, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(uniqueIDs) == 0 {
+	if len(keys) == 0 {
 		return nil, nil
 	}
+	uniqueIDs := make([]string, len(keys))
+	for i, key := range keys {
+		uniqueIDs[i] = key.ID
+	}
 	var rows []NodeRow
 	err = s.Database.SelectRows(ctx, s.tableName, NodeSelectFilters{
 		IDIn:           uniqueIDs,
@@ -152,11 +184,52 @@ func (s *NodeTable) List(ctx context.Context, filters NodeSelectFilters) ([]Node
 	return rows, nil
 }
 
-// Get retrieves a NodeRecord by its ID, along with its capabilities and local volumes.
+// ListKeys returns the page keys of the nodes matching the filters, ordered by name and ID. Only the keys
+// ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func (s *NodeTable) ListKeys(ctx context.Context, filters NodeSelectFilters, after *PageKey) ([]PageKey, error) {
+	query, params := nodeFilterQuery(filters)
+	query, params = pageQuery("SELECT DISTINCT n.id, n.name "+query, params, "n.name", "n.id", after, filters.Limit)
+	var keys []PageKey
+	err := selectQuery(ctx, s.Database, &keys, query, params)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Count returns the number of nodes matching the filters, ignoring their Limit.
+func (s *NodeTable) Count(ctx context.Context, filters NodeSelectFilters) (uint64, error) {
+	query, params := nodeFilterQuery(filters)
+	var counts []uint64
+	err := selectQuery(ctx, s.Database, &counts, "SELECT COUNT(DISTINCT n.id) "+query, params)
+	if err != nil {
+		return 0, err
+	}
+	return counts[0], nil
+}
+
+// getKeys returns the keys of the nodes matching the filters, which may match their capabilities, volumes
+// and payloads.
 // TODO: Optimize this function to reduce the number of queries.
-func (s *NodeTable) getUniqueIDs(ctx context.Context, filters NodeSelectFilters) ([]string, error) {
+func (s *NodeTable) getKeys(ctx context.Context, filters NodeSelectFilters) ([]PageKey, error) {
+	query, params := nodeFilterQuery(filters)
+	query = "SELECT DISTINCT n.id, n.name " + query
+	if filters.Limit > 0 {
+		query += " LIMIT :limit"
+		params["limit"] = filters.Limit
+	}
+	var keys []PageKey
+	err := selectQuery(ctx, s.Database, &keys, query, params)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// nodeFilterQuery returns the FROM and WHERE clauses selecting the nodes matching the filters, and their
+// parameters.
+func nodeFilterQuery(filters NodeSelectFilters) (string, map[string]interface{}) {
 	query := `
-		SELECT DISTINCT n.id
 		FROM node n
 		LEFT JOIN node_capability nc ON n.id = nc.node_id
 		LEFT JOIN node_local_volume lv ON n.id = lv.node_id
@@ -242,40 +315,31 @@ func (s *NodeTable) getUniqueIDs(ctx context.Context, filters NodeSelectFilters)
 		filtersQuery = append(filtersQuery, "(np.payload_name NOT IN (:payload_name_not_in) or np.payload_name IS NULL)")
 		params["payload_name_not_in"] = filters.PayloadNameNotIn
 	}
-
-	if len(filtersQuery) > 0 {
-		query += " AND " + strings.Join(filtersQuery, " AND ")
+	if filters.NoActiveDisruption {
+		filtersQuery = append(filtersQuery, "NOT EXISTS (SELECT 1 FROM node_disruption nd WHERE nd.node_id = n.id AND nd.state != :disruption_state_completed)")
+		params["disruption_state_completed"] = disruptionStateCompleted
 	}
-
-	if filters.Limit > 0 {
-		query += " LIMIT :limit"
-		params["limit"] = filters.Limit
-	}
-
-	query, args, err := sqlx.Named(query, params)
-	if err != nil {
-		return nil, err
+	if filters.NoDisruptionStartingBefore != nil {
+		filtersQuery = append(filtersQuery, "NOT EXISTS (SELECT 1 FROM node_disruption nd WHERE nd.node_id = n.id AND nd.state != :disruption_state_completed AND nd.start_time < :disruption_start_time_before)")
+		params["disruption_state_completed"] = disruptionStateCompleted
+		params["disruption_start_time_before"] = *filters.NoDisruptionStartingBefore
 	}
-	query, args, err = sqlx.In(query, args...)
-	if err != nil {
-		return nil, err
-	}
-	query = s.DB.Rebind(query)
-	var ids []struct {
-		ID string `db:"id"`
-	}
-	err = s.DB.SelectContext(ctx, &ids, query, args...)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(ids) == 0 {
-		return nil, nil
+	if len(filters.PortsNotInUse) > 0 {
+		var portsQuery []string
+		for i, port := range filters.PortsNotInUse {
+			protocolParam := fmt.Sprintf("port_protocol_%d", i)
+			portParam := fmt.Sprintf("port_port_%d", i)
+			portsQuery = append(portsQuery, fmt.Sprintf("(pa.protocol = :%s AND pa.port = :%s)", protocolParam, portParam))
+			params[protocolParam] = port.Protocol
+			params[portParam] = port.Port
+		}
+		filtersQuery = append(filtersQuery, fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM node_port_allocation pa WHERE pa.node_id = n.id AND (%s))", strings.Join(portsQuery, " OR "),
+		))
 	}
 
-	uniqueIDs := make([]string, len(ids))
-	for i, id := range ids {
-		uniqueIDs[i] = id.ID
+	if len(filtersQuery) > 0 {
+		query += " AND " + strings.Join(filtersQuery, " AND ")
 	}
-	return uniqueIDs, nil
+	return query, params
 }
@@ -0,0 +1,143 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/msanath/gondolf/pkg/simplesql"
+)
+
+// PageKey is the key by which the rows of a table are ordered into the pages of a list. The rows are
+// ordered by name, and by ID for the rows of the same name.
+type PageKey struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+// selectPageKeys returns the keys of the rows of a table matching the filters, ordered by name and ID. Only
+// the keys ordered after the after key are returned when it is set, and at most the Limit of the filters.
+func selectPageKeys(ctx context.Context, db simplesql.Database, tableName string, filters interface{}, after *PageKey) ([]PageKey, error) {
+	conditions, params := filterConditions(filters)
+	query := fmt.Sprintf("SELECT id, name FROM %s WHERE %s", tableName, strings.Join(conditions, " AND "))
+	query, params = pageQuery(query, params, "name", "id", after, filterLimit(filters))
+
+	var keys []PageKey
+	err := selectQuery(ctx, db, &keys, query, params)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// countRows returns the number of rows of a table matching the filters, ignoring their Limit.
+func countRows(ctx context.Context, db simplesql.Database, tableName string, filters interface{}) (uint64, error) {
+	conditions, params := filterConditions(filters)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", tableName, strings.Join(conditions, " AND "))
+
+	var counts []uint64
+	err := selectQuery(ctx, db, &counts, query, params)
+	if err != nil {
+		return 0, err
+	}
+	return counts[0], nil
+}
+
+// filterConditions returns the conditions of the filters of a table, which are tagged as the filters of
+// simplesql.Database.SelectRows.
+func filterConditions(filters interface{}) ([]string, map[string]interface{}) {
+	conditions := []string{"1=1"}
+	params := map[string]interface{}{}
+
+	v := reflect.ValueOf(filters)
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		name := t.Field(i).Name
+		column, operation, _ := strings.Cut(t.Field(i).Tag.Get("db"), ":")
+
+		switch {
+		case column == "" || column == "limit":
+			continue
+		case column == "include_deleted":
+			if field.Bool() {
+				conditions = append(conditions, "deleted_at >= 0")
+			} else {
+				conditions = append(conditions, "deleted_at = 0")
+			}
+			continue
+		case field.Kind() == reflect.Slice:
+			if field.Len() == 0 {
+				continue
+			}
+			if operation == "not_in" {
+				conditions = append(conditions, fmt.Sprintf("%s NOT IN (:%s)", column, name))
+			} else {
+				conditions = append(conditions, fmt.Sprintf("%s IN (:%s)", column, name))
+			}
+		case field.Kind() == reflect.Ptr:
+			if field.IsNil() {
+				continue
+			}
+			switch operation {
+			case "lt":
+				conditions = append(conditions, fmt.Sprintf("%s < :%s", column, name))
+			case "gt":
+				conditions = append(conditions, fmt.Sprintf("%s > :%s", column, name))
+			case "lte":
+				conditions = append(conditions, fmt.Sprintf("%s <= :%s", column, name))
+			case "gte":
+				conditions = append(conditions, fmt.Sprintf("%s >= :%s", column, name))
+			case "eq":
+				conditions = append(conditions, fmt.Sprintf("%s = :%s", column, name))
+			}
+		default:
+			continue
+		}
+		params[name] = field.Interface()
+	}
+	return conditions, params
+}
+
+func filterLimit(filters interface{}) uint32 {
+	limit := reflect.ValueOf(filters).FieldByName("Limit")
+	if !limit.IsValid() {
+		return 0
+	}
+	return uint32(limit.Uint())
+}
+
+// pageQuery orders the rows selected by a query by their name and ID columns, and keeps the ones ordered
+// after the after key when it is set, and at most limit of them when it is not 0.
+func pageQuery(
+	query string, params map[string]interface{}, nameColumn, idColumn string, after *PageKey, limit uint32,
+) (string, map[string]interface{}) {
+	if after != nil {
+		query += fmt.Sprintf(" AND (%s, %s) > (:after_name, :after_id)", nameColumn, idColumn)
+		params["after_name"] = after.Name
+		params["after_id"] = after.ID
+	}
+	query += fmt.Sprintf(" ORDER BY %s, %s", nameColumn, idColumn)
+	if limit > 0 {
+		query += " LIMIT :limit"
+		params["limit"] = limit
+	}
+	return query, params
+}
+
+// selectQuery runs a query with named parameters, which may be slices expanded into IN clauses.
+func selectQuery(ctx context.Context, db simplesql.Database, dest interface{}, query string, params map[string]interface{}) error {
+	query, args, err := sqlx.Named(query, params)
+	if err != nil {
+		return err
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return err
+	}
+	query = db.DB.Rebind(query)
+	return db.DB.SelectContext(ctx, dest, query, args...)
+}
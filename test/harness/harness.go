@@ -0,0 +1,292 @@
+// Package harness runs the deployment, disruption and operation workflows of the control plane in the test
+// environment of Temporal, against a test ledger and the fake runtime, to test the scheduling and rollout logic
+// at scale.
+package harness
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/temporal/activities/mrds"
+	"github.com/msanath/mrds/controlplane/temporal/activities/scheduler"
+	"github.com/msanath/mrds/controlplane/temporal/workflows"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/runtime/fake"
+	testserver "github.com/msanath/mrds/test/server"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+)
+
+const (
+	// ClusterID is the cluster of the nodes added by the harness.
+	ClusterID = "fake"
+	// approvalTimeout is how long the operations wait for their approval. The harness approves every operation
+	// in the ledger, so they run once the timeout expires.
+	approvalTimeout = time.Minute
+)
+
+// Harness holds a test ledger and a fake runtime, on which it runs the workflows.
+type Harness struct {
+	t      testing.TB
+	ctx    context.Context
+	server *testserver.TestServer
+
+	MetaInstances       mrdspb.MetaInstancesClient
+	DeploymentPlans     mrdspb.DeploymentPlansClient
+	Nodes               mrdspb.NodesClient
+	ComputeCapabilities mrdspb.ComputeCapabilitiesClient
+	Runtime             *fake.FakeRuntime
+}
+
+// New returns a harness whose runtime simulates the profile. The test ledger is closed with the test.
+func New(t testing.TB, profile fake.Profile) *Harness {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Close)
+
+	metaInstances := mrdspb.NewMetaInstancesClient(ts.Conn())
+	return &Harness{
+		t:                   t,
+		ctx:                 context.Background(),
+		server:              ts,
+		MetaInstances:       metaInstances,
+		DeploymentPlans:     mrdspb.NewDeploymentPlansClient(ts.Conn()),
+		Nodes:               mrdspb.NewNodesClient(ts.Conn()),
+		ComputeCapabilities: mrdspb.NewComputeCapabilitiesClient(ts.Conn()),
+		Runtime:             fake.NewFakeRuntime(metaInstances, profile),
+	}
+}
+
+// AddNodes adds count allocated nodes with the resources, spread over the given number of update domains.
+func (h *Harness) AddNodes(count int, updateDomains int, resources *mrdspb.Resources) []*mrdspb.Node {
+	var nodes []*mrdspb.Node
+	for i := 0; i < count; i++ {
+		createResp, err := h.Nodes.Create(h.ctx, &mrdspb.CreateNodeRequest{
+			Name:                    fmt.Sprintf("node-%d", i),
+			UpdateDomain:            fmt.Sprintf("ud-%d", i%updateDomains),
+			TotalResources:          resources,
+			SystemReservedResources: &mrdspb.Resources{},
+		})
+		require.NoError(h.t, err)
+		node := createResp.Record
+		for _, state := range []mrdspb.NodeState{mrdspb.NodeState_NodeState_ALLOCATING, mrdspb.NodeState_NodeState_ALLOCATED} {
+			updateResp, err := h.Nodes.UpdateStatus(h.ctx, &mrdspb.UpdateNodeStatusRequest{
+				Metadata:  node.Metadata,
+				Status:    &mrdspb.NodeStatus{State: state},
+				ClusterId: ClusterID,
+			})
+			require.NoError(h.t, err)
+			node = updateResp.Record
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// CreatePlan creates a deployment plan with a single application "app" using the resources, and returns its ID.
+func (h *Harness) CreatePlan(name string, resources *mrdspb.ApplicationResources) string {
+	createResp, err := h.DeploymentPlans.Create(h.ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:        name,
+		Namespace:   "harness",
+		ServiceName: name,
+		Applications: []*mrdspb.Application{
+			{PayloadName: "app", Resources: resources},
+		},
+	})
+	require.NoError(h.t, err)
+	return createResp.Record.Metadata.Id
+}
+
+// AddDeployment adds a deployment of instanceCount instances of the image to the plan, rolled out with the
+// strategy.
+func (h *Harness) AddDeployment(planID string, deploymentID string, instanceCount uint32, image string, strategy *mrdspb.RolloutStrategy) {
+	_, err := h.DeploymentPlans.AddDeployment(h.ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:     h.Plan(planID).Metadata,
+		DeploymentId: deploymentID,
+		PayloadCoordinates: []*mrdspb.PayloadCoordinates{
+			{PayloadName: "app", Coordinates: map[string]string{"image": image}},
+		},
+		InstanceCount:   instanceCount,
+		RolloutStrategy: strategy,
+	})
+	require.NoError(h.t, err)
+}
+
+// RunDeployment runs the deployment workflow of the deployment to completion, with the operations workflow
+// running its operations, and returns the error of the workflow. Every operation is approved in the ledger.
+func (h *Harness) RunDeployment(planID string, deploymentID string) error {
+	plan := h.Plan(planID)
+	deployment := h.Deployment(planID, deploymentID)
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	metaInstanceActivities := mrds.NewMetaInstanceActivities(h.MetaInstances, env)
+	schedulerActivities := scheduler.NewSchedulerActivities(
+		h.MetaInstances,
+		h.Nodes,
+		h.DeploymentPlans,
+		h.ComputeCapabilities,
+		scheduler.DefaultProfileName,
+		env,
+	)
+	h.Runtime.Register(env)
+	w := workflows.NewDeploymentWorkflow(
+		mrds.NewDeploymentPlanActivities(h.DeploymentPlans, env),
+		metaInstanceActivities,
+		mrds.NewNodeActivities(h.Nodes, env),
+		h.Runtime,
+		env,
+	)
+	_ = workflows.NewOperationsWorkflow(metaInstanceActivities, schedulerActivities, h.Runtime, approvalTimeout, env)
+
+	env.SetOnActivityCompletedListener(func(info *activity.Info, result converter.EncodedValue, err error) {
+		if err != nil || info.ActivityType.Name != "UpdateOperationStatus" {
+			return
+		}
+		var resp mrds.UdpateOperationStatusResponse
+		if result.Get(&resp) == nil {
+			h.approvePending(resp.MetaInstance.Metadata.Id)
+		}
+	})
+
+	env.ExecuteWorkflow(w.RunDeployment, workflows.RunDeploymentWorkflowParams{
+		DeploymentPlan: plan,
+		Deployment:     deployment,
+	})
+	require.True(h.t, env.IsWorkflowCompleted())
+	return env.GetWorkflowError()
+}
+
+// RunDisruption runs the disruption workflow of the disruption of the node to completion, with the operations
+// workflow relocating the instances, and returns the error of the workflow. Every operation is approved in the
+// ledger, and the disruption is completed as soon as it is approved.
+func (h *Harness) RunDisruption(nodeID string, disruptionID string) error {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	metaInstanceActivities := mrds.NewMetaInstanceActivities(h.MetaInstances, env)
+	schedulerActivities := scheduler.NewSchedulerActivities(
+		h.MetaInstances,
+		h.Nodes,
+		h.DeploymentPlans,
+		h.ComputeCapabilities,
+		scheduler.DefaultProfileName,
+		env,
+	)
+	h.Runtime.Register(env)
+	w := workflows.NewDisruptionWorkflow(
+		metaInstanceActivities,
+		mrds.NewNodeActivities(h.Nodes, env),
+		h.Runtime,
+		env,
+	)
+	_ = workflows.NewOperationsWorkflow(metaInstanceActivities, schedulerActivities, h.Runtime, approvalTimeout, env)
+
+	env.SetOnActivityCompletedListener(func(info *activity.Info, result converter.EncodedValue, err error) {
+		if err != nil {
+			return
+		}
+		switch info.ActivityType.Name {
+		case "UpdateOperationStatus":
+			var resp mrds.UdpateOperationStatusResponse
+			if result.Get(&resp) == nil {
+				h.approvePending(resp.MetaInstance.Metadata.Id)
+			}
+		case "UpdateNodeDisruptionStatus":
+			h.completeApproved(nodeID, disruptionID)
+		}
+	})
+
+	env.ExecuteWorkflow(w.RunDisruption, workflows.RunDisruptionWorkflowParams{
+		NodeID:       nodeID,
+		DisruptionID: disruptionID,
+	})
+	require.True(h.t, env.IsWorkflowCompleted())
+	return env.GetWorkflowError()
+}
+
+// completeApproved completes the disruption of the node when it is approved.
+func (h *Harness) completeApproved(nodeID string, disruptionID string) {
+	getResp, err := h.Nodes.GetByID(h.ctx, &mrdspb.GetNodeByIDRequest{Id: nodeID})
+	if err != nil {
+		h.t.Errorf("failed to get node %s: %v", nodeID, err)
+		return
+	}
+	for _, disruption := range getResp.Record.Disruptions {
+		if disruption.Id != disruptionID || disruption.Status.State != mrdspb.DisruptionState_DisruptionState_APPROVED {
+			continue
+		}
+		_, err := h.Nodes.UpdateDisruptionStatus(h.ctx, &mrdspb.UpdateDisruptionStatusRequest{
+			Metadata:     getResp.Record.Metadata,
+			DisruptionId: disruptionID,
+			Status:       &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_COMPLETED},
+		})
+		if err != nil {
+			h.t.Errorf("failed to complete disruption %s: %v", disruptionID, err)
+		}
+	}
+}
+
+// approvePending approves the operations of the meta instance which are pending approval.
+func (h *Harness) approvePending(metaInstanceID string) {
+	getResp, err := h.MetaInstances.GetByID(h.ctx, &mrdspb.GetMetaInstanceByIDRequest{Id: metaInstanceID})
+	if err != nil {
+		h.t.Errorf("failed to get meta instance %s: %v", metaInstanceID, err)
+		return
+	}
+	metadata := getResp.Record.Metadata
+	for _, op := range getResp.Record.Operations {
+		if op.Status.State != mrdspb.OperationState_OperationState_PENDING_APPROVAL {
+			continue
+		}
+		updateResp, err := h.MetaInstances.UpdateOperationStatus(h.ctx, &mrdspb.UpdateOperationStatusRequest{
+			Metadata:    metadata,
+			OperationId: op.Id,
+			Status:      &mrdspb.OperationStatus{State: mrdspb.OperationState_OperationState_APPROVED},
+		})
+		if err != nil {
+			h.t.Errorf("failed to approve operation %s: %v", op.Id, err)
+			return
+		}
+		metadata = updateResp.Record.Metadata
+	}
+}
+
+// Plan returns the deployment plan.
+func (h *Harness) Plan(planID string) *mrdspb.DeploymentPlanRecord {
+	getResp, err := h.DeploymentPlans.GetByID(h.ctx, &mrdspb.GetDeploymentPlanByIDRequest{Id: planID})
+	require.NoError(h.t, err)
+	return getResp.Record
+}
+
+// Deployment returns the deployment of the plan.
+func (h *Harness) Deployment(planID string, deploymentID string) *mrdspb.Deployment {
+	for _, d := range h.Plan(planID).Deployments {
+		if d.Id == deploymentID {
+			return d
+		}
+	}
+	require.FailNow(h.t, "deployment not found", deploymentID)
+	return nil
+}
+
+// Instances returns the meta instances of the plan.
+func (h *Harness) Instances(planID string) []*mrdspb.MetaInstance {
+	listResp, err := h.MetaInstances.List(h.ctx, &mrdspb.ListMetaInstanceRequest{DeploymentPlanIdIn: []string{planID}})
+	require.NoError(h.t, err)
+	return listResp.Records
+}
+
+// ActiveRuntimeInstance returns the active runtime instance of the meta instance, or nil when it has none.
+func ActiveRuntimeInstance(instance *mrdspb.MetaInstance) *mrdspb.RuntimeInstance {
+	for _, ri := range instance.RuntimeInstances {
+		if ri.IsActive {
+			return ri
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package harness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/msanath/mrds/controlplane/operators"
+	"github.com/msanath/mrds/gen/api/mrdspb"
+	"github.com/msanath/mrds/pkg/runtime/fake"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	nodeResources = &mrdspb.Resources{Cores: 8, Memory: 8192}
+	appResources  = &mrdspb.ApplicationResources{Cores: 1, Memory: 256}
+)
+
+func TestRollout(t *testing.T) {
+	h := New(t, fake.Profile{
+		StartLatency:  5 * time.Millisecond,
+		StopLatency:   5 * time.Millisecond,
+		LatencyJitter: 5 * time.Millisecond,
+	})
+	h.AddNodes(48, 4, nodeResources)
+	planID := h.CreatePlan("web", appResources)
+
+	// All the instances are allocated at once, so they are placed concurrently.
+	h.AddDeployment(planID, "deployment-1", 40, "web:1", nil)
+	require.NoError(t, h.RunDeployment(planID, "deployment-1"))
+	deployment := h.Deployment(planID, "deployment-1")
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_COMPLETED, deployment.Status.State)
+	require.Equal(t, uint32(1), deployment.RolloutProgress.TotalBatches)
+
+	instances := h.Instances(planID)
+	require.Len(t, instances, 40)
+	nodeIDs := make(map[string]bool)
+	for _, instance := range instances {
+		ri := ActiveRuntimeInstance(instance)
+		require.NotNil(t, ri, instance.Name)
+		require.Equal(t, mrdspb.RuntimeInstanceState_RuntimeState_RUNNING, ri.Status.State, instance.Name)
+		require.True(t, ri.Status.Ready, instance.Name)
+		nodeIDs[ri.NodeId] = true
+	}
+
+	// The update is rolled out one update domain at a time.
+	listResp, err := h.Nodes.List(context.Background(), &mrdspb.ListNodeRequest{})
+	require.NoError(t, err)
+	updateDomains := make(map[string]bool)
+	for _, node := range listResp.Records {
+		if nodeIDs[node.Metadata.Id] {
+			updateDomains[node.UpdateDomain] = true
+		}
+	}
+	h.AddDeployment(planID, "deployment-2", 40, "web:2", nil)
+	require.NoError(t, h.RunDeployment(planID, "deployment-2"))
+	deployment = h.Deployment(planID, "deployment-2")
+	require.Equal(t, mrdspb.DeploymentState_DeploymentState_COMPLETED, deployment.Status.State)
+	require.Equal(t, uint32(len(updateDomains)), deployment.RolloutProgress.TotalBatches)
+	require.Equal(t, deployment.RolloutProgress.TotalBatches, deployment.RolloutProgress.CompletedBatches)
+
+	for _, instance := range h.Instances(planID) {
+		require.Equal(t, "deployment-2", instance.DeploymentId)
+		require.True(t, ActiveRuntimeInstance(instance).Status.Ready, instance.Name)
+		for _, op := range instance.Operations {
+			require.Equal(t, mrdspb.OperationState_OperationState_SUCCEEDED, op.Status.State, instance.Name)
+		}
+	}
+}
+
+func TestRolloutFaults(t *testing.T) {
+	testCases := []struct {
+		name        string
+		profile     fake.Profile
+		expectErr   string
+		expectState mrdspb.RuntimeInstanceState
+	}{
+		{
+			name:        "Failed starts fail the deployment",
+			profile:     fake.Profile{StartFailureRate: 1},
+			expectErr:   "Simulated start failure",
+			expectState: mrdspb.RuntimeInstanceState_RuntimeState_FAILED,
+		},
+		{
+			name:        "Stuck instances are not ready in time",
+			profile:     fake.Profile{StuckRate: 1},
+			expectErr:   "instances are not ready",
+			expectState: mrdspb.RuntimeInstanceState_RuntimeState_STARTING,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := New(t, tc.profile)
+			h.AddNodes(8, 2, nodeResources)
+			planID := h.CreatePlan("web", appResources)
+
+			h.AddDeployment(planID, "deployment-1", 8, "web:1", nil)
+			err := h.RunDeployment(planID, "deployment-1")
+			require.ErrorContains(t, err, tc.expectErr)
+
+			for _, instance := range h.Instances(planID) {
+				require.Equal(t, tc.expectState, ActiveRuntimeInstance(instance).Status.State, instance.Name)
+			}
+		})
+	}
+}
+
+func TestNodeCrash(t *testing.T) {
+	h := New(t, fake.Profile{})
+	nodes := h.AddNodes(8, 2, nodeResources)
+	planID := h.CreatePlan("web", appResources)
+
+	h.AddDeployment(planID, "deployment-1", 8, "web:1", nil)
+	require.NoError(t, h.RunDeployment(planID, "deployment-1"))
+
+	crashed := nodes[0].Metadata.Id
+	h.Runtime.CrashNode(crashed)
+
+	// The reconciler records the crash in the ledger.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reconciler := operators.NewReconcilerOperator(h.MetaInstances, h.Runtime, 10*time.Millisecond)
+	go func() {
+		_ = reconciler.RunBlocking(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		for _, instance := range h.Instances(planID) {
+			ri := ActiveRuntimeInstance(instance)
+			failed := ri.Status.State == mrdspb.RuntimeInstanceState_RuntimeState_FAILED
+			if failed != (ri.NodeId == crashed) {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDisruptionRetry(t *testing.T) {
+	ctx := context.Background()
+	h := New(t, fake.Profile{})
+	h.AddNodes(2, 2, nodeResources)
+	// Each instance takes a whole node, so an instance can only be relocated to an empty node.
+	planID := h.CreatePlan("web", &mrdspb.ApplicationResources{Cores: nodeResources.Cores, Memory: nodeResources.Memory})
+
+	h.AddDeployment(planID, "deployment-1", 1, "web:1", nil)
+	require.NoError(t, h.RunDeployment(planID, "deployment-1"))
+	instance := h.Instances(planID)[0]
+	source := ActiveRuntimeInstance(instance).NodeId
+
+	listResp, err := h.Nodes.List(ctx, &mrdspb.ListNodeRequest{})
+	require.NoError(t, err)
+	var target *mrdspb.Node
+	for _, node := range listResp.Records {
+		if node.Metadata.Id != source {
+			target = node
+		}
+	}
+	require.NotNil(t, target)
+
+	// The other node is disrupted as well, so the instance has nowhere to go and the relocation fails.
+	updateResp, err := h.Nodes.AddDisruption(ctx, &mrdspb.AddDisruptionRequest{
+		Metadata: target.Metadata,
+		Disruption: &mrdspb.NodeDisruption{
+			Id:     "hold",
+			Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+		},
+	})
+	require.NoError(t, err)
+	target = updateResp.Record
+	getResp, err := h.Nodes.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: source})
+	require.NoError(t, err)
+	_, err = h.Nodes.AddDisruption(ctx, &mrdspb.AddDisruptionRequest{
+		Metadata: getResp.Record.Metadata,
+		Disruption: &mrdspb.NodeDisruption{
+			Id:     "disruption-1",
+			Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Error(t, h.RunDisruption(source, "disruption-1"))
+	getResp, err = h.Nodes.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: source})
+	require.NoError(t, err)
+	require.Equal(t, mrdspb.DisruptionState_DisruptionState_SCHEDULED, getResp.Record.Disruptions[0].Status.State)
+	instance = h.Instances(planID)[0]
+	require.Equal(t, source, ActiveRuntimeInstance(instance).NodeId)
+	require.Len(t, instance.Operations, 2)
+
+	// Once the other node is available, running the disruption again retries the relocation.
+	_, err = h.Nodes.UpdateDisruptionStatus(ctx, &mrdspb.UpdateDisruptionStatusRequest{
+		Metadata:     target.Metadata,
+		DisruptionId: "hold",
+		Status:       &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_COMPLETED},
+	})
+	require.NoError(t, err)
+	require.NoError(t, h.RunDisruption(source, "disruption-1"))
+
+	getResp, err = h.Nodes.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: source})
+	require.NoError(t, err)
+	require.Empty(t, getResp.Record.Disruptions)
+	instance = h.Instances(planID)[0]
+	require.Len(t, instance.RuntimeInstances, 1)
+	require.Equal(t, target.Metadata.Id, ActiveRuntimeInstance(instance).NodeId)
+	require.True(t, ActiveRuntimeInstance(instance).Status.Ready)
+	for _, op := range instance.Operations {
+		require.Equal(t, mrdspb.OperationState_OperationState_SUCCEEDED, op.Status.State, op.Id)
+	}
+}
+
+func TestDisruptionRemovesPassiveInstances(t *testing.T) {
+	ctx := context.Background()
+	h := New(t, fake.Profile{})
+	h.AddNodes(2, 2, nodeResources)
+	planID := h.CreatePlan("web", appResources)
+
+	h.AddDeployment(planID, "deployment-1", 1, "web:1", nil)
+	require.NoError(t, h.RunDeployment(planID, "deployment-1"))
+	instance := h.Instances(planID)[0]
+	source := ActiveRuntimeInstance(instance).NodeId
+
+	listResp, err := h.Nodes.List(ctx, &mrdspb.ListNodeRequest{})
+	require.NoError(t, err)
+	var disrupted *mrdspb.Node
+	for _, node := range listResp.Records {
+		if node.Metadata.Id != source {
+			disrupted = node
+		}
+	}
+	require.NotNil(t, disrupted)
+
+	// The instance has a passive runtime instance on the disrupted node, as during a blue-green deployment.
+	_, err = h.MetaInstances.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+		Metadata: instance.Metadata,
+		RuntimeInstance: &mrdspb.RuntimeInstance{
+			Id:     "passive",
+			NodeId: disrupted.Metadata.Id,
+			Status: &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_RUNNING},
+		},
+	})
+	require.NoError(t, err)
+	getResp, err := h.Nodes.GetByID(ctx, &mrdspb.GetNodeByIDRequest{Id: disrupted.Metadata.Id})
+	require.NoError(t, err)
+	_, err = h.Nodes.AddDisruption(ctx, &mrdspb.AddDisruptionRequest{
+		Metadata: getResp.Record.Metadata,
+		Disruption: &mrdspb.NodeDisruption{
+			Id:     "disruption-1",
+			Status: &mrdspb.DisruptionStatus{State: mrdspb.DisruptionState_DisruptionState_SCHEDULED},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, h.RunDisruption(disrupted.Metadata.Id, "disruption-1"))
+
+	// The passive runtime instance is removed, and the active one keeps running where it was.
+	instance = h.Instances(planID)[0]
+	require.Len(t, instance.RuntimeInstances, 1)
+	require.Equal(t, source, ActiveRuntimeInstance(instance).NodeId)
+	for _, op := range instance.Operations {
+		require.NotEqual(t, mrdspb.OperationType_OperationType_RELOCATE, op.Type, op.Id)
+	}
+}
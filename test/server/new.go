@@ -34,13 +34,41 @@ var testDb = test.NewTestSQLiteDB
 
 // var testDb = test.NewTestMySQLDB
 
-func NewTestServer() (*TestServer, error) {
+type options struct {
+	metaInstanceOpts   []grpcservers.MetaInstanceServiceOption
+	deploymentPlanOpts []grpcservers.DeploymentPlanServiceOption
+}
+
+type Option func(*options)
+
+// WithMetaInstanceServiceOptions configures the MetaInstance service of the test server.
+func WithMetaInstanceServiceOptions(opts ...grpcservers.MetaInstanceServiceOption) Option {
+	return func(o *options) {
+		o.metaInstanceOpts = append(o.metaInstanceOpts, opts...)
+	}
+}
+
+// WithDeploymentPlanServiceOptions configures the DeploymentPlan service of the test server.
+func WithDeploymentPlanServiceOptions(opts ...grpcservers.DeploymentPlanServiceOption) Option {
+	return func(o *options) {
+		o.deploymentPlanOpts = append(o.deploymentPlanOpts, opts...)
+	}
+}
+
+func NewTestServer(opts ...Option) (*TestServer, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	gServer := grpc.NewServer()
 
 	db, err := testDb()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create test sqlite db: %w", err)
 	}
+	// Every connection to an in-memory sqlite database opens a database of its own, so that concurrent
+	// requests must share a single connection.
+	db.SetMaxOpenConns(1)
 	storage, err := sqlstorage.NewSQLStorage(db, false)
 	if err != nil {
 		return nil, err
@@ -67,13 +95,13 @@ func NewTestServer() (*TestServer, error) {
 	metaInstanceLedger := metainstance.NewLedger(storage.MetaInstance)
 	mrdspb.RegisterMetaInstancesServer(
 		gServer,
-		grpcservers.NewMetaInstanceService(metaInstanceLedger),
+		grpcservers.NewMetaInstanceService(metaInstanceLedger, o.metaInstanceOpts...),
 	)
 
 	deploymentPlanLedger := deploymentplan.NewLedger(storage.DeploymentPlan)
 	mrdspb.RegisterDeploymentPlansServer(
 		gServer,
-		grpcservers.NewDeploymentPlanService(deploymentPlanLedger),
+		grpcservers.NewDeploymentPlanService(deploymentPlanLedger, o.deploymentPlanOpts...),
 	)
 	// ++ledgerbuilder:TestServerRegister
 
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/msanath/mrds/gen/api/mrdspb"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RuntimeInstanceID is the ID of the runtime instance of a runtime fixture.
+const RuntimeInstanceID = "plan-0-runtime-0"
+
+// RuntimeFixtureSpec is the deployment plan of a runtime fixture.
+type RuntimeFixtureSpec struct {
+	Namespace          string                       // Namespace of the deployment plan. Defaults to test.
+	Applications       []*mrdspb.Application        // Applications of the deployment plan.
+	PayloadCoordinates []*mrdspb.PayloadCoordinates // Payload coordinates of deployment-1.
+	LocalVolumes       []*mrdspb.NodeLocalVolume    // Local volumes of node-1.
+}
+
+// RuntimeFixture is a meta instance with a runtime instance which the tests of the runtimes start and stop.
+type RuntimeFixture struct {
+	MetaInstances   mrdspb.MetaInstancesClient
+	DeploymentPlans mrdspb.DeploymentPlansClient
+	Nodes           mrdspb.NodesClient
+	NodeID          string
+	PlanID          string
+	MetaInstance    *mrdspb.MetaInstance // MetaInstance holds the pending runtime instance RuntimeInstanceID.
+}
+
+// NewRuntimeFixture starts a test server, closed with the test. It creates node-1, the deployment plan of the
+// spec with deployment-1, and the meta instance plan-0 of deployment-1 with an active runtime instance on node-1.
+func NewRuntimeFixture(t *testing.T, spec RuntimeFixtureSpec) *RuntimeFixture {
+	ts, err := NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Close)
+
+	ctx := context.Background()
+	f := &RuntimeFixture{
+		MetaInstances:   mrdspb.NewMetaInstancesClient(ts.Conn()),
+		DeploymentPlans: mrdspb.NewDeploymentPlansClient(ts.Conn()),
+		Nodes:           mrdspb.NewNodesClient(ts.Conn()),
+	}
+
+	nodeResp, err := f.Nodes.Create(ctx, &mrdspb.CreateNodeRequest{
+		Name:                    "node-1",
+		UpdateDomain:            "ud-1",
+		TotalResources:          &mrdspb.Resources{Cores: 16, Memory: 16384},
+		SystemReservedResources: &mrdspb.Resources{},
+		LocalVolumes:            spec.LocalVolumes,
+	})
+	require.NoError(t, err)
+	f.NodeID = nodeResp.Record.Metadata.Id
+
+	namespace := spec.Namespace
+	if namespace == "" {
+		namespace = "test"
+	}
+	planResp, err := f.DeploymentPlans.Create(ctx, &mrdspb.CreateDeploymentPlanRequest{
+		Name:         "plan",
+		Namespace:    namespace,
+		ServiceName:  "plan",
+		Applications: spec.Applications,
+	})
+	require.NoError(t, err)
+	f.PlanID = planResp.Record.Metadata.Id
+	_, err = f.DeploymentPlans.AddDeployment(ctx, &mrdspb.AddDeploymentRequest{
+		Metadata:           planResp.Record.Metadata,
+		DeploymentId:       "deployment-1",
+		PayloadCoordinates: spec.PayloadCoordinates,
+		InstanceCount:      1,
+	})
+	require.NoError(t, err)
+
+	createResp, err := f.MetaInstances.Create(ctx, &mrdspb.CreateMetaInstanceRequest{
+		Name:             "plan-0",
+		DeploymentPlanId: f.PlanID,
+		DeploymentId:     "deployment-1",
+	})
+	require.NoError(t, err)
+	addResp, err := f.MetaInstances.AddRuntimeInstance(ctx, &mrdspb.AddRuntimeInstanceRequest{
+		Metadata: createResp.Record.Metadata,
+		RuntimeInstance: &mrdspb.RuntimeInstance{
+			Id:       RuntimeInstanceID,
+			NodeId:   f.NodeID,
+			IsActive: true,
+			Status:   &mrdspb.RuntimeInstanceStatus{State: mrdspb.RuntimeInstanceState_RuntimeState_PENDING},
+		},
+	})
+	require.NoError(t, err)
+	f.MetaInstance = addResp.Record
+	return f
+}